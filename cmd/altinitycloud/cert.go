@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+)
+
+func (c *cli) certIssue(ctx context.Context, args []string) error {
+	envName := args[0]
+	cert, err := c.client.GenerateCertificate(ctx, envName)
+	if err != nil {
+		return fmt.Errorf("issue certificate for env %s: %s", envName, client.FormatError(err, envName))
+	}
+
+	if c.outFile != "" {
+		// The file holds the private key.
+		if err := os.WriteFile(c.outFile, []byte(cert.PEM()+"\n"), 0o600); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stderr, "Wrote the certificate and key of env %s to %s.\n", envName, c.outFile)
+		return nil
+	}
+	if c.output == "json" {
+		return c.printJSON(map[string]string{
+			"env_name":    envName,
+			"certificate": cert.CertificatePEM,
+			"private_key": cert.PrivateKeyPEM,
+			"pem":         cert.PEM(),
+		})
+	}
+	_, err = fmt.Fprintln(c.stdout, cert.PEM())
+	return err
+}
+
+func (c *cli) secretEncrypt(ctx context.Context, _ []string) error {
+	if c.pemFile == "" {
+		return errors.New("--pem-file is required: the certificate and key of the env, as issued by cert issue")
+	}
+	pem, err := os.ReadFile(c.pemFile)
+	if err != nil {
+		return err
+	}
+
+	var value []byte
+	if c.valueFile != "" {
+		value, err = os.ReadFile(c.valueFile)
+	} else {
+		value, err = io.ReadAll(c.stdin)
+	}
+	if err != nil {
+		return fmt.Errorf("read value: %w", err)
+	}
+	// Like the shell, drop the newline ending the input.
+	plain := strings.TrimSuffix(strings.TrimSuffix(string(value), "\n"), "\r")
+
+	encrypted, err := c.client.EncryptSecret(ctx, string(pem), plain)
+	if err != nil {
+		return fmt.Errorf("encrypt secret: %s", client.FormatError(err, ""))
+	}
+	if c.output == "json" {
+		return c.printJSON(map[string]string{"value": encrypted})
+	}
+	_, err = fmt.Fprintln(c.stdout, encrypted)
+	return err
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/altinity/terraform-provider-altinitycloud/pkg/altinitycloud"
+)
+
+// cloud are the operations of one cloud, named as in the provider resource
+// types.
+type cloud struct {
+	name    string
+	status  altinitycloud.StatusGetter
+	list    func(ctx context.Context) ([]*altinitycloud.EnvStatus, error)
+	get     func(ctx context.Context, name string) (interface{}, error)
+	codeGen func(ctx context.Context, name string, boilerplate bool) (string, error)
+}
+
+func (c *cli) clouds() ([]cloud, error) {
+	aws, hosted, azure, gcp, hcloud, k8s := c.client.AWS(), c.client.AWSHosted(), c.client.Azure(), c.client.GCP(), c.client.HCloud(), c.client.K8S()
+	all := []cloud{
+		{common.CloudAWS, aws, aws.List, func(ctx context.Context, name string) (interface{}, error) { return aws.Get(ctx, name) }, aws.CodeGen},
+		{common.CloudAWSHosted, hosted, hosted.List, func(ctx context.Context, name string) (interface{}, error) { return hosted.Get(ctx, name) }, nil},
+		{common.CloudAzure, azure, azure.List, func(ctx context.Context, name string) (interface{}, error) { return azure.Get(ctx, name) }, azure.CodeGen},
+		{common.CloudGCP, gcp, gcp.List, func(ctx context.Context, name string) (interface{}, error) { return gcp.Get(ctx, name) }, gcp.CodeGen},
+		{common.CloudHCloud, hcloud, hcloud.List, func(ctx context.Context, name string) (interface{}, error) { return hcloud.Get(ctx, name) }, hcloud.CodeGen},
+		{common.CloudK8S, k8s, k8s.List, func(ctx context.Context, name string) (interface{}, error) { return k8s.Get(ctx, name) }, k8s.CodeGen},
+	}
+	if c.cloud == "" {
+		return all, nil
+	}
+	var names []string
+	for _, cl := range all {
+		if cl.name == c.cloud {
+			return []cloud{cl}, nil
+		}
+		names = append(names, cl.name)
+	}
+	return nil, fmt.Errorf("unknown cloud %q, expected one of %s", c.cloud, strings.Join(names, ", "))
+}
+
+// findEnv returns the cloud of env name and its status, trying every cloud
+// unless --cloud is set.
+func (c *cli) findEnv(ctx context.Context, name string) (*cloud, *altinitycloud.EnvStatus, error) {
+	clouds, err := c.clouds()
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range clouds {
+		status, err := clouds[i].status.EnvStatus(ctx, name)
+		if errors.Is(err, altinitycloud.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read env %s: %s", name, client.FormatError(err, name))
+		}
+		return &clouds[i], status, nil
+	}
+	if c.cloud != "" {
+		return nil, nil, fmt.Errorf("%s env %s not found", c.cloud, name)
+	}
+	return nil, nil, fmt.Errorf("env %s not found", name)
+}
+
+// envSummary is an env status as printed.
+type envSummary struct {
+	Name                string     `json:"name"`
+	Cloud               string     `json:"cloud"`
+	State               string     `json:"state"`
+	SpecRevision        int64      `json:"spec_revision"`
+	AppliedSpecRevision int64      `json:"applied_spec_revision"`
+	PendingDelete       bool       `json:"pending_delete"`
+	Errors              []envError `json:"errors"`
+}
+
+type envError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+}
+
+func summarize(cloud string, status *altinitycloud.EnvStatus) envSummary {
+	summary := envSummary{
+		Name:                status.Name,
+		Cloud:               cloud,
+		State:               state(status),
+		SpecRevision:        status.SpecRevision,
+		AppliedSpecRevision: status.AppliedSpecRevision,
+		PendingDelete:       status.PendingDelete,
+		Errors:              []envError{},
+	}
+	for _, e := range status.Errors {
+		summary.Errors = append(summary.Errors, envError{Code: e.Code, Message: e.Message, Hint: common.Hint(cloud, e.Code)})
+	}
+	return summary
+}
+
+// state sums up status in a word.
+func state(status *altinitycloud.EnvStatus) string {
+	switch {
+	case status.PendingDelete:
+		return "deleting"
+	case len(status.Errors) > 0 && status.AppliedSpecRevision == 0 && onlyDisconnected(status.Errors):
+		return "connecting"
+	case len(status.Errors) > 0:
+		return "error"
+	case status.AppliedSpecRevision >= status.SpecRevision:
+		return "ready"
+	default:
+		return "provisioning"
+	}
+}
+
+func onlyDisconnected(errs []altinitycloud.EnvError) bool {
+	for _, e := range errs {
+		if e.Code != "DISCONNECTED" && e.Code != "K8S_DISCONNECTED" {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *cli) envList(ctx context.Context, _ []string) error {
+	clouds, err := c.clouds()
+	if err != nil {
+		return err
+	}
+	summaries := []envSummary{}
+	for _, cl := range clouds {
+		statuses, err := cl.list(ctx)
+		if err != nil {
+			return fmt.Errorf("list %s envs: %s", cl.name, client.FormatError(err, ""))
+		}
+		for _, status := range statuses {
+			summaries = append(summaries, summarize(cl.name, status))
+		}
+	}
+
+	if c.output == "json" {
+		return c.printJSON(summaries)
+	}
+	rows := [][]string{{"NAME", "CLOUD", "STATE", "SPEC REVISION", "APPLIED", "ERRORS"}}
+	for _, s := range summaries {
+		var codes []string
+		for _, e := range s.Errors {
+			codes = append(codes, e.Code)
+		}
+		rows = append(rows, []string{s.Name, s.Cloud, s.State, fmt.Sprint(s.SpecRevision), fmt.Sprint(s.AppliedSpecRevision), strings.Join(codes, ",")})
+	}
+	return c.printTable(rows)
+}
+
+func (c *cli) envGet(ctx context.Context, args []string) error {
+	name := args[0]
+	cl, _, err := c.findEnv(ctx, name)
+	if err != nil {
+		return err
+	}
+	env, err := cl.get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("read env %s: %s", name, client.FormatError(err, name))
+	}
+	if c.output == "json" {
+		return c.printJSON(struct {
+			Cloud string      `json:"cloud"`
+			Env   interface{} `json:"env"`
+		}{cl.name, env})
+	}
+	fmt.Fprintf(c.stdout, "Cloud: %s\n", cl.name)
+	return c.printJSON(env)
+}
+
+func (c *cli) envStatus(ctx context.Context, args []string) error {
+	name := args[0]
+	cl, status, err := c.findEnv(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if c.wait {
+		revision := c.revision
+		if revision == 0 {
+			revision = status.SpecRevision
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+		start := time.Now()
+		status, err = altinitycloud.WaitForSpecRevision(waitCtx, cl.status, name, revision,
+			altinitycloud.WithPollInterval(c.pollInterval),
+			altinitycloud.WithProgress(func(status *altinitycloud.EnvStatus) {
+				fmt.Fprintf(c.stderr, "%s: [%s] %s, applied spec revision %d of %d\n",
+					name, time.Since(start).Round(time.Second), state(status), status.AppliedSpecRevision, revision)
+			}))
+		var provisioningErr *altinitycloud.ProvisioningError
+		if err != nil && !errors.As(err, &provisioningErr) {
+			return fmt.Errorf("wait for env %s: %s", name, client.FormatError(err, name))
+		}
+		if err := c.printStatus(summarize(cl.name, status)); err != nil {
+			return err
+		}
+		if provisioningErr != nil {
+			return fmt.Errorf("env %s has provisioning errors", name)
+		}
+		return nil
+	}
+
+	return c.printStatus(summarize(cl.name, status))
+}
+
+func (c *cli) printStatus(summary envSummary) error {
+	if c.output == "json" {
+		return c.printJSON(summary)
+	}
+	if err := c.printTable([][]string{
+		{"Name:", summary.Name},
+		{"Cloud:", summary.Cloud},
+		{"State:", summary.State},
+		{"Spec revision:", fmt.Sprint(summary.SpecRevision)},
+		{"Applied spec revision:", fmt.Sprint(summary.AppliedSpecRevision)},
+		{"Pending delete:", fmt.Sprint(summary.PendingDelete)},
+	}); err != nil {
+		return err
+	}
+	for _, e := range summary.Errors {
+		fmt.Fprintf(c.stdout, "\n%s: %s\n", e.Code, e.Message)
+		if e.Hint != "" {
+			fmt.Fprintf(c.stdout, "  Hint: %s\n", e.Hint)
+		}
+	}
+	return nil
+}
+
+func (c *cli) envCodeGen(ctx context.Context, args []string) error {
+	name := args[0]
+	cl, _, err := c.findEnv(ctx, name)
+	if err != nil {
+		return err
+	}
+	if cl.codeGen == nil {
+		return fmt.Errorf("code generation is not available for %s envs", cl.name)
+	}
+	terraform, err := cl.codeGen(ctx, name, c.boilerplate)
+	if err != nil {
+		return fmt.Errorf("generate code for env %s: %s", name, client.FormatError(err, name))
+	}
+	if c.output == "json" {
+		return c.printJSON(map[string]string{"terraform": terraform})
+	}
+	_, err = fmt.Fprint(c.stdout, terraform)
+	return err
+}
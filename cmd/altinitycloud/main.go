@@ -0,0 +1,223 @@
+// Command altinitycloud answers quick questions about Altinity.Cloud envs (is
+// it ready, what revision is applied, why is it DISCONNECTED) without running
+// Terraform. It resolves the API URL and token like the provider does: flags,
+// then the ALTINITYCLOUD_API_URL/ALTINITYCLOUD_API_TOKEN env vars, then the
+// selected profile of ~/.config/altinitycloud/config.
+//
+//	altinitycloud env list
+//	altinitycloud env status acme-staging --wait
+//	altinitycloud -o json env get acme-staging
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/credentials"
+	"github.com/altinity/terraform-provider-altinitycloud/pkg/altinitycloud"
+)
+
+var (
+	version string = "dev"
+)
+
+const usage = `Usage: altinitycloud [flags] <command> [args]
+
+Commands:
+  env list                      List envs and whether they are ready
+  env get <name>                Show the spec of an env
+  env status <name> [--wait]    Show the status of an env, optionally waiting until it is ready
+  env codegen <name>            Print the Terraform configuration of an env
+  cert issue <env>              Issue a client certificate for an env
+  secret encrypt --pem-file f   Encrypt stdin with the key of the env the certificate in f was issued for
+  version                       Print the version
+
+Flags, accepted anywhere on the command line:
+`
+
+// errUsage is returned for invalid command lines, after printing the usage.
+var errUsage = errors.New("usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// cli holds the flags and the streams of a run.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	output    string
+	settings  credentials.Settings
+	caCrtFile string
+	readOnly  bool
+	cloud     string
+
+	wait         bool
+	revision     int64
+	timeout      time.Duration
+	pollInterval time.Duration
+	boilerplate  bool
+	outFile      string
+	pemFile      string
+	valueFile    string
+
+	client *altinitycloud.Client
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr}
+	err := c.dispatch(ctx, args)
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, errUsage), errors.Is(err, flag.ErrHelp):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "Error: %s\n", err)
+		return 1
+	}
+}
+
+func (c *cli) flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("altinitycloud", flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprint(c.stderr, usage)
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&c.output, "o", "table", "output format: table or json")
+	fs.StringVar(&c.settings.APIURL, "api-url", "", "Altinity.Cloud API URL (default $"+credentials.EnvAPIURL+", then the profile)")
+	fs.StringVar(&c.settings.APITokenFile, "api-token-file", "", "file holding the API token")
+	fs.StringVar(&c.settings.APITokenCommand, "api-token-command", "", "command printing the API token")
+	fs.StringVar(&c.settings.Profile, "profile", "", "profile of the config file (default $"+credentials.EnvProfile+", then default)")
+	fs.StringVar(&c.caCrtFile, "ca-crt-file", "", "CA certificate file to verify the API with")
+	fs.BoolVar(&c.readOnly, "read-only", false, "refuse to issue certificates")
+	fs.StringVar(&c.cloud, "cloud", "", "cloud of the env: aws, aws_hosted, azure, gcp, hcloud or k8s (default: all)")
+
+	fs.BoolVar(&c.wait, "wait", false, "env status: wait until the spec revision is applied")
+	fs.Int64Var(&c.revision, "revision", 0, "env status --wait: spec revision to wait for (default: the latest)")
+	fs.DurationVar(&c.timeout, "timeout", 60*time.Minute, "env status --wait: how long to wait")
+	fs.DurationVar(&c.pollInterval, "poll-interval", altinitycloud.DefaultPollInterval, "env status --wait: how often to poll")
+	fs.BoolVar(&c.boilerplate, "boilerplate", false, "env codegen: include the API's boilerplate")
+	fs.StringVar(&c.outFile, "out", "", "cert issue: write the certificate and key to this file instead of stdout")
+	fs.StringVar(&c.pemFile, "pem-file", "", "secret encrypt: certificate and key of the env, as issued by cert issue")
+	fs.StringVar(&c.valueFile, "value-file", "", "secret encrypt: file holding the value (default: stdin)")
+	return fs
+}
+
+// parse parses args with fs, allowing flags after positional arguments, and
+// returns the positional arguments.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// commands maps the command words to the command and its number of
+// arguments.
+var commands = map[string]struct {
+	run  func(c *cli, ctx context.Context, args []string) error
+	args int
+}{
+	"env list":       {(*cli).envList, 0},
+	"env get":        {(*cli).envGet, 1},
+	"env status":     {(*cli).envStatus, 1},
+	"env codegen":    {(*cli).envCodeGen, 1},
+	"cert issue":     {(*cli).certIssue, 1},
+	"secret encrypt": {(*cli).secretEncrypt, 0},
+}
+
+func (c *cli) dispatch(ctx context.Context, args []string) error {
+	fs := c.flags()
+	positional, err := parse(fs, args)
+	if err != nil {
+		// The flag package has printed the error and the usage.
+		return errUsage
+	}
+	if len(positional) == 1 && positional[0] == "version" {
+		fmt.Fprintln(c.stdout, version)
+		return nil
+	}
+	if len(positional) < 2 {
+		fs.Usage()
+		return errUsage
+	}
+	command, ok := commands[positional[0]+" "+positional[1]]
+	if !ok {
+		fmt.Fprintf(c.stderr, "Unknown command %q.\n\n", positional[0]+" "+positional[1])
+		fs.Usage()
+		return errUsage
+	}
+	if len(positional)-2 != command.args {
+		fmt.Fprintf(c.stderr, "%s %s takes %d argument(s), got %d.\n\n", positional[0], positional[1], command.args, len(positional)-2)
+		fs.Usage()
+		return errUsage
+	}
+	if c.output != "table" && c.output != "json" {
+		return fmt.Errorf("unknown output format %q, expected table or json", c.output)
+	}
+	if err := c.connect(); err != nil {
+		return err
+	}
+	return command.run(c, ctx, positional[2:])
+}
+
+// connect creates the API client from the global flags.
+func (c *cli) connect() error {
+	resolved, err := credentials.Resolve(c.settings)
+	if errors.Is(err, credentials.ErrMissingToken) {
+		return fmt.Errorf("no API token: set %s, --api-token-file, --api-token-command or a profile with a token", credentials.EnvAPIToken)
+	}
+	if err != nil {
+		return err
+	}
+
+	opts := []altinitycloud.Option{
+		altinitycloud.WithTokenSource(resolved.Token),
+		altinitycloud.WithReadOnly(c.readOnly),
+		altinitycloud.WithUserAgent(fmt.Sprintf("altinitycloud-cli/%s", version)),
+		// A command runs its queries one after the other, so there is nothing to
+		// share, and --poll-interval may be shorter than the cache TTL.
+		altinitycloud.WithQueryCacheTTL(0),
+	}
+	if resolved.APIURL != "" {
+		opts = append(opts, altinitycloud.WithAPIURL(resolved.APIURL))
+	}
+	caCrtFile := c.caCrtFile
+	if caCrtFile == "" {
+		caCrtFile = resolved.CACrtFile
+	}
+	if caCrtFile != "" {
+		pem, err := os.ReadFile(caCrtFile)
+		if err != nil {
+			return fmt.Errorf("load CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("load CA certificate: no certificate found in %s", caCrtFile)
+		}
+		opts = append(opts, altinitycloud.WithRootCAs(pool))
+	}
+
+	c.client, err = altinitycloud.New(opts...)
+	return err
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+var listFields = map[string]string{
+	"ListAWSEnvs":       "awsEnvs",
+	"ListAWSEnvsHosted": "awsEnvsHosted",
+	"ListAzureEnvs":     "azureEnvs",
+	"ListGCPEnvs":       "gcpEnvs",
+	"ListHCloudEnvs":    "hcloudEnvs",
+	"ListK8SEnvs":       "k8sEnvs",
+}
+
+// fakeAPI answers the GraphQL operations named in responses, and every other
+// query with a null env or an empty list.
+func fakeAPI(t *testing.T, responses map[string]func() string) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			OperationName string `json:"operationName"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if respond, ok := responses[body.OperationName]; ok {
+			_, _ = w.Write([]byte(respond()))
+			return
+		}
+		if field, ok := listFields[body.OperationName]; ok {
+			_, _ = w.Write([]byte(`{"data":{"` + field + `":[]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Setenv("ALTINITYCLOUD_API_TOKEN", "t0ken")
+	t.Setenv("ALTINITYCLOUD_CONFIG_FILE", filepath.Join(t.TempDir(), "missing"))
+	return srv.URL
+}
+
+func runCLI(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(context.Background(), args, strings.NewReader(""), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestEnvList(t *testing.T) {
+	url := fakeAPI(t, map[string]func() string{
+		"ListAWSEnvs": func() string {
+			return `{"data":{"awsEnvs":[{"name":"acme-prod","specRevision":4,"status":{"appliedSpecRevision":4,"pendingDelete":false,"errors":[]}}]}}`
+		},
+		"ListGCPEnvs": func() string {
+			return `{"data":{"gcpEnvs":[{"name":"acme-dev","specRevision":1,"status":{"appliedSpecRevision":0,"pendingDelete":false,"errors":[{"code":"DISCONNECTED","message":"not connected"}]}}]}}`
+		},
+	})
+
+	code, stdout, stderr := runCLI(t, "env", "list", "--api-url", url)
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	for _, want := range []string{"acme-prod  aws    ready", "acme-dev   gcp    connecting", "DISCONNECTED"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("expected %q in:\n%s", want, stdout)
+		}
+	}
+
+	code, stdout, _ = runCLI(t, "-o", "json", "--api-url", url, "env", "list", "--cloud", "gcp")
+	var envs []envSummary
+	if err := json.Unmarshal([]byte(stdout), &envs); err != nil || code != 0 {
+		t.Fatalf("exit %d, invalid JSON %s: %v", code, stdout, err)
+	}
+	if len(envs) != 1 || envs[0].Name != "acme-dev" || envs[0].Errors[0].Hint == "" {
+		t.Errorf("expected the GCP env with a hint, got %+v", envs)
+	}
+}
+
+func TestEnvStatusWait(t *testing.T) {
+	var polls int32
+	url := fakeAPI(t, map[string]func() string{
+		"GetAzureEnvStatus": func() string {
+			applied := 1
+			if atomic.AddInt32(&polls, 1) > 2 {
+				applied = 2
+			}
+			return `{"data":{"azureEnv":{"name":"acme","specRevision":2,"status":{"appliedSpecRevision":` + string(rune('0'+applied)) + `,"pendingDelete":false,"errors":[]}}}}`
+		},
+	})
+
+	code, stdout, stderr := runCLI(t, "env", "status", "acme", "--wait", "--poll-interval", "1ms", "--api-url", url)
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "azure") || !strings.Contains(stdout, "ready") {
+		t.Errorf("expected the ready Azure env, got:\n%s", stdout)
+	}
+	if !strings.Contains(stderr, "provisioning, applied spec revision 1 of 2") {
+		t.Errorf("expected progress on stderr, got:\n%s", stderr)
+	}
+}
+
+func TestEnvStatusProvisioningErrors(t *testing.T) {
+	url := fakeAPI(t, map[string]func() string{
+		"GetK8SEnvStatus": func() string {
+			return `{"data":{"k8sEnv":{"name":"acme","specRevision":3,"status":{"appliedSpecRevision":2,"pendingDelete":false,"errors":[{"code":"DISCONNECTED","message":"lost contact"}]}}}}`
+		},
+	})
+
+	code, stdout, _ := runCLI(t, "env", "status", "acme", "--wait", "--poll-interval", "1ms", "--api-url", url)
+	if code != 1 {
+		t.Errorf("expected exit 1, got %d", code)
+	}
+	if !strings.Contains(stdout, "Hint: Altinity.Cloud lost contact with the cloud-connect agent in your cluster") {
+		t.Errorf("expected the K8S hint, got:\n%s", stdout)
+	}
+}
+
+func TestEnvStatusNotFound(t *testing.T) {
+	url := fakeAPI(t, nil)
+	if code, _, stderr := runCLI(t, "env", "status", "missing", "--api-url", url); code != 1 || !strings.Contains(stderr, "env missing not found") {
+		t.Errorf("expected a missing env to fail, got %d: %s", code, stderr)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	for _, args := range [][]string{{}, {"env"}, {"env", "frobnicate"}, {"env", "get"}, {"--no-such-flag"}} {
+		if code, _, _ := runCLI(t, args...); code != 2 {
+			t.Errorf("%v: expected exit 2, got %d", args, code)
+		}
+	}
+	if code, stdout, _ := runCLI(t, "version"); code != 0 || strings.TrimSpace(stdout) != version {
+		t.Errorf("unexpected version output %d %q", code, stdout)
+	}
+}
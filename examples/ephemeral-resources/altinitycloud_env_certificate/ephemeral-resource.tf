@@ -0,0 +1,13 @@
+# Signed on every plan and apply that needs it: the certificate and its private
+# key are never stored in the Terraform plan or state.
+ephemeral "altinitycloud_env_certificate" "this" {
+  env_name = "acme-staging"
+}
+
+# E.g. hand the certificate over to a secret manager through a write-only
+# attribute. Bump the version to store a new certificate.
+resource "aws_secretsmanager_secret_version" "certificate" {
+  secret_id                = aws_secretsmanager_secret.certificate.id
+  secret_string_wo         = ephemeral.altinitycloud_env_certificate.this.pem
+  secret_string_wo_version = 1
+}
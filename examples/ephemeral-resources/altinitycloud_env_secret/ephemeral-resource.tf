@@ -0,0 +1,23 @@
+# Neither the certificate, the plaintext nor the encrypted value are stored in
+# the Terraform plan or state.
+ephemeral "altinitycloud_env_certificate" "this" {
+  env_name = "acme-staging"
+}
+
+variable "value" {
+  type      = string
+  ephemeral = true
+}
+
+ephemeral "altinitycloud_env_secret" "this" {
+  pem   = ephemeral.altinitycloud_env_certificate.this.pem
+  value = var.value
+}
+
+# The encrypted value is ephemeral too: pass it on through write-only
+# attributes, e.g. to a secret manager.
+resource "aws_secretsmanager_secret_version" "encrypted" {
+  secret_id                = aws_secretsmanager_secret.encrypted.id
+  secret_string_wo         = ephemeral.altinitycloud_env_secret.this.secret_value
+  secret_string_wo_version = 1
+}
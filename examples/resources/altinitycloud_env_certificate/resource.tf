@@ -1,5 +1,12 @@
 resource "altinitycloud_env_certificate" "this" {
   env_name = "acme-staging"
+
+  # Re-issue the certificate on the first apply within 30 days of its expiry.
+  renew_before = "720h"
+}
+
+output "certificate_expiry" {
+  value = altinitycloud_env_certificate.this.not_after
 }
 
 # If you need to re-generate certificate, use the `terraform taint` command to force the resource to be re-created.
@@ -10,3 +10,27 @@ resource "altinitycloud_env_secret" "this" {
   pem   = altinitycloud_env_certificate.this.pem
   value = var.value
 }
+
+# With Terraform 1.11+, keep the plaintext out of the plan and state with the
+# write-only value_wo: only the encrypted value is stored. Bump
+# value_wo_version to encrypt a new value.
+variable "datadog_api_key" {
+  type      = string
+  ephemeral = true
+}
+
+resource "altinitycloud_env_secret" "datadog" {
+  pem              = altinitycloud_env_certificate.this.pem
+  value_wo         = var.datadog_api_key
+  value_wo_version = 1
+}
+
+resource "altinitycloud_env_aws" "this" {
+  name = altinitycloud_env_certificate.this.env_name
+  # ...
+
+  datadog = {
+    enabled     = true
+    enc_api_key = altinitycloud_env_secret.datadog.secret_value
+  }
+}
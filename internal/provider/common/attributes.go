@@ -129,6 +129,15 @@ func GetMaintenanceWindowAttribute(required, optional, computed bool) rschema.Li
 	}
 }
 
+// GetMaintenanceWindowsAllAttribute is the computed counterpart of
+// maintenance_windows, merged with the provider defaults.
+func GetMaintenanceWindowsAllAttribute() rschema.ListNestedAttribute {
+	attribute := GetMaintenanceWindowAttribute(false, false, true)
+	attribute.MarkdownDescription = MAINTENANCE_WINDOWS_ALL_DESCRIPTION
+	attribute.Validators = nil
+	return attribute
+}
+
 func GetCIDRAttribute(required, optional, computed bool) rschema.StringAttribute {
 	return rschema.StringAttribute{
 		Optional:            optional,
@@ -241,6 +250,16 @@ func GetAllowDeleteWhileDisconnectedAttribute(required, optional, computed bool)
 	}
 }
 
+func GetAllowSpecRevisionMismatchAttribute(required, optional, computed bool) rschema.BoolAttribute {
+	return rschema.BoolAttribute{
+		Required:            required,
+		Optional:            optional,
+		Computed:            computed,
+		MarkdownDescription: ALLOW_SPEC_REVISION_MISMATCH_DESCRIPTION,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
 func GetReservationsAttribute(required, optional, computed bool) rschema.SetAttribute {
 	// The default only takes effect on an Optional+Computed attribute (config may
 	// be null); on a Required attribute it can never fire, so skip it.
@@ -288,6 +307,16 @@ func GetTagsAttribute(required, optional, computed bool, description string) rsc
 	}
 }
 
+// GetTagsAllAttribute is the computed counterpart of a tags or labels
+// attribute, merged with the provider defaults.
+func GetTagsAllAttribute(description string) rschema.ListNestedAttribute {
+	return rschema.ListNestedAttribute{
+		NestedObject:        KeyValueAttribute,
+		Computed:            true,
+		MarkdownDescription: description,
+	}
+}
+
 var PendingDeleteAttribute = rschema.BoolAttribute{
 	Required:            false,
 	Optional:            false,
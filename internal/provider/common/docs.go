@@ -58,6 +58,7 @@ const MAINTENANCE_WINDOW_HOUR_DESCRIPTION = "Hour of the day in [0, 23] range."
 const MAINTENANCE_WINDOW_LENGTH_IN_HOURS_DESCRIPTION = "Maintenance window length in hours. 4h min, 24h max."
 const MAINTENANCE_WINDOW_ENABLED_DESCRIPTION = "Set to `true` if maintenance window is enabled, `false` otherwise. (default `false`)"
 const MAINTENANCE_WINDOW_DESCRIPTION = "List of maintenance windows during which automatic maintenance is permitted. By default updates are applied as soon as they are available."
+const MAINTENANCE_WINDOWS_ALL_DESCRIPTION = "Maintenance windows of the environment: `maintenance_windows` followed by the provider `defaults` maintenance windows of other names."
 const KEY_DESCRIPTION = "Name of the key"
 const VALUE_DESCRIPTION = "Value of the key"
 const LOAD_BALANCING_STRATEGY_DESCRIPTION = `Load balancing strategy for the environment.
@@ -103,6 +104,7 @@ const FORCE_DESTROY_DESCRIPTION = "Locks the environment for accidental deletion
 const FORCE_DESTROY_CLUSTERS_DESCRIPTION = "By default, the destroy operation will not delete any provisioned clusters and the deletion will fail until the clusters get removed. Set to `true` to remove all provisioned clusters as part of the environment deletion process."
 const SKIP_PROVISIONING_ON_DESTROY_DESCRIPTION = "Set to `true` will delete without waiting for environment deprovisioning. Use this with precaution, it may end up with dangling resources in your cloud provider (default `false`)."
 const ALLOW_DELETE_WHILE_DISCONNECTED_DESCRIPTION = "Set to `true` to allow deletion of the environment while it is disconnected from the cloud connect. If the the environment is not connected during the deletion process you will end up in a delete timeout (default `false`)."
+const ALLOW_SPEC_REVISION_MISMATCH_DESCRIPTION = "Set to `true` to update the environment even if it was modified outside of Terraform (e.g. in the Altinity.Cloud console) since the last refresh. By default the update fails listing the attributes changed on the server, as applying would overwrite them (default `false`)."
 const STATUS_DESCRIPTION = "Environment status"
 const STATUS_SPEC_REVISION_DESCRIPTION = "Spec revision"
 const STATUS_APPLIED_SPEC_REVISION_DESCRIPTION = "Applied spec revision"
@@ -115,6 +117,7 @@ const STATUS_LOAD_BALANCERS_ENDPOINT_SERVICE_NAME_DESCRIPTION = "VPC endpoint se
 // AWS descriptions.
 const AWS_ACCOUNT_ID_DESCRIPTION = "ID of the AWS account ([docs](https://docs.aws.amazon.com/IAM/latest/UserGuide/console_account-alias.html#ViewYourAWSId)) in which to provision AWS resources. **[IMMUTABLE]**"
 const AWS_TAGS_DESCRIPTION = "Tags to apply to AWS resources."
+const AWS_TAGS_ALL_DESCRIPTION = "Tags applied to AWS resources: `tags` followed by the provider `defaults` tags of other keys."
 
 const AWS_REGION_DESCRIPTION = `AWS region ([docs](https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.RegionsAndAvailabilityZones.html#Concepts.RegionsAndAvailabilityZones.Regions)). **[IMMUTABLE]**
 
@@ -199,6 +202,7 @@ const GCP_PEERING_CONNECTION_PROJECT_ID_DESCRIPTION = "Target network's project
 const GCP_PEERING_CONNECTION_NETWORK_NAME_DESCRIPTION = "Target network name."
 const GCP_PRIVATE_SERVICE_CONSUMERS_DESCRIPTION = "List of project IDs representing the network's private service consumers."
 const GCP_LABELS_DESCRIPTION = "Labels to apply to GCP resources."
+const GCP_LABELS_ALL_DESCRIPTION = "Labels applied to GCP resources: `labels` followed by the provider `defaults` labels of other keys."
 
 // K8S descriptions.
 const K8S_NODE_GROUP_NODE_TYPE_DESCRIPTION = "node.kubernetes.io/instance-type value."
@@ -258,6 +262,7 @@ const AZURE_PRIVATE_LINK_SERVICE_DESCRIPTION = "Azure Private Link service confi
 const AZURE_PRIVATE_LINK_SERVICE_ALIAS_DESCRIPTION = "Private Link Service Alias / DNS Name in prefix.GUID.suffix format."
 const AZURE_PRIVATE_LINK_SERVICE_ALLOWED_SUBSCRIPTIONS_DESCRIPTION = "Lists subscription IDs permitted for Private Link access, securing service connections."
 const AZURE_TAGS_DESCRIPTION = "Tags to apply to Azure resources."
+const AZURE_TAGS_ALL_DESCRIPTION = "Tags applied to Azure resources: `tags` followed by the provider `defaults` tags of other keys."
 
 // HCloud descriptions.
 const HCLOUD_TOKEN_ENC_DESCRIPTION = "HCloud token (stored encrypted)"
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// attributeGetter is a plan, state or config (tfsdk.Plan, tfsdk.State, tfsdk.Config).
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// envNameAttributes are the attributes holding the env name, by resource.
+var envNameAttributes = []string{"name", "env_name"}
+
+// TraceCRUD starts the span of a resource CRUD call or data source read, named
+// after resourceType and operation (e.g. "altinitycloud_env_aws Create" or
+// "data.altinitycloud_env_aws Read"), with the env name read from source, the
+// plan, state or config the call works on. The returned function
+// ends the span; it takes the resulting state, to record its spec revision,
+// and the call diagnostics, to record errors:
+//
+//	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws", "Create", req.Plan)
+//	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+func TraceCRUD(ctx context.Context, resourceType, operation string, source attributeGetter) (context.Context, func(result attributeGetter, diags diag.Diagnostics)) {
+	spanCtx, span := tracing.Start(ctx, resourceType+" "+operation,
+		tracing.AttrResourceType.String(resourceType),
+		tracing.AttrOperation.String(operation),
+	)
+	if !span.IsRecording() {
+		return spanCtx, func(attributeGetter, diag.Diagnostics) { span.End() }
+	}
+
+	for _, attribute := range envNameAttributes {
+		var envName types.String
+		if d := source.GetAttribute(ctx, path.Root(attribute), &envName); !d.HasError() && envName.ValueString() != "" {
+			span.SetAttributes(tracing.AttrEnvName.String(envName.ValueString()))
+			break
+		}
+	}
+
+	return spanCtx, func(result attributeGetter, diags diag.Diagnostics) {
+		var specRevision types.Int64
+		if result != nil {
+			if d := result.GetAttribute(ctx, path.Root("spec_revision"), &specRevision); !d.HasError() && !specRevision.IsNull() && !specRevision.IsUnknown() {
+				span.SetAttributes(tracing.AttrSpecRevision.Int64(specRevision.ValueInt64()))
+			}
+		}
+		var err error
+		if diags.HasError() {
+			var summaries []string
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			err = errors.New(strings.Join(summaries, "; "))
+		}
+		tracing.End(span, err)
+	}
+}
+
+// TracePoll wraps refresh so that each poll iteration of a wait runs in its own
+// span, named after wait, with the env name, the iteration number and the
+// resulting state as attributes. refresh gets the span context and may add
+// attributes of its own to trace.SpanFromContext(ctx).
+func TracePoll(ctx context.Context, wait, envName string, refresh func(ctx context.Context) (interface{}, string, error), attributes ...attribute.KeyValue) retry.StateRefreshFunc {
+	iteration := 0
+	return func() (interface{}, string, error) {
+		iteration++
+		spanCtx, span := tracing.Start(ctx, wait+" poll", append([]attribute.KeyValue{
+			tracing.AttrEnvName.String(envName),
+			tracing.AttrOperation.String(wait),
+			attribute.Int("altinitycloud.poll.iteration", iteration),
+		}, attributes...)...)
+		result, state, err := refresh(spanCtx)
+		if state != "" {
+			span.SetAttributes(attribute.String("altinitycloud.poll.state", state))
+		}
+		tracing.End(span, err)
+		return result, state, err
+	}
+}
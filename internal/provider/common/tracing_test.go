@@ -0,0 +1,162 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// fakeAttributes is a plan or state holding the given attributes.
+type fakeAttributes map[string]interface{}
+
+func (f fakeAttributes) GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value, ok := f[p.String()]
+	if !ok {
+		diags.AddError("no attribute", p.String())
+		return diags
+	}
+	switch target := target.(type) {
+	case *types.String:
+		*target = types.StringValue(value.(string))
+	case *types.Int64:
+		*target = types.Int64Value(value.(int64))
+	}
+	return diags
+}
+
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return exporter
+}
+
+func attributesOf(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestTraceCRUD(t *testing.T) {
+	exporter := recordSpans(t)
+
+	_, endSpan := TraceCRUD(context.Background(), "altinitycloud_env_certificate", "Create", fakeAttributes{"env_name": "acme"})
+	endSpan(fakeAttributes{"spec_revision": int64(3)}, nil)
+
+	var diags diag.Diagnostics
+	diags.AddError("Client Error", "boom")
+	_, endSpan = TraceCRUD(context.Background(), "altinitycloud_env_aws", "Update", fakeAttributes{"name": "acme"})
+	endSpan(fakeAttributes{}, diags)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	create := spans[0]
+	if create.Name != "altinitycloud_env_certificate Create" {
+		t.Errorf("unexpected span name %q", create.Name)
+	}
+	attributes := attributesOf(create)
+	if attributes[tracing.AttrEnvName].AsString() != "acme" {
+		t.Errorf("expected env name acme, got %q", attributes[tracing.AttrEnvName].AsString())
+	}
+	if attributes[tracing.AttrOperation].AsString() != "Create" {
+		t.Errorf("expected operation Create, got %q", attributes[tracing.AttrOperation].AsString())
+	}
+	if attributes[tracing.AttrSpecRevision].AsInt64() != 3 {
+		t.Errorf("expected spec revision 3, got %d", attributes[tracing.AttrSpecRevision].AsInt64())
+	}
+
+	update := spans[1]
+	if update.Status.Code != codes.Error || update.Status.Description != "Client Error" {
+		t.Errorf("expected the diagnostics to mark the span as failed, got %+v", update.Status)
+	}
+	if _, ok := attributesOf(update)[tracing.AttrSpecRevision]; ok {
+		t.Error("expected no spec revision when the state has none")
+	}
+}
+
+func TestTraceCRUDDataSourceRead(t *testing.T) {
+	exporter := recordSpans(t)
+
+	config := tfsdk.Config{
+		Schema: schema.Schema{Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Required: true}}},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+			map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "acme")}),
+	}
+	_, endSpan := TraceCRUD(context.Background(), "data.altinitycloud_env_aws_status", "Read", config)
+	endSpan(nil, nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Name != "data.altinitycloud_env_aws_status Read" {
+		t.Errorf("unexpected span name %q", spans[0].Name)
+	}
+	if got := attributesOf(spans[0])[tracing.AttrEnvName].AsString(); got != "acme" {
+		t.Errorf("expected env name acme from the config, got %q", got)
+	}
+}
+
+func TestTracePoll(t *testing.T) {
+	exporter := recordSpans(t)
+
+	states := []string{"WAITING", "READY"}
+	refresh := TracePoll(context.Background(), "WaitForSpecRevision", "acme", func(ctx context.Context) (interface{}, string, error) {
+		if len(states) == 0 {
+			return nil, "", errors.New("boom")
+		}
+		state := states[0]
+		states = states[1:]
+		return state, state, nil
+	}, attribute.Int64("altinitycloud.target_spec_revision", 2))
+
+	for range 3 {
+		_, _, _ = refresh()
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("expected a span per iteration, got %d", len(spans))
+	}
+	for i, span := range spans {
+		attributes := attributesOf(span)
+		if span.Name != "WaitForSpecRevision poll" {
+			t.Errorf("unexpected span name %q", span.Name)
+		}
+		if attributes["altinitycloud.poll.iteration"].AsInt64() != int64(i+1) {
+			t.Errorf("span %d: expected iteration %d, got %d", i, i+1, attributes["altinitycloud.poll.iteration"].AsInt64())
+		}
+		if attributes[tracing.AttrEnvName].AsString() != "acme" {
+			t.Errorf("span %d: expected env name acme", i)
+		}
+		if attributes["altinitycloud.target_spec_revision"].AsInt64() != 2 {
+			t.Errorf("span %d: expected the extra attributes to be set", i)
+		}
+	}
+	if state := attributesOf(spans[1])["altinitycloud.poll.state"].AsString(); state != "READY" {
+		t.Errorf("expected state READY, got %q", state)
+	}
+	if spans[2].Status.Code != codes.Error {
+		t.Error("expected the failed iteration to be marked as an error")
+	}
+}
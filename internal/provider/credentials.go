@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/credentials"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiSettings resolves the API URL and token source from, in order of
+// precedence, the provider attributes, the env vars and the selected profile
+// of the config file. A CA file of the profile is copied to data when the
+// configuration sets no CA.
+func apiSettings(data *altinityCloudProviderModel) (string, credentials.TokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resolved, err := credentials.Resolve(credentials.Settings{
+		APIURL:          data.ApiURL.ValueString(),
+		APIToken:        data.ApiToken.ValueString(),
+		APITokenFile:    data.ApiTokenFile.ValueString(),
+		APITokenCommand: data.ApiTokenCommand.ValueString(),
+		Profile:         data.Profile.ValueString(),
+	})
+	var settingErr *credentials.SettingError
+	switch {
+	case errors.Is(err, credentials.ErrMissingToken):
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Missing Altinity.Cloud API Token",
+			fmt.Sprintf("%s environment variable, the \"api_token\", \"api_token_file\" or \"api_token_command\" provider attribute, "+
+				"or a profile with a token is required.\n"+
+				"See https://github.com/altinity/terraform-provider-altinitycloud for details.", ENV_VAR_API_TOKEN),
+		)
+		return "", nil, diags
+	case errors.As(err, &settingErr):
+		diags.AddAttributeError(path.Root(settingErr.Setting), settingErr.Summary, settingErr.Err.Error())
+		return "", nil, diags
+	case err != nil:
+		diags.AddError("Invalid Provider Configuration", err.Error())
+		return "", nil, diags
+	}
+
+	apiUrl := resolved.APIURL
+	if apiUrl == "" {
+		apiUrl = DEFAULT_API_URL
+	}
+
+	if resolved.CACrtFile != "" && data.CACrt.IsNull() && data.CACrtFile.IsNull() {
+		data.CACrtFile = types.StringValue(resolved.CACrtFile)
+	}
+
+	return apiUrl, resolved.Token, diags
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPISettings(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config")
+	config := "[default]\napi_token = default-t0ken\n\n[staging]\napi_url = https://staging.example.com\napi_token = staging-t0ken\nca_crt_file = /etc/ssl/staging.pem\n"
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ENV_VAR_CONFIG_FILE, configPath)
+
+	tests := map[string]struct {
+		env       map[string]string
+		data      altinityCloudProviderModel
+		wantURL   string
+		wantToken string
+		wantCA    string
+		wantError bool
+	}{
+		"default profile": {
+			wantURL: DEFAULT_API_URL, wantToken: "default-t0ken",
+		},
+		"env over profile": {
+			env:     map[string]string{ENV_VAR_API_TOKEN: "env-t0ken", ENV_VAR_API_URL: "https://env.example.com"},
+			wantURL: "https://env.example.com", wantToken: "env-t0ken",
+		},
+		"attribute over env": {
+			env:     map[string]string{ENV_VAR_API_TOKEN: "env-t0ken"},
+			data:    altinityCloudProviderModel{ApiTokenCommand: types.StringValue("echo command-t0ken")},
+			wantURL: DEFAULT_API_URL, wantToken: "command-t0ken",
+		},
+		"profile from env": {
+			env:     map[string]string{ENV_VAR_PROFILE: "staging"},
+			wantURL: "https://staging.example.com", wantToken: "staging-t0ken", wantCA: "/etc/ssl/staging.pem",
+		},
+		"profile attribute over env": {
+			env:     map[string]string{ENV_VAR_PROFILE: "missing"},
+			data:    altinityCloudProviderModel{Profile: types.StringValue("staging"), CACrt: types.StringValue("PEM")},
+			wantURL: "https://staging.example.com", wantToken: "staging-t0ken",
+		},
+		"missing named profile": {
+			env:       map[string]string{ENV_VAR_PROFILE: "prod"},
+			wantError: true,
+		},
+		"conflicting attributes": {
+			data:      altinityCloudProviderModel{ApiToken: types.StringValue("t0ken"), ApiTokenFile: types.StringValue("/run/secrets/token")},
+			wantError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, key := range []string{ENV_VAR_API_TOKEN, ENV_VAR_API_URL, ENV_VAR_PROFILE} {
+				t.Setenv(key, tc.env[key])
+			}
+			data := tc.data
+			apiURL, token, diags := apiSettings(&data)
+			if tc.wantError {
+				if !diags.HasError() {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			got, err := token.Token(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if apiURL != tc.wantURL || got != tc.wantToken || data.CACrtFile.ValueString() != tc.wantCA {
+				t.Errorf("got %s, %s, CA %q; want %s, %s, CA %q", apiURL, got, data.CACrtFile.ValueString(), tc.wantURL, tc.wantToken, tc.wantCA)
+			}
+		})
+	}
+
+	t.Run("no token", func(t *testing.T) {
+		t.Setenv(ENV_VAR_CONFIG_FILE, filepath.Join(t.TempDir(), "missing"))
+		t.Setenv(ENV_VAR_API_TOKEN, "")
+		t.Setenv(ENV_VAR_PROFILE, "")
+		if _, _, diags := apiSettings(&altinityCloudProviderModel{}); !diags.HasError() {
+			t.Error("expected a missing token error")
+		}
+	})
+}
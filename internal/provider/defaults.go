@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
+	env_common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultsModel is the provider defaults block.
+type defaultsModel struct {
+	Tags               []env_common.KeyValueModel          `tfsdk:"tags"`
+	Labels             []env_common.KeyValueModel          `tfsdk:"labels"`
+	MaintenanceWindows []env_common.MaintenanceWindowModel `tfsdk:"maintenance_windows"`
+}
+
+var defaultsBlock = schema.SingleNestedBlock{
+	MarkdownDescription: "Tags, labels and maintenance windows merged into the spec of every env resource of the provider instance, e.g. to tag all the cloud resources of a team. " +
+		"The values set on a resource win: a resource tag or label overrides the default of the same key, a resource maintenance window the default of the same name. " +
+		"The merged values are exported as `tags_all`, `labels_all` and `maintenance_windows_all`. Data sources are not affected.",
+	Attributes: map[string]schema.Attribute{
+		"tags":   keyValuesAttribute("Tags applied to the cloud resources of every AWS and Azure env."),
+		"labels": keyValuesAttribute("Labels applied to the cloud resources of every GCP env."),
+		"maintenance_windows": schema.ListNestedAttribute{
+			MarkdownDescription: "Maintenance windows of every env.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: common.MAINTENANCE_WINDOW_NAME_DESCRIPTION,
+						Required:            true,
+					},
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: common.MAINTENANCE_WINDOW_ENABLED_DESCRIPTION,
+						Optional:            true,
+					},
+					"hour": schema.Int64Attribute{
+						MarkdownDescription: common.MAINTENANCE_WINDOW_HOUR_DESCRIPTION,
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.Between(0, 23)},
+					},
+					"length_in_hours": schema.Int64Attribute{
+						MarkdownDescription: common.MAINTENANCE_WINDOW_LENGTH_IN_HOURS_DESCRIPTION,
+						Required:            true,
+						Validators:          []validator.Int64{int64validator.Between(4, 24)},
+					},
+					"days": schema.ListAttribute{
+						MarkdownDescription: common.MAINTENANCE_WINDOW_DAYS_DESCRIPTION,
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(days()...)),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func keyValuesAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key":   schema.StringAttribute{Required: true},
+				"value": schema.StringAttribute{Required: true},
+			},
+		},
+	}
+}
+
+func days() []string {
+	var days []string
+	for _, day := range client.AllDay {
+		days = append(days, string(day))
+	}
+	return days
+}
+
+// defaults converts the defaults block for the env resources.
+func defaults(data *defaultsModel) sdk.Defaults {
+	if data == nil {
+		return sdk.Defaults{}
+	}
+	return sdk.Defaults{
+		Tags:               env_common.KeyValueToSDK(data.Tags),
+		Labels:             env_common.KeyValueToSDK(data.Labels),
+		MaintenanceWindows: env_common.MaintenanceWindowsToSDK(data.MaintenanceWindows),
+	}
+}
@@ -43,12 +43,39 @@ type AWSEnvModel struct {
 	ForceDestroyClusters         types.Bool  `tfsdk:"force_destroy_clusters"`
 	SkipDeprovisionOnDestroy     types.Bool  `tfsdk:"skip_deprovision_on_destroy"`
 	AllowDeleteWhileDisconnected types.Bool  `tfsdk:"allow_delete_while_disconnected"`
+	AllowSpecRevisionMismatch    types.Bool  `tfsdk:"allow_spec_revision_mismatch"`
 }
 
 // Split models: `timeouts` only exists on the resource schema and the framework requires an exact struct/schema match.
 type AWSEnvResourceModel struct {
 	AWSEnvModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	TagsAll               []common.KeyValueModel          `tfsdk:"tags_all"`
+	MaintenanceWindowsAll []common.MaintenanceWindowModel `tfsdk:"maintenance_windows_all"`
+	Timeouts              timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// withDefaults returns the env to send to the API: its tags and maintenance
+// windows merged with the provider defaults.
+func (model *AWSEnvResourceModel) withDefaults(defaults common.Defaults) *AWSEnvModel {
+	env := model.AWSEnvModel
+	env.Tags = common.MergeTags(defaults.Tags, model.Tags)
+	env.MaintenanceWindows = common.MergeMaintenanceWindows(defaults.MaintenanceWindows, model.MaintenanceWindows)
+	return &env
+}
+
+// applyDefaults splits the tags and maintenance windows of the env as read into
+// the resource's own and all of them, given prior, the env before the read.
+func (model *AWSEnvResourceModel) applyDefaults(prior AWSEnvModel, defaults common.Defaults) {
+	model.Tags, model.TagsAll = common.SplitTags(prior.Tags, model.Tags, defaults.Tags)
+	model.MaintenanceWindows, model.MaintenanceWindowsAll = common.SplitMaintenanceWindows(prior.MaintenanceWindows, model.MaintenanceWindows, defaults.MaintenanceWindows)
+}
+
+// applyEnvWithDefaults is applyEnv for the resource.
+func (model *AWSEnvResourceModel) applyEnvWithDefaults(ctx context.Context, env *sdk.GetAWSEnv_AWSEnv, defaults common.Defaults) diag.Diagnostics {
+	prior := model.AWSEnvModel
+	diags := model.applyEnv(ctx, env)
+	model.applyDefaults(prior, defaults)
+	return diags
 }
 
 type AWSEnvDataSourceModel struct {
@@ -242,6 +269,32 @@ func (e AWSEnvModel) toSDK(ctx context.Context) (sdk.CreateAWSEnvInput, sdk.Upda
 	return create, update, allDiags
 }
 
+// applyEnv maps the env returned by the API, after reordering its lists to
+// respect the order in the user's configuration.
+func (model *AWSEnvModel) applyEnv(ctx context.Context, env *sdk.GetAWSEnv_AWSEnv) diag.Diagnostics {
+	var allDiags diag.Diagnostics
+
+	env.Spec.NodeGroups = common.ReorderByKey(model.NodeGroups, env.Spec.NodeGroups,
+		func(m common.NodeGroupsModel) string { return m.NodeType.ValueString() },
+		func(s *sdk.AWSEnvSpecFragment_NodeGroups) string { return s.NodeType },
+	)
+	allDiags.Append(reorderNodeGroupZones(ctx, model.NodeGroups, env.Spec.NodeGroups)...)
+	zones, diags := common.ReorderList(ctx, model.Zones, env.Spec.Zones)
+	allDiags.Append(diags...)
+	env.Spec.Zones = zones
+	env.Spec.Tags = common.ReorderByKey(model.Tags, env.Spec.Tags,
+		func(m common.KeyValueModel) string { return m.Key.ValueString() },
+		func(s *sdk.AWSEnvSpecFragment_Tags) string { return s.Key },
+	)
+	env.Spec.PeeringConnections = common.ReorderByKey(model.PeeringConnections, env.Spec.PeeringConnections,
+		func(m AWSEnvPeeringConnectionModel) string { return m.VpcID.ValueString() },
+		func(s *sdk.AWSEnvSpecFragment_PeeringConnections) string { return s.VpcID },
+	)
+	allDiags.Append(model.toModel(*env)...)
+
+	return allDiags
+}
+
 func (model *AWSEnvModel) toModel(env sdk.GetAWSEnv_AWSEnv) diag.Diagnostics {
 	var allDiags diag.Diagnostics
 	model.Name = types.StringValue(env.Name)
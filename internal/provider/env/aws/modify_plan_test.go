@@ -1,11 +1,109 @@
 package env
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
+	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/testutil"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestAWSModifyPlanSpecRevision(t *testing.T) {
 	testutil.AssertModifyPlanSpecRevision(t, &AWSEnvResource{})
 }
+
+func TestAWSModifyPlanDefaults(t *testing.T) {
+	ctx := context.Background()
+	r := &AWSEnvResource{}
+	r.Defaults = common.Defaults{Tags: []common.KeyValueModel{
+		{Key: types.StringValue("owner"), Value: types.StringValue("ops")},
+		{Key: types.StringValue("team"), Value: types.StringValue("default")},
+	}}
+
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+	objType := sresp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for n, at := range objType.AttributeTypes {
+		vals[n] = tftypes.NewValue(at, nil)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "env")
+	tagsType := objType.AttributeTypes["tags"].(tftypes.List)
+	vals["tags"] = tftypes.NewValue(tagsType, []tftypes.Value{
+		tftypes.NewValue(tagsType.ElementType, map[string]tftypes.Value{
+			"key":   tftypes.NewValue(tftypes.String, "team"),
+			"value": tftypes.NewValue(tftypes.String, "a"),
+		}),
+	})
+	plan := tfsdk.Plan{Raw: tftypes.NewValue(objType, vals), Schema: sresp.Schema}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(objType, nil), Schema: sresp.Schema},
+		Plan:  plan,
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics.Errors())
+	}
+
+	var got AWSEnvResourceModel
+	if d := resp.Plan.Get(ctx, &got); d.HasError() {
+		t.Fatal(d.Errors())
+	}
+	expected := []common.KeyValueModel{
+		{Key: types.StringValue("team"), Value: types.StringValue("a")},
+		{Key: types.StringValue("owner"), Value: types.StringValue("ops")},
+	}
+	if !reflect.DeepEqual(got.TagsAll, expected) {
+		t.Errorf("expected tags_all %v, got %v", expected, got.TagsAll)
+	}
+	if got.MaintenanceWindowsAll != nil {
+		t.Errorf("expected no maintenance_windows_all, got %v", got.MaintenanceWindowsAll)
+	}
+}
+
+func TestAWSModifyPlanPolicy(t *testing.T) {
+	ctx := context.Background()
+	r := NewAWSEnvResource().(*AWSEnvResource)
+	r.Defaults = common.Defaults{Tags: []common.KeyValueModel{
+		{Key: types.StringValue("owner"), Value: types.StringValue("ops")},
+	}}
+	r.Policy = sdk.Policy{
+		AllowedRegions:  map[string][]string{"aws": {"us-east-1"}},
+		RequiredTagKeys: []string{"owner", "cost-center"},
+	}
+
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+	objType := sresp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for n, at := range objType.AttributeTypes {
+		vals[n] = tftypes.NewValue(at, nil)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "env")
+	vals["region"] = tftypes.NewValue(tftypes.String, "eu-west-1")
+	plan := tfsdk.Plan{Raw: tftypes.NewValue(objType, vals), Schema: sresp.Schema}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(objType, nil), Schema: sresp.Schema},
+		Plan:  plan,
+	}, resp)
+
+	var paths []string
+	for _, d := range resp.Diagnostics.Errors() {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			paths = append(paths, withPath.Path().String())
+		}
+	}
+	if expected := []string{"region", "tags"}; !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected policy errors at %v, got %v", expected, resp.Diagnostics.Errors())
+	}
+}
@@ -2,12 +2,15 @@ package env
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,7 +22,7 @@ var _ resource.ResourceWithImportState = &AWSEnvResource{}
 var _ resource.ResourceWithValidateConfig = &AWSEnvResource{}
 
 func NewAWSEnvResource() resource.Resource {
-	return &AWSEnvResource{}
+	return &AWSEnvResource{EnvResourceBase: common.EnvResourceBase{Cloud: envstatus.CloudAWS}}
 }
 
 type AWSEnvResource struct {
@@ -47,6 +50,9 @@ func (r *AWSEnvResource) ValidateConfig(ctx context.Context, req resource.Valida
 }
 
 func (r *AWSEnvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -58,7 +64,7 @@ func (r *AWSEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	envName := data.Name.ValueString()
 	tflog.Trace(ctx, "creating resource", map[string]interface{}{"name": envName})
 
-	sdkEnv, _, diags := data.toSDK(ctx)
+	sdkEnv, _, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -66,7 +72,28 @@ func (r *AWSEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	apiResp, err := r.Client.CreateAWSEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to create env %s, got error: %s", envName, client.FormatError(err, envName)))
+		// The create may have been committed even though the response was lost.
+		adopted, diags := common.ReconcileCreate(ctx, req.Plan, envName, err,
+			func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+				current, err := r.Client.GetAWSEnv(ctx, envName)
+				if err != nil || current.AWSEnv == nil {
+					return nil, nil, err
+				}
+				model := *data
+				diags := model.applyEnvWithDefaults(ctx, current.AWSEnv, r.Defaults)
+				return &common.SpecRevisionResult{SpecRevision: current.AWSEnv.SpecRevision, Model: &model}, diags, nil
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if adopted == nil {
+			common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to create env %s", envName), err, envName)
+			return
+		}
+
+		data = adopted.(*AWSEnvResourceModel)
+		data.Id = data.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 		return
 	}
 
@@ -101,9 +128,13 @@ func (r *AWSEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created resource", map[string]interface{}{"name": envName})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *AWSEnvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvResourceModel
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -116,8 +147,7 @@ func (r *AWSEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 	apiResp, err := r.Client.GetAWSEnv(ctx, envName)
 
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "removing resource from state", map[string]interface{}{"name": envName})
 			resp.State.RemoveResource(ctx)
 		} else {
@@ -126,23 +156,7 @@ func (r *AWSEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Reorder node groups, zones and tags to respect order in the user's configuration
-	apiResp.AWSEnv.Spec.NodeGroups = common.ReorderByKey(data.NodeGroups, apiResp.AWSEnv.Spec.NodeGroups,
-		func(m common.NodeGroupsModel) string { return m.NodeType.ValueString() },
-		func(s *client.AWSEnvSpecFragment_NodeGroups) string { return s.NodeType },
-	)
-	resp.Diagnostics.Append(reorderNodeGroupZones(ctx, data.NodeGroups, apiResp.AWSEnv.Spec.NodeGroups)...)
-	apiResp.AWSEnv.Spec.Zones, diags = common.ReorderList(ctx, data.Zones, apiResp.AWSEnv.Spec.Zones)
-	resp.Diagnostics.Append(diags...)
-	apiResp.AWSEnv.Spec.Tags = common.ReorderByKey(data.Tags, apiResp.AWSEnv.Spec.Tags,
-		func(m common.KeyValueModel) string { return m.Key.ValueString() },
-		func(s *client.AWSEnvSpecFragment_Tags) string { return s.Key },
-	)
-	apiResp.AWSEnv.Spec.PeeringConnections = common.ReorderByKey(data.PeeringConnections, apiResp.AWSEnv.Spec.PeeringConnections,
-		func(m AWSEnvPeeringConnectionModel) string { return m.VpcID.ValueString() },
-		func(s *client.AWSEnvSpecFragment_PeeringConnections) string { return s.VpcID },
-	)
-	diags = data.toModel(*apiResp.AWSEnv)
+	diags = data.applyEnvWithDefaults(ctx, apiResp.AWSEnv, r.Defaults)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -151,9 +165,13 @@ func (r *AWSEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.SeedAppliedSpecRevision(ctx, resp.Private, envName)...)
 }
 
 func (r *AWSEnvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -165,15 +183,35 @@ func (r *AWSEnvResource) Update(ctx context.Context, req resource.UpdateRequest,
 	envName := data.Name.ValueString()
 	tflog.Trace(ctx, "updating resource", map[string]interface{}{"name": envName})
 
-	_, sdkEnv, diags := data.toSDK(ctx)
+	_, sdkEnv, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(common.CheckSpecRevision(ctx, req.State, envName, data.AllowSpecRevisionMismatch.ValueBool(),
+		func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+			var prior *AWSEnvResourceModel
+			diags := req.State.Get(ctx, &prior)
+			if diags.HasError() {
+				return nil, diags, nil
+			}
+			current, err := r.Client.GetAWSEnv(ctx, envName)
+			if err != nil || current.AWSEnv == nil {
+				return nil, diags, err
+			}
+			diags.Append(prior.applyEnvWithDefaults(ctx, current.AWSEnv, r.Defaults)...)
+			return &common.SpecRevisionResult{SpecRevision: current.AWSEnv.SpecRevision, Model: prior}, diags, nil
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, err := r.Client.UpdateAWSEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update env %s, got error: %s", envName, client.FormatError(err, envName)))
+		common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to update env %s", envName), err, envName)
 		return
 	}
 
@@ -207,9 +245,13 @@ func (r *AWSEnvResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated resource", map[string]interface{}{"name": envName})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *AWSEnvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -226,8 +268,7 @@ func (r *AWSEnvResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	envStatus, err := r.Client.GetAWSEnvStatus(ctx, envName)
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "deleted resource", map[string]interface{}{"name": envName})
 		} else {
 			clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read env status %s, got error: %s", envName, err))
@@ -238,6 +279,7 @@ func (r *AWSEnvResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if len(envStatus.AWSEnv.Status.Errors) > 0 {
 		for _, err := range envStatus.AWSEnv.Status.Errors {
 			resp.Diagnostics.Append(common.ValidateDisconnected(
+				envstatus.CloudAWS,
 				envName,
 				string(err.Code),
 				envStatus.AWSEnv.Status.AppliedSpecRevision,
@@ -35,6 +35,7 @@ func (r *AWSEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"load_balancers":                  getLoadBalancersAttribute(false, true, true),
 			"load_balancing_strategy":         common.GetLoadBalancingStrategyAttribute(false, true, true),
 			"maintenance_windows":             common.GetMaintenanceWindowAttribute(false, true, false),
+			"maintenance_windows_all":         common.GetMaintenanceWindowsAllAttribute(),
 			"cidr":                            common.GetCIDRAttribute(true, false, false),
 			"zones":                           getZonesAttribute(false, true, true, common.AWS_ZONES_DESCRIPTION),
 			"node_groups":                     common.GetNodeGroupsAttribute(true, false, false),
@@ -44,6 +45,7 @@ func (r *AWSEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"peering_connections":             getPeeringConnectionsAttribute(false, true, false),
 			"endpoints":                       getEndpointsAttribute(false, true, false),
 			"tags":                            getTagsAttribute(false, true, false),
+			"tags_all":                        common.GetTagsAllAttribute(common.AWS_TAGS_ALL_DESCRIPTION),
 			"cloud_connect":                   getCloudConnectAttribute(false, true, true),
 			"resource_prefix":                 getResourcePrefixAttribute(false, true, true),
 			"permissions_boundary_policy_arn": getPermissionsBoundaryPolicyArnAttribute(false, true, false),
@@ -60,6 +62,7 @@ func (r *AWSEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, true, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, true, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, true, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, true, true),
 		},
 		Blocks: map[string]rschema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -107,6 +110,7 @@ func (d *AWSEnvDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, false, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, false, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, false, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, false, true),
 		},
 	}
 }
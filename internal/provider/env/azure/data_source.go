@@ -49,6 +49,9 @@ func (d *AzureEnvDataSource) Configure(ctx context.Context, req datasource.Confi
 }
 
 func (d *AzureEnvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_azure", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading Azure env state source")
 
 	var data AzureEnvDataSourceModel
@@ -34,12 +34,39 @@ type AzureEnvModel struct {
 	ForceDestroyClusters         types.Bool  `tfsdk:"force_destroy_clusters"`
 	SkipDeprovisionOnDestroy     types.Bool  `tfsdk:"skip_deprovision_on_destroy"`
 	AllowDeleteWhileDisconnected types.Bool  `tfsdk:"allow_delete_while_disconnected"`
+	AllowSpecRevisionMismatch    types.Bool  `tfsdk:"allow_spec_revision_mismatch"`
 }
 
 // Split models: `timeouts` only exists on the resource schema and the framework requires an exact struct/schema match.
 type AzureEnvResourceModel struct {
 	AzureEnvModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	TagsAll               []common.KeyValueModel          `tfsdk:"tags_all"`
+	MaintenanceWindowsAll []common.MaintenanceWindowModel `tfsdk:"maintenance_windows_all"`
+	Timeouts              timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// withDefaults returns the env to send to the API: its tags and maintenance
+// windows merged with the provider defaults.
+func (model *AzureEnvResourceModel) withDefaults(defaults common.Defaults) *AzureEnvModel {
+	env := model.AzureEnvModel
+	env.Tags = common.MergeTags(defaults.Tags, model.Tags)
+	env.MaintenanceWindows = common.MergeMaintenanceWindows(defaults.MaintenanceWindows, model.MaintenanceWindows)
+	return &env
+}
+
+// applyDefaults splits the tags and maintenance windows of the env as read into
+// the resource's own and all of them, given prior, the env before the read.
+func (model *AzureEnvResourceModel) applyDefaults(prior AzureEnvModel, defaults common.Defaults) {
+	model.Tags, model.TagsAll = common.SplitTags(prior.Tags, model.Tags, defaults.Tags)
+	model.MaintenanceWindows, model.MaintenanceWindowsAll = common.SplitMaintenanceWindows(prior.MaintenanceWindows, model.MaintenanceWindows, defaults.MaintenanceWindows)
+}
+
+// applyEnvWithDefaults is applyEnv for the resource.
+func (model *AzureEnvResourceModel) applyEnvWithDefaults(ctx context.Context, env *client.GetAzureEnv_AzureEnv, defaults common.Defaults) diag.Diagnostics {
+	prior := model.AzureEnvModel
+	diags := model.applyEnv(ctx, env)
+	model.applyDefaults(prior, defaults)
+	return diags
 }
 
 type AzureEnvDataSourceModel struct {
@@ -152,6 +179,28 @@ func (e AzureEnvModel) toSDK(ctx context.Context) (client.CreateAzureEnvInput, c
 	return create, update, allDiags
 }
 
+// applyEnv maps the env returned by the API, after reordering its lists to
+// respect the order in the user's configuration.
+func (model *AzureEnvModel) applyEnv(ctx context.Context, env *client.GetAzureEnv_AzureEnv) diag.Diagnostics {
+	var allDiags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	env.Spec.NodeGroups = common.ReorderByKey(model.NodeGroups, env.Spec.NodeGroups,
+		func(m common.NodeGroupsModel) string { return m.NodeType.ValueString() },
+		func(s *client.AzureEnvSpecFragment_NodeGroups) string { return s.NodeType },
+	)
+	allDiags.Append(reorderNodeGroupZones(ctx, model.NodeGroups, env.Spec.NodeGroups)...)
+	env.Spec.Zones, diags = common.ReorderList(ctx, model.Zones, env.Spec.Zones)
+	allDiags.Append(diags...)
+	env.Spec.Tags = common.ReorderByKey(model.Tags, env.Spec.Tags,
+		func(m common.KeyValueModel) string { return m.Key.ValueString() },
+		func(s *client.AzureEnvSpecFragment_Tags) string { return s.Key },
+	)
+	allDiags.Append(model.toModel(*env)...)
+
+	return allDiags
+}
+
 func (model *AzureEnvModel) toModel(env client.GetAzureEnv_AzureEnv) diag.Diagnostics {
 	var allDiags diag.Diagnostics
 	model.Name = types.StringValue(env.Name)
@@ -2,13 +2,16 @@ package env
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,7 +22,7 @@ var _ resource.ResourceWithImportState = &AzureEnvResource{}
 var _ resource.ResourceWithValidateConfig = &AzureEnvResource{}
 
 func NewAzureEnvResource() resource.Resource {
-	return &AzureEnvResource{}
+	return &AzureEnvResource{EnvResourceBase: common.EnvResourceBase{Cloud: envstatus.CloudAzure}}
 }
 
 type AzureEnvResource struct {
@@ -47,6 +50,9 @@ func (r *AzureEnvResource) ValidateConfig(ctx context.Context, req resource.Vali
 }
 
 func (r *AzureEnvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_azure", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AzureEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -58,7 +64,7 @@ func (r *AzureEnvResource) Create(ctx context.Context, req resource.CreateReques
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "creating resource", map[string]interface{}{"name": name})
 
-	sdkEnv, _, diags := data.toSDK(ctx)
+	sdkEnv, _, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -67,7 +73,28 @@ func (r *AzureEnvResource) Create(ctx context.Context, req resource.CreateReques
 	apiResp, err := r.Client.CreateAzureEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to create env %s, got error: %s", name, client.FormatError(err, name)))
+		// The create may have been committed even though the response was lost.
+		adopted, diags := common.ReconcileCreate(ctx, req.Plan, name, err,
+			func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+				current, err := r.Client.GetAzureEnv(ctx, name)
+				if err != nil || current.AzureEnv == nil {
+					return nil, nil, err
+				}
+				model := *data
+				diags := model.applyEnvWithDefaults(ctx, current.AzureEnv, r.Defaults)
+				return &common.SpecRevisionResult{SpecRevision: current.AzureEnv.SpecRevision, Model: &model}, diags, nil
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if adopted == nil {
+			common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to create env %s", name), err, name)
+			return
+		}
+
+		data = adopted.(*AzureEnvResourceModel)
+		data.Id = data.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 		return
 	}
 
@@ -97,9 +124,13 @@ func (r *AzureEnvResource) Create(ctx context.Context, req resource.CreateReques
 	tflog.Trace(ctx, "created resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *AzureEnvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_azure", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AzureEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -113,8 +144,7 @@ func (r *AzureEnvResource) Read(ctx context.Context, req resource.ReadRequest, r
 	apiResp, err := r.Client.GetAzureEnv(ctx, envName)
 
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "removing resource from state", map[string]interface{}{"name": envName})
 			resp.State.RemoveResource(ctx)
 		} else {
@@ -123,19 +153,7 @@ func (r *AzureEnvResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Reorder node groups, zones and tags to respect order in the user's configuration
-	apiResp.AzureEnv.Spec.NodeGroups = common.ReorderByKey(data.NodeGroups, apiResp.AzureEnv.Spec.NodeGroups,
-		func(m common.NodeGroupsModel) string { return m.NodeType.ValueString() },
-		func(s *client.AzureEnvSpecFragment_NodeGroups) string { return s.NodeType },
-	)
-	resp.Diagnostics.Append(reorderNodeGroupZones(ctx, data.NodeGroups, apiResp.AzureEnv.Spec.NodeGroups)...)
-	apiResp.AzureEnv.Spec.Zones, diags = common.ReorderList(ctx, data.Zones, apiResp.AzureEnv.Spec.Zones)
-	resp.Diagnostics.Append(diags...)
-	apiResp.AzureEnv.Spec.Tags = common.ReorderByKey(data.Tags, apiResp.AzureEnv.Spec.Tags,
-		func(m common.KeyValueModel) string { return m.Key.ValueString() },
-		func(s *client.AzureEnvSpecFragment_Tags) string { return s.Key },
-	)
-	diags = data.toModel(*apiResp.AzureEnv)
+	diags = data.applyEnvWithDefaults(ctx, apiResp.AzureEnv, r.Defaults)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -144,9 +162,13 @@ func (r *AzureEnvResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.SeedAppliedSpecRevision(ctx, resp.Private, envName)...)
 }
 
 func (r *AzureEnvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_azure", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AzureEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -158,15 +180,35 @@ func (r *AzureEnvResource) Update(ctx context.Context, req resource.UpdateReques
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "updating resource", map[string]interface{}{"name": name})
 
-	_, sdkEnv, diags := data.toSDK(ctx)
+	_, sdkEnv, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(common.CheckSpecRevision(ctx, req.State, name, data.AllowSpecRevisionMismatch.ValueBool(),
+		func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+			var prior *AzureEnvResourceModel
+			diags := req.State.Get(ctx, &prior)
+			if diags.HasError() {
+				return nil, diags, nil
+			}
+			current, err := r.Client.GetAzureEnv(ctx, name)
+			if err != nil || current.AzureEnv == nil {
+				return nil, diags, err
+			}
+			diags.Append(prior.applyEnvWithDefaults(ctx, current.AzureEnv, r.Defaults)...)
+			return &common.SpecRevisionResult{SpecRevision: current.AzureEnv.SpecRevision, Model: prior}, diags, nil
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, err := r.Client.UpdateAzureEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update env %s, got error: %s", name, client.FormatError(err, name)))
+		common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to update env %s", name), err, name)
 		return
 	}
 
@@ -195,9 +237,13 @@ func (r *AzureEnvResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Trace(ctx, "updated resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *AzureEnvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_azure", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AzureEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -214,8 +260,7 @@ func (r *AzureEnvResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	envStatus, err := r.Client.GetAzureEnvStatus(ctx, envName)
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "deleted resource", map[string]interface{}{"name": envName})
 		} else {
 			clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read env status %s, got error: %s", envName, err))
@@ -226,6 +271,7 @@ func (r *AzureEnvResource) Delete(ctx context.Context, req resource.DeleteReques
 	if len(envStatus.AzureEnv.Status.Errors) > 0 {
 		for _, err := range envStatus.AzureEnv.Status.Errors {
 			resp.Diagnostics.Append(common.ValidateDisconnected(
+				envstatus.CloudAzure,
 				envName,
 				string(err.Code),
 				envStatus.AzureEnv.Status.AppliedSpecRevision,
@@ -30,6 +30,7 @@ func (r *AzureEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 			"load_balancers":                  getLoadBalancersAttribute(false, true, true),
 			"load_balancing_strategy":         common.GetLoadBalancingStrategyAttribute(false, true, true),
 			"maintenance_windows":             common.GetMaintenanceWindowAttribute(false, true, false),
+			"maintenance_windows_all":         common.GetMaintenanceWindowsAllAttribute(),
 			"cidr":                            common.GetCIDRAttribute(true, false, false),
 			"zones":                           common.GetZonesAttribute(false, true, true, common.AZURE_ZONES_DESCRIPTION),
 			"node_groups":                     common.GetNodeGroupsAttribute(true, false, false),
@@ -37,6 +38,7 @@ func (r *AzureEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 			"tenant_id":                       getAzureTenantIDAttribute(true, false, false),
 			"subscription_id":                 getAzureSubscriptionIDAttribute(true, false, false),
 			"tags":                            getTagsAttribute(false, true, false),
+			"tags_all":                        common.GetTagsAllAttribute(common.AZURE_TAGS_ALL_DESCRIPTION),
 			"private_link_service":            getPrivateLinkServiceAttribute(false, true, true),
 			"metrics_endpoint":                common.GetMetricsEndpointAttribute(false, true, true),
 			"datadog":                         common.GetDatadogAttribute(false, true, false),
@@ -45,6 +47,7 @@ func (r *AzureEnvResource) Schema(ctx context.Context, req resource.SchemaReques
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, true, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, true, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, true, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, true, true),
 		},
 		Blocks: map[string]rschema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -83,6 +86,7 @@ func (d *AzureEnvDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, false, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, false, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, false, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, false, true),
 		},
 	}
 }
@@ -0,0 +1,158 @@
+package env
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// schemaTypes is the part of a resource schema needed to validate a path.
+type schemaTypes interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// AddMutationError reports a failed create/update mutation. GraphQL errors that
+// point at an input field are reported on the matching Terraform attribute
+// (e.g. node_groups[2].capacity_per_zone); the rest fall back to a client error.
+// summary prefixes every message, e.g. "Unable to update env acme".
+func AddMutationError(ctx context.Context, diags *diag.Diagnostics, schema schemaTypes, summary string, err error, envName string) {
+	apiErr, ok := client.AsAPIError(err)
+	if !ok || len(apiErr.Errors) == 0 {
+		clientsupport.AddClientError(diags, fmt.Sprintf("%s, got error: %s", summary, client.FormatError(err, envName)))
+		return
+	}
+
+	var unmapped []client.GraphQLError
+	for _, gqlError := range apiErr.Errors {
+		attributePath, ok := AttributePath(ctx, schema, InputPath(gqlError))
+		if !ok {
+			unmapped = append(unmapped, gqlError)
+			continue
+		}
+		diags.AddAttributeError(attributePath, "Invalid Env Configuration", fmt.Sprintf("%s: %s", summary, gqlError.Message))
+	}
+	if len(unmapped) > 0 {
+		clientsupport.AddClientError(diags, fmt.Sprintf("%s, got error: %s", summary, client.FormatError(&client.APIError{Errors: unmapped}, envName)))
+	}
+}
+
+// inputFieldPattern splits a dotted input path ("spec.nodeGroups[2].zones").
+var inputFieldPattern = regexp.MustCompile(`[^.\[\]]+`)
+
+// InputPath returns the path of the mutation input field a GraphQL error is
+// about, relative to the input object, or nil if it has none. It is taken from
+// extensions.field (a dotted path) or from the error path when it runs through
+// the input argument, as in variable validation errors
+// (["variable", "input", "spec", "nodeGroups", 2, "capacityPerZone"]).
+func InputPath(gqlError client.GraphQLError) []interface{} {
+	if field, ok := gqlError.Extensions["field"].(string); ok && field != "" {
+		var elements []interface{}
+		for _, part := range inputFieldPattern.FindAllString(strings.TrimPrefix(field, "input."), -1) {
+			if i, err := strconv.Atoi(part); err == nil {
+				elements = append(elements, i)
+			} else {
+				elements = append(elements, part)
+			}
+		}
+		return elements
+	}
+
+	for i, element := range gqlError.Path {
+		if element == "input" {
+			return normalizePathElements(gqlError.Path[i+1:])
+		}
+	}
+	return nil
+}
+
+// normalizePathElements turns JSON-decoded indices (float64) into ints.
+func normalizePathElements(elements []interface{}) []interface{} {
+	normalized := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		if f, ok := element.(float64); ok {
+			normalized = append(normalized, int(f))
+			continue
+		}
+		normalized = append(normalized, element)
+	}
+	return normalized
+}
+
+// AttributePath translates a GraphQL input path into a Terraform attribute
+// path of schema. Field names are converted to snake case and each step is
+// checked against the schema, so the per-env naming (capacity_per_location,
+// zone_ids, labels, ...) is resolved by the env's own schema. Steps the schema
+// can't follow (e.g. an index into a set) stop the translation at the deepest
+// attribute found. The spec wrapper is skipped, since env attributes are flat.
+func AttributePath(ctx context.Context, schema schemaTypes, inputPath []interface{}) (path.Path, bool) {
+	if len(inputPath) > 0 && inputPath[0] == "spec" {
+		inputPath = inputPath[1:]
+	}
+	if len(inputPath) == 0 {
+		return path.Empty(), false
+	}
+
+	name, ok := inputPath[0].(string)
+	if !ok {
+		return path.Empty(), false
+	}
+	current := path.Root(SnakeCase(name))
+	if _, d := schema.TypeAtPath(ctx, current); d.HasError() {
+		return path.Empty(), false
+	}
+
+	for _, element := range inputPath[1:] {
+		currentType, _ := schema.TypeAtPath(ctx, current)
+		var next path.Path
+		switch e := element.(type) {
+		case int:
+			if _, isList := currentType.(basetypes.ListTypable); !isList {
+				return current, true
+			}
+			next = current.AtListIndex(e)
+		case string:
+			next = current.AtName(SnakeCase(e))
+		default:
+			return current, true
+		}
+		if _, d := schema.TypeAtPath(ctx, next); d.HasError() {
+			return current, true
+		}
+		current = next
+	}
+	return current, true
+}
+
+// SnakeCase converts a GraphQL field name to the Terraform attribute naming,
+// keeping acronyms together, plural ones included ("sourceIPRanges" ->
+// source_ip_ranges, "zoneIDs" -> zone_ids, "customS3Bucket" -> custom_s3_bucket).
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			startsWord := unicode.IsLower(prev) || unicode.IsDigit(prev)
+			if unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				// "IPRanges": R starts a word, but the s of "IDs" is a plural.
+				pluralAcronym := runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2]))
+				startsWord = !pluralAcronym
+			}
+			if startsWord {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,158 @@
+package env
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func apiErrorsTestSchema() rschema.Schema {
+	return rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"name": rschema.StringAttribute{Required: true},
+			"node_groups": rschema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: rschema.NestedAttributeObject{Attributes: map[string]rschema.Attribute{
+					"node_type":         rschema.StringAttribute{Required: true},
+					"capacity_per_zone": rschema.Int64Attribute{Required: true},
+					"zones":             rschema.ListAttribute{Optional: true, ElementType: types.StringType},
+				}},
+			},
+			"peering_connections": rschema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: rschema.NestedAttributeObject{Attributes: map[string]rschema.Attribute{
+					"vpc_id": rschema.StringAttribute{Required: true},
+				}},
+			},
+			"load_balancers": rschema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]rschema.Attribute{
+					"public": rschema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]rschema.Attribute{
+							"source_ip_ranges": rschema.ListAttribute{Optional: true, ElementType: types.StringType},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"name":                   "name",
+		"capacityPerZone":        "capacity_per_zone",
+		"sourceIPRanges":         "source_ip_ranges",
+		"zoneIDs":                "zone_ids",
+		"allowedIPs":             "allowed_ips",
+		"vpcID":                  "vpc_id",
+		"kmsKeyARN":              "kms_key_arn",
+		"customS3Bucket":         "custom_s3_bucket",
+		"customS3TableBucketARN": "custom_s3_table_bucket_arn",
+		"NAT":                    "nat",
+		"privateDNS":             "private_dns",
+	}
+	for in, want := range tests {
+		if got := SnakeCase(in); got != want {
+			t.Errorf("SnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+	schema := apiErrorsTestSchema()
+
+	tests := map[string]struct {
+		gqlError client.GraphQLError
+		want     string
+		wantOK   bool
+	}{
+		"variable path into a node group": {
+			gqlError: client.GraphQLError{Path: []interface{}{"variable", "input", "spec", "nodeGroups", float64(2), "capacityPerZone"}},
+			want:     "node_groups[2].capacity_per_zone",
+			wantOK:   true,
+		},
+		"extensions field": {
+			gqlError: client.GraphQLError{Extensions: map[string]interface{}{"field": "spec.loadBalancers.public.sourceIPRanges[1]"}},
+			want:     "load_balancers.public.source_ip_ranges[1]",
+			wantOK:   true,
+		},
+		"index into a set stops at the set": {
+			gqlError: client.GraphQLError{Path: []interface{}{"updateAWSEnv", "input", "spec", "peeringConnections", 0, "vpcID"}},
+			want:     "peering_connections",
+			wantOK:   true,
+		},
+		"unknown nested field stops at its parent": {
+			gqlError: client.GraphQLError{Path: []interface{}{"variable", "input", "spec", "nodeGroups", 0, "gpu"}},
+			want:     "node_groups[0]",
+			wantOK:   true,
+		},
+		"env name": {
+			gqlError: client.GraphQLError{Path: []interface{}{"variable", "input", "name"}},
+			want:     "name",
+			wantOK:   true,
+		},
+		"mutation path only": {
+			gqlError: client.GraphQLError{Path: []interface{}{"updateAWSEnv"}},
+		},
+		"unknown top-level field": {
+			gqlError: client.GraphQLError{Path: []interface{}{"variable", "input", "spec", "clickHouseClusters", 0}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := AttributePath(context.Background(), schema, InputPath(tc.gqlError))
+			if ok != tc.wantOK {
+				t.Fatalf("expected ok=%v, got %v (%s)", tc.wantOK, ok, got)
+			}
+			if ok && got.String() != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAddMutationError(t *testing.T) {
+	t.Parallel()
+	schema := apiErrorsTestSchema()
+
+	err := &client.APIError{Errors: []client.GraphQLError{
+		{Message: "capacity must be positive", Path: []interface{}{"variable", "input", "spec", "nodeGroups", 1, "capacityPerZone"}},
+		{Message: "quota exceeded", Path: []interface{}{"updateAWSEnv"}},
+	}}
+
+	var diags diag.Diagnostics
+	AddMutationError(context.Background(), &diags, schema, "Unable to update env acme", err, "acme")
+
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diags)
+	}
+	attrDiag, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !attrDiag.Path().Equal(path.Root("node_groups").AtListIndex(1).AtName("capacity_per_zone")) {
+		t.Errorf("expected an attribute diagnostic on node_groups[1].capacity_per_zone, got %v", diags[0])
+	}
+	if !strings.Contains(diags[0].Detail(), "capacity must be positive") {
+		t.Errorf("unexpected detail: %s", diags[0].Detail())
+	}
+	if diags[1].Summary() != "Client Error" || !strings.Contains(diags[1].Detail(), "Validation Error: quota exceeded") {
+		t.Errorf("expected unmapped errors as a client error, got %v", diags[1])
+	}
+
+	var transport diag.Diagnostics
+	AddMutationError(context.Background(), &transport, schema, "Unable to update env acme", errors.New("connection refused"), "acme")
+	if len(transport) != 1 || !strings.Contains(transport[0].Detail(), "Unable to update env acme, got error: connection refused") {
+		t.Errorf("expected a client error, got %v", transport)
+	}
+}
@@ -0,0 +1,174 @@
+package env
+
+import (
+	"context"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Defaults are the provider defaults an env resource merges into its spec.
+type Defaults struct {
+	Tags               []KeyValueModel
+	Labels             []KeyValueModel
+	MaintenanceWindows []MaintenanceWindowModel
+}
+
+func defaultsToModel(defaults sdk.Defaults) Defaults {
+	var model Defaults
+	for _, tag := range defaults.Tags {
+		model.Tags = append(model.Tags, KeyValueModel{Key: types.StringValue(tag.Key), Value: types.StringValue(tag.Value)})
+	}
+	for _, label := range defaults.Labels {
+		model.Labels = append(model.Labels, KeyValueModel{Key: types.StringValue(label.Key), Value: types.StringValue(label.Value)})
+	}
+	for _, mw := range defaults.MaintenanceWindows {
+		var days []types.String
+		for _, day := range mw.Days {
+			days = append(days, types.StringValue(string(day)))
+		}
+		model.MaintenanceWindows = append(model.MaintenanceWindows, MaintenanceWindowModel{
+			Name: types.StringValue(mw.Name),
+			// Unset means disabled, as on the resources.
+			Enabled:       types.BoolValue(mw.Enabled != nil && *mw.Enabled),
+			Hour:          types.Int64Value(mw.Hour),
+			LengthInHours: types.Int64Value(mw.LengthInHours),
+			Days:          days,
+		})
+	}
+	return model
+}
+
+func keyValueKey(m KeyValueModel) string { return m.Key.ValueString() }
+
+func maintenanceWindowKey(m MaintenanceWindowModel) string { return m.Name.ValueString() }
+
+func sameKeyValue(a, b KeyValueModel) bool {
+	return a.Key.Equal(b.Key) && a.Value.Equal(b.Value)
+}
+
+func sameMaintenanceWindow(a, b MaintenanceWindowModel) bool {
+	if !a.Name.Equal(b.Name) || !a.Enabled.Equal(b.Enabled) || !a.Hour.Equal(b.Hour) || !a.LengthInHours.Equal(b.LengthInHours) || len(a.Days) != len(b.Days) {
+		return false
+	}
+	for i := range a.Days {
+		if !a.Days[i].Equal(b.Days[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeDefaults returns items followed by the defaults whose key none of
+// items has: resource values win on conflicts. nil stays nil (null).
+func mergeDefaults[M any](defaults, items []M, key func(M) string) []M {
+	merged := append([]M(nil), items...)
+	for _, d := range defaults {
+		overridden := false
+		for _, item := range items {
+			overridden = overridden || key(item) == key(d)
+		}
+		if !overridden {
+			merged = append(merged, d)
+		}
+	}
+	return merged
+}
+
+// splitDefaults splits all, the items read from the API, into the ones the
+// resource sets itself and all of them, ordered as mergeDefaults orders them.
+// An item is the resource's own unless it equals a default and its key isn't
+// in prior, the resource's own items before the read.
+func splitDefaults[M any](prior, all, defaults []M, key func(M) string, same func(M, M) bool) ([]M, []M) {
+	var own []M
+	for _, item := range all {
+		inPrior := false
+		for _, p := range prior {
+			inPrior = inPrior || key(p) == key(item)
+		}
+		fromDefaults := false
+		for _, d := range defaults {
+			fromDefaults = fromDefaults || same(d, item)
+		}
+		if inPrior || !fromDefaults {
+			own = append(own, item)
+		}
+	}
+	return own, ReorderByKey(mergeDefaults(defaults, own, key), all, key, key)
+}
+
+// MergeTags returns the tags, or labels, of a resource merged with defaults.
+func MergeTags(defaults, tags []KeyValueModel) []KeyValueModel {
+	return mergeDefaults(defaults, tags, keyValueKey)
+}
+
+// MergeMaintenanceWindows returns the maintenance windows of a resource merged
+// with defaults.
+func MergeMaintenanceWindows(defaults, windows []MaintenanceWindowModel) []MaintenanceWindowModel {
+	return mergeDefaults(defaults, windows, maintenanceWindowKey)
+}
+
+// SplitTags returns the tags, or labels, of a resource and all its tags from
+// all, the tags read from the API, given prior, the resource tags before the
+// read.
+func SplitTags(prior, all, defaults []KeyValueModel) ([]KeyValueModel, []KeyValueModel) {
+	return splitDefaults(prior, all, defaults, keyValueKey, sameKeyValue)
+}
+
+// SplitMaintenanceWindows is SplitTags for maintenance windows.
+func SplitMaintenanceWindows(prior, all, defaults []MaintenanceWindowModel) ([]MaintenanceWindowModel, []MaintenanceWindowModel) {
+	return splitDefaults(prior, all, defaults, maintenanceWindowKey, sameMaintenanceWindow)
+}
+
+// planDefaults sets the tags_all, labels_all and maintenance_windows_all
+// attributes of the planned resource, those it has, to its tags, labels and
+// maintenance windows merged with the provider defaults. They are unknown
+// until these are known.
+func (r *EnvResourceBase) planDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	attributes := req.Plan.Schema.GetAttributes()
+	for _, attribute := range []string{"tags", "labels"} {
+		if _, ok := attributes[attribute+"_all"]; !ok {
+			continue
+		}
+		defaults := r.Defaults.Tags
+		if attribute == "labels" {
+			defaults = r.Defaults.Labels
+		}
+		var items []KeyValueModel
+		resp.Diagnostics.Append(planAll(ctx, resp, attribute, &items, func() interface{} { return MergeTags(defaults, items) })...)
+	}
+	if _, ok := attributes["maintenance_windows_all"]; ok {
+		var items []MaintenanceWindowModel
+		resp.Diagnostics.Append(planAll(ctx, resp, "maintenance_windows", &items, func() interface{} {
+			return MergeMaintenanceWindows(r.Defaults.MaintenanceWindows, items)
+		})...)
+	}
+}
+
+func planAll(ctx context.Context, resp *resource.ModifyPlanResponse, attribute string, items interface{}, merge func() interface{}) diag.Diagnostics {
+	all := path.Root(attribute + "_all")
+	var list types.List
+	diags := resp.Plan.GetAttribute(ctx, path.Root(attribute), &list)
+	if diags.HasError() {
+		return diags
+	}
+	// Unknown elements, e.g. days from a module output, can't be merged yet.
+	if list.IsUnknown() || !fullyKnown(list) {
+		return resp.Plan.SetAttribute(ctx, all, types.ListUnknown(list.ElementType(ctx)))
+	}
+	diags.Append(list.ElementsAs(ctx, items, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, all, merge())...)
+	return diags
+}
+
+func fullyKnown(value attr.Value) bool {
+	tfValue, err := value.ToTerraformValue(context.Background())
+	return err == nil && tfValue.IsFullyKnown()
+}
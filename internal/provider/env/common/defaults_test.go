@@ -0,0 +1,139 @@
+package env
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func kv(key, value string) KeyValueModel {
+	return KeyValueModel{Key: types.StringValue(key), Value: types.StringValue(value)}
+}
+
+func TestMergeTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []KeyValueModel
+		tags     []KeyValueModel
+		expected []KeyValueModel
+	}{
+		{
+			name:     "No defaults",
+			tags:     []KeyValueModel{kv("team", "a")},
+			expected: []KeyValueModel{kv("team", "a")},
+		},
+		{
+			name:     "No tags",
+			defaults: []KeyValueModel{kv("owner", "ops")},
+			expected: []KeyValueModel{kv("owner", "ops")},
+		},
+		{
+			name:     "Resource tags first",
+			defaults: []KeyValueModel{kv("owner", "ops")},
+			tags:     []KeyValueModel{kv("team", "a")},
+			expected: []KeyValueModel{kv("team", "a"), kv("owner", "ops")},
+		},
+		{
+			name:     "Resource tag overrides default of the same key",
+			defaults: []KeyValueModel{kv("owner", "ops"), kv("env", "prod")},
+			tags:     []KeyValueModel{kv("owner", "dev")},
+			expected: []KeyValueModel{kv("owner", "dev"), kv("env", "prod")},
+		},
+		{
+			name: "Neither",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeTags(tt.defaults, tt.tags); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	defaults := []KeyValueModel{kv("owner", "ops"), kv("env", "prod")}
+
+	tests := []struct {
+		name        string
+		prior       []KeyValueModel
+		all         []KeyValueModel
+		expectedOwn []KeyValueModel
+		expectedAll []KeyValueModel
+	}{
+		{
+			name:        "Defaults only",
+			all:         []KeyValueModel{kv("env", "prod"), kv("owner", "ops")},
+			expectedAll: []KeyValueModel{kv("owner", "ops"), kv("env", "prod")},
+		},
+		{
+			name:        "Resource tags and defaults",
+			prior:       []KeyValueModel{kv("team", "a")},
+			all:         []KeyValueModel{kv("owner", "ops"), kv("team", "a"), kv("env", "prod")},
+			expectedOwn: []KeyValueModel{kv("team", "a")},
+			expectedAll: []KeyValueModel{kv("team", "a"), kv("owner", "ops"), kv("env", "prod")},
+		},
+		{
+			name:        "Resource tag equal to a default stays the resource's",
+			prior:       []KeyValueModel{kv("owner", "ops")},
+			all:         []KeyValueModel{kv("owner", "ops"), kv("env", "prod")},
+			expectedOwn: []KeyValueModel{kv("owner", "ops")},
+			expectedAll: []KeyValueModel{kv("owner", "ops"), kv("env", "prod")},
+		},
+		{
+			name:        "Default changed outside Terraform shows as a resource tag",
+			all:         []KeyValueModel{kv("owner", "someone"), kv("env", "prod")},
+			expectedOwn: []KeyValueModel{kv("owner", "someone")},
+			expectedAll: []KeyValueModel{kv("owner", "someone"), kv("env", "prod")},
+		},
+		{
+			name:        "Default removed outside Terraform",
+			prior:       []KeyValueModel{kv("team", "a")},
+			all:         []KeyValueModel{kv("team", "a")},
+			expectedOwn: []KeyValueModel{kv("team", "a")},
+			expectedAll: []KeyValueModel{kv("team", "a")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			own, all := SplitTags(tt.prior, tt.all, defaults)
+			if !reflect.DeepEqual(own, tt.expectedOwn) {
+				t.Errorf("expected own %v, got %v", tt.expectedOwn, own)
+			}
+			if !reflect.DeepEqual(all, tt.expectedAll) {
+				t.Errorf("expected all %v, got %v", tt.expectedAll, all)
+			}
+		})
+	}
+}
+
+func TestSplitMaintenanceWindows(t *testing.T) {
+	window := func(name string, hour int64) MaintenanceWindowModel {
+		return MaintenanceWindowModel{
+			Name:          types.StringValue(name),
+			Enabled:       types.BoolValue(false),
+			Hour:          types.Int64Value(hour),
+			LengthInHours: types.Int64Value(4),
+			Days:          []types.String{types.StringValue("SUNDAY")},
+		}
+	}
+	defaults := []MaintenanceWindowModel{window("weekly", 2)}
+
+	own, all := SplitMaintenanceWindows(nil, []MaintenanceWindowModel{window("weekly", 2), window("nightly", 1)}, defaults)
+	if expected := []MaintenanceWindowModel{window("nightly", 1)}; !reflect.DeepEqual(own, expected) {
+		t.Errorf("expected own %v, got %v", expected, own)
+	}
+	if expected := []MaintenanceWindowModel{window("nightly", 1), window("weekly", 2)}; !reflect.DeepEqual(all, expected) {
+		t.Errorf("expected all %v, got %v", expected, all)
+	}
+
+	// A resource window overriding the default one of the same name.
+	own, all = SplitMaintenanceWindows([]MaintenanceWindowModel{window("weekly", 5)}, []MaintenanceWindowModel{window("weekly", 5)}, defaults)
+	if expected := []MaintenanceWindowModel{window("weekly", 5)}; !reflect.DeepEqual(own, expected) || !reflect.DeepEqual(all, expected) {
+		t.Errorf("expected own and all %v, got %v and %v", expected, own, all)
+	}
+}
@@ -1,9 +1,11 @@
 package env
 
 import (
+	"errors"
 	"fmt"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
@@ -18,8 +20,9 @@ func ValidateForceDestroy(envName string, forceDestroy bool) diag.Diagnostics {
 }
 
 // ValidateDisconnected checks if the environment is DISCONNECTED and returns
-// differentiated error messages based on whether the env was ever provisioned.
-func ValidateDisconnected(envName string, errorCode string, appliedSpecRevision int64, skipDeprovision, allowDeleteDisconnected bool) diag.Diagnostics {
+// differentiated error messages based on whether the env was ever provisioned,
+// followed by the remediation hint for cloud.
+func ValidateDisconnected(cloud string, envName string, errorCode string, appliedSpecRevision int64, skipDeprovision, allowDeleteDisconnected bool) diag.Diagnostics {
 	var diags diag.Diagnostics
 	if (errorCode == "DISCONNECTED" || errorCode == "K8S_DISCONNECTED") && !skipDeprovision && !allowDeleteDisconnected {
 		msg := fmt.Sprintf("Unable to delete env %s, environment is DISCONNECTED.\n", envName)
@@ -28,6 +31,9 @@ func ValidateDisconnected(envName string, errorCode string, appliedSpecRevision
 		} else {
 			msg += "Check environment's `cloudconnect` or use `allow_delete_while_disconnected=true` to continue with the delete operation."
 		}
+		if hint := envstatus.Remediation(cloud, errorCode); hint != "" {
+			msg += "\n" + hint
+		}
 		clientsupport.AddClientError(&diags, msg)
 	}
 	return diags
@@ -35,8 +41,7 @@ func ValidateDisconnected(envName string, errorCode string, appliedSpecRevision
 
 // FormatDeleteError returns a user-friendly error message for delete failures.
 func FormatDeleteError(envName string, err error) string {
-	activeClusters, _ := client.IsActiveClustersError(err)
-	if activeClusters {
+	if errors.Is(err, client.ErrActiveClusters) {
 		return fmt.Sprintf("Unable to delete env %s, it has active ClickHouse/Zookeeper clusters (use force_destroy_clusters=true to force delete them)", envName)
 	}
 	return fmt.Sprintf("Unable to delete env %s, got error: %s", envName, client.FormatError(err, envName))
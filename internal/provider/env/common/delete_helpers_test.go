@@ -4,6 +4,9 @@ import (
 	"errors"
 	"strings"
 	"testing"
+
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
 )
 
 func TestValidateForceDestroy(t *testing.T) {
@@ -60,6 +63,12 @@ func TestValidateDisconnected(t *testing.T) {
 			expectErr:           true,
 			containsMsg:         "cloudconnect",
 		},
+		"DISCONNECTED includes the remediation hint": {
+			errorCode:           "DISCONNECTED",
+			appliedSpecRevision: 5,
+			expectErr:           true,
+			containsMsg:         "terraform-altinitycloud-connect-aws",
+		},
 		"K8S_DISCONNECTED rev=0 blocks with never-provisioned message": {
 			errorCode:           "K8S_DISCONNECTED",
 			appliedSpecRevision: 0,
@@ -94,7 +103,7 @@ func TestValidateDisconnected(t *testing.T) {
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			diags := ValidateDisconnected("test-env", tc.errorCode, tc.appliedSpecRevision, tc.skipDeprovision, tc.allowDeleteDisconnected)
+			diags := ValidateDisconnected(envstatus.CloudAWS, "test-env", tc.errorCode, tc.appliedSpecRevision, tc.skipDeprovision, tc.allowDeleteDisconnected)
 			if tc.expectErr && !diags.HasError() {
 				t.Error("expected error, got none")
 			}
@@ -119,7 +128,11 @@ func TestFormatDeleteError(t *testing.T) {
 		contains string
 	}{
 		"active clusters error": {
-			err:      errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"env has active clusters, use forceDestroyClusters=true","path":["deleteAWSEnv"],"extensions":{"code":"CONFLICT"}}]}`),
+			err: &client.APIError{Errors: []client.GraphQLError{{
+				Message:    "env has active clusters, use forceDestroyClusters=true",
+				Path:       []interface{}{"deleteAWSEnv"},
+				Extensions: map[string]interface{}{"code": "CONFLICT"},
+			}}},
 			contains: "force_destroy_clusters=true",
 		},
 		"generic error": {
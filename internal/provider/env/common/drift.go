@@ -0,0 +1,568 @@
+package env
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// appliedSpecRevisionKey is the private state key holding the spec revision
+// Terraform itself last applied. After a refresh spec_revision in state is the
+// server's, so this is the only way to tell server-side edits from config edits.
+const appliedSpecRevisionKey = "applied_spec_revision"
+
+type privateStateWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type privateStateReadWriter interface {
+	privateStateWriter
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// SetAppliedSpecRevision records the spec revision returned by a create or
+// update mutation in the resource private state.
+func SetAppliedSpecRevision(ctx context.Context, private privateStateWriter, revision int64) diag.Diagnostics {
+	return private.SetKey(ctx, appliedSpecRevisionKey, []byte(strconv.FormatInt(revision, 10)))
+}
+
+// SeedAppliedSpecRevision records the spec revision the cloud has applied as
+// the one Terraform last applied, unless one is recorded already: imported envs
+// and envs created by older provider versions would otherwise never get their
+// drift explained. Failing to read it is not an error, the next read retries.
+func (r *EnvResourceBase) SeedAppliedSpecRevision(ctx context.Context, private privateStateReadWriter, envName string) diag.Diagnostics {
+	raw, diags := private.GetKey(ctx, appliedSpecRevisionKey)
+	if diags.HasError() || raw != nil {
+		return diags
+	}
+	applied, err := r.appliedSpecRevision(ctx, envName)
+	if err != nil {
+		tflog.Debug(ctx, "unable to read the applied spec revision", map[string]interface{}{"name": envName, "error": err.Error()})
+		return diags
+	}
+	return SetAppliedSpecRevision(ctx, private, applied)
+}
+
+// appliedSpecRevision returns the spec revision the cloud has applied to the
+// env, from its status.
+func (r *EnvResourceBase) appliedSpecRevision(ctx context.Context, envName string) (int64, error) {
+	if r.Client == nil {
+		return 0, errors.New("provider not configured")
+	}
+	switch r.Cloud {
+	case envstatus.CloudAWS:
+		resp, err := r.Client.GetAWSEnvStatus(ctx, envName)
+		if err != nil {
+			return 0, err
+		}
+		if resp.AWSEnv == nil {
+			return 0, ErrEnvNotFound
+		}
+		return resp.AWSEnv.Status.AppliedSpecRevision, nil
+	case envstatus.CloudAWSHosted:
+		resp, err := r.Client.GetAWSEnvHostedStatus(ctx, envName)
+		if err != nil {
+			return 0, err
+		}
+		if resp.AWSEnvHosted == nil {
+			return 0, ErrEnvNotFound
+		}
+		return resp.AWSEnvHosted.Status.AppliedSpecRevision, nil
+	case envstatus.CloudAzure:
+		resp, err := r.Client.GetAzureEnvStatus(ctx, envName)
+		if err != nil {
+			return 0, err
+		}
+		if resp.AzureEnv == nil {
+			return 0, ErrEnvNotFound
+		}
+		return resp.AzureEnv.Status.AppliedSpecRevision, nil
+	case envstatus.CloudGCP:
+		resp, err := r.Client.GetGCPEnvStatus(ctx, envName)
+		if err != nil {
+			return 0, err
+		}
+		if resp.GCPEnv == nil {
+			return 0, ErrEnvNotFound
+		}
+		return resp.GCPEnv.Status.AppliedSpecRevision, nil
+	case envstatus.CloudHCloud:
+		resp, err := r.Client.GetHCloudEnvStatus(ctx, envName)
+		if err != nil {
+			return 0, err
+		}
+		if resp.HcloudEnv == nil {
+			return 0, ErrEnvNotFound
+		}
+		return resp.HcloudEnv.Status.AppliedSpecRevision, nil
+	case envstatus.CloudK8S:
+		resp, err := r.Client.GetK8SEnvStatus(ctx, envName)
+		if err != nil {
+			return 0, err
+		}
+		if resp.K8sEnv == nil {
+			return 0, ErrEnvNotFound
+		}
+		return resp.K8sEnv.Status.AppliedSpecRevision, nil
+	}
+	return 0, fmt.Errorf("unknown cloud %q", r.Cloud)
+}
+
+// nodeGroupZoneAttributes and nodeGroupCapacityAttributes cover the per-cloud
+// naming of node group placement (hcloud uses locations, hosted AWS zone ids).
+var nodeGroupZoneAttributes = []string{"zones", "zone_ids", "locations"}
+var nodeGroupCapacityAttributes = []string{"capacity_per_zone", "capacity_per_location"}
+
+// sourceIPRangePaths are the CIDR allow-lists an env may expose.
+var sourceIPRangePaths = [][]string{
+	{"load_balancers", "public", "source_ip_ranges"},
+	{"load_balancers", "internal", "source_ip_ranges"},
+	{"metrics_endpoint", "source_ip_ranges"},
+}
+
+// explainDrift warns when the env was modified outside of Terraform since the
+// spec revision Terraform last applied, summarizing the server changes the plan
+// is about to revert and flagging the disruptive ones.
+func explainDrift(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	raw, diags := req.Private.GetKey(ctx, appliedSpecRevisionKey)
+	if diags.HasError() || raw == nil {
+		return
+	}
+	applied, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return
+	}
+
+	var name types.String
+	var stateRevision types.Int64
+	if req.State.GetAttribute(ctx, path.Root("name"), &name).HasError() ||
+		req.State.GetAttribute(ctx, path.Root("spec_revision"), &stateRevision).HasError() {
+		return
+	}
+	if stateRevision.IsNull() || stateRevision.IsUnknown() || stateRevision.ValueInt64() <= applied {
+		return
+	}
+
+	changes, disruptive := DriftSummary(req.State.Raw, resp.Plan.Raw)
+	if len(changes) == 0 {
+		return
+	}
+
+	detail := fmt.Sprintf("Env %s was modified outside of Terraform: spec revision is %d on the server, Terraform last applied %d.\n\nServer changes:\n  - %s\n\nApplying this plan reverts them.",
+		name.ValueString(), stateRevision.ValueInt64(), applied, strings.Join(changes, "\n  - "))
+	if len(disruptive) > 0 {
+		detail += fmt.Sprintf(" This is disruptive:\n  - %s", strings.Join(disruptive, "\n  - "))
+	}
+	resp.Diagnostics.AddWarning("Environment Changed Outside of Terraform", detail)
+}
+
+// DriftSummary compares the refreshed state (what the server has) with the plan
+// (what the configuration wants) for the attributes most often edited in the
+// console: node groups, zones, CIDR allow-lists, tags/labels and maintenance
+// windows. Other changed attributes are listed by name. disruptive lists the
+// plan's capacity reductions and zone or node group removals.
+func DriftSummary(state, plan tftypes.Value) (changes []string, disruptive []string) {
+	stateAttrs, planAttrs := objectAttributes(state), objectAttributes(plan)
+	if stateAttrs == nil || planAttrs == nil {
+		return nil, nil
+	}
+
+	summarized := map[string]bool{}
+
+	if c, d := nodeGroupsDrift(stateAttrs["node_groups"], planAttrs["node_groups"]); len(c) > 0 {
+		changes = append(changes, c...)
+		disruptive = append(disruptive, d...)
+	}
+	summarized["node_groups"] = true
+
+	for _, zonesAttr := range nodeGroupZoneAttributes {
+		added, removed, ok := stringSetDiff(stateAttrs[zonesAttr], planAttrs[zonesAttr])
+		summarized[zonesAttr] = true
+		if !ok || (len(added) == 0 && len(removed) == 0) {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s: %s", zonesAttr, describeSetDiff(added, removed)))
+		if len(added) > 0 {
+			disruptive = append(disruptive, fmt.Sprintf("removes %s %s", zonesAttr, strings.Join(added, ", ")))
+		}
+	}
+
+	for _, p := range sourceIPRangePaths {
+		// Only the allow-list itself: other changes to its block are listed below.
+		summarized[strings.Join(p, ".")] = true
+		added, removed, ok := stringSetDiff(nestedAttribute(state, p...), nestedAttribute(plan, p...))
+		if !ok || (len(added) == 0 && len(removed) == 0) {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s: %s", strings.Join(p, "."), describeSetDiff(added, removed)))
+	}
+
+	for _, tagsAttr := range []string{"tags", "labels"} {
+		summarized[tagsAttr], summarized[tagsAttr+"_all"] = true, true
+		if c := keyValueDrift(tagsAttr, withDefaults(stateAttrs, tagsAttr), withDefaults(planAttrs, tagsAttr)); c != "" {
+			changes = append(changes, c)
+		}
+	}
+
+	summarized["maintenance_windows"], summarized["maintenance_windows_all"] = true, true
+	if c := maintenanceWindowsDrift(withDefaults(stateAttrs, "maintenance_windows"), withDefaults(planAttrs, "maintenance_windows")); c != "" {
+		changes = append(changes, c)
+	}
+
+	var other []string
+	for name, planValue := range planAttrs {
+		if specRevisionIgnoredAttributes[name] {
+			continue
+		}
+		other = append(other, unsummarizedChanges(name, stateAttrs[name], planValue, summarized)...)
+	}
+	if len(other) > 0 {
+		sort.Strings(other)
+		changes = append(changes, fmt.Sprintf("other attributes: %s", strings.Join(other, ", ")))
+	}
+
+	return changes, disruptive
+}
+
+// withDefaults returns attribute merged with the provider defaults, its _all
+// attribute, when the resource has one: the server has the merged values.
+func withDefaults(attrs map[string]tftypes.Value, attribute string) tftypes.Value {
+	if all, ok := attrs[attribute+"_all"]; ok {
+		return all
+	}
+	return attrs[attribute]
+}
+
+type nodeGroupDriftItem struct {
+	name     string
+	nodeType string
+	capacity *int64
+	zones    tftypes.Value
+}
+
+func (n nodeGroupDriftItem) label() string {
+	if n.name != "" && n.name != n.nodeType {
+		return fmt.Sprintf("%q (%s)", n.name, n.nodeType)
+	}
+	return fmt.Sprintf("%q", n.nodeType)
+}
+
+func nodeGroupsDrift(stateValue, planValue tftypes.Value) (changes []string, disruptive []string) {
+	if !planValue.IsFullyKnown() {
+		return nil, nil
+	}
+	stateGroups, planGroups := nodeGroupItems(stateValue), nodeGroupItems(planValue)
+
+	// Pair like ReorderByKey: by name when the configuration sets it, else by node type.
+	used := make([]bool, len(stateGroups))
+	for _, p := range planGroups {
+		match := -1
+		for i, s := range stateGroups {
+			if used[i] {
+				continue
+			}
+			if (p.name != "" && p.name == s.name) || (p.name == "" && p.nodeType == s.nodeType) {
+				match = i
+				break
+			}
+		}
+		if match < 0 {
+			changes = append(changes, fmt.Sprintf("node group %s was removed", p.label()))
+			continue
+		}
+		used[match] = true
+		s := stateGroups[match]
+
+		if s.capacity != nil && p.capacity != nil && *s.capacity != *p.capacity {
+			changes = append(changes, fmt.Sprintf("node group %s capacity changed to %d (configuration has %d)", s.label(), *s.capacity, *p.capacity))
+			if *p.capacity < *s.capacity {
+				disruptive = append(disruptive, fmt.Sprintf("reduces capacity of node group %s from %d to %d", s.label(), *s.capacity, *p.capacity))
+			}
+		}
+
+		added, removed, ok := stringSetDiff(s.zones, p.zones)
+		if ok && (len(added) > 0 || len(removed) > 0) {
+			changes = append(changes, fmt.Sprintf("node group %s zones: %s", s.label(), describeSetDiff(added, removed)))
+			if len(added) > 0 {
+				disruptive = append(disruptive, fmt.Sprintf("removes zones %s from node group %s", strings.Join(added, ", "), s.label()))
+			}
+		}
+	}
+
+	for i, s := range stateGroups {
+		if !used[i] {
+			changes = append(changes, fmt.Sprintf("node group %s was added", s.label()))
+			disruptive = append(disruptive, fmt.Sprintf("removes node group %s", s.label()))
+		}
+	}
+
+	return changes, disruptive
+}
+
+func nodeGroupItems(v tftypes.Value) []nodeGroupDriftItem {
+	var items []nodeGroupDriftItem
+	for _, element := range collectionElements(v) {
+		attrs := objectAttributes(element)
+		if attrs == nil {
+			continue
+		}
+		item := nodeGroupDriftItem{}
+		item.name, _ = stringValue(attrs["name"])
+		item.nodeType, _ = stringValue(attrs["node_type"])
+		for _, a := range nodeGroupCapacityAttributes {
+			if c, ok := int64Value(attrs[a]); ok {
+				item.capacity = &c
+			}
+		}
+		for _, a := range nodeGroupZoneAttributes {
+			if z, ok := attrs[a]; ok {
+				item.zones = z
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func keyValueDrift(attribute string, stateValue, planValue tftypes.Value) string {
+	if !planValue.IsFullyKnown() {
+		return ""
+	}
+	stateTags, planTags := keyValueMap(stateValue), keyValueMap(planValue)
+
+	var parts []string
+	for _, k := range sortedKeys(stateTags) {
+		if v, ok := planTags[k]; !ok {
+			parts = append(parts, fmt.Sprintf("added %q", k))
+		} else if v != stateTags[k] {
+			parts = append(parts, fmt.Sprintf("changed %q", k))
+		}
+	}
+	for _, k := range sortedKeys(planTags) {
+		if _, ok := stateTags[k]; !ok {
+			parts = append(parts, fmt.Sprintf("removed %q", k))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s: %s", attribute, strings.Join(parts, ", "))
+}
+
+func maintenanceWindowsDrift(stateValue, planValue tftypes.Value) string {
+	if !planValue.IsFullyKnown() {
+		return ""
+	}
+	byName := func(v tftypes.Value) map[string]tftypes.Value {
+		windows := map[string]tftypes.Value{}
+		for _, element := range collectionElements(v) {
+			if name, ok := stringValue(objectAttributes(element)["name"]); ok {
+				windows[name] = element
+			}
+		}
+		return windows
+	}
+	stateWindows, planWindows := byName(stateValue), byName(planValue)
+
+	var parts []string
+	for _, name := range sortedKeys(stateWindows) {
+		if w, ok := planWindows[name]; !ok {
+			parts = append(parts, fmt.Sprintf("added %q", name))
+		} else if !w.Equal(stateWindows[name]) {
+			parts = append(parts, fmt.Sprintf("changed %q", name))
+		}
+	}
+	for _, name := range sortedKeys(planWindows) {
+		if _, ok := stateWindows[name]; !ok {
+			parts = append(parts, fmt.Sprintf("removed %q", name))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("maintenance_windows: %s", strings.Join(parts, ", "))
+}
+
+// stringSetDiff returns the strings only the state has (added on the server) and
+// only the plan has (removed on the server). ok is false when either side is
+// unknown or not a string collection.
+func stringSetDiff(stateValue, planValue tftypes.Value) (added, removed []string, ok bool) {
+	if planValue.Type() == nil || stateValue.Type() == nil || !planValue.IsFullyKnown() || !stateValue.IsFullyKnown() {
+		return nil, nil, false
+	}
+	stateStrings, okState := stringElements(stateValue)
+	planStrings, okPlan := stringElements(planValue)
+	if !okState || !okPlan {
+		return nil, nil, false
+	}
+	inPlan := map[string]bool{}
+	for _, s := range planStrings {
+		inPlan[s] = true
+	}
+	inState := map[string]bool{}
+	for _, s := range stateStrings {
+		inState[s] = true
+		if !inPlan[s] {
+			added = append(added, s)
+		}
+	}
+	for _, s := range planStrings {
+		if !inState[s] {
+			removed = append(removed, s)
+		}
+	}
+	return added, removed, true
+}
+
+func describeSetDiff(added, removed []string) string {
+	var parts []string
+	if len(added) > 0 {
+		parts = append(parts, "added "+strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		parts = append(parts, "removed "+strings.Join(removed, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func objectAttributes(v tftypes.Value) map[string]tftypes.Value {
+	if v.Type() == nil || !v.Type().Is(tftypes.Object{}) || v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil
+	}
+	return attrs
+}
+
+// unsummarizedChanges returns the dotted paths at or under name that differ
+// between state and plan and are not summarized. Objects holding a summarized
+// path are compared attribute by attribute, anything else as a whole.
+func unsummarizedChanges(name string, state, plan tftypes.Value, summarized map[string]bool) []string {
+	if summarized[name] || !plan.IsFullyKnown() {
+		return nil
+	}
+	planAttrs := objectAttributes(plan)
+	if planAttrs == nil || !hasSummarizedPathUnder(name, summarized) {
+		if plan.Equal(state) {
+			return nil
+		}
+		return []string{name}
+	}
+	stateAttrs := objectAttributes(state)
+	var changed []string
+	for attr, planValue := range planAttrs {
+		changed = append(changed, unsummarizedChanges(name+"."+attr, stateAttrs[attr], planValue, summarized)...)
+	}
+	return changed
+}
+
+func hasSummarizedPathUnder(name string, summarized map[string]bool) bool {
+	for p := range summarized {
+		if strings.HasPrefix(p, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func nestedAttribute(v tftypes.Value, names ...string) tftypes.Value {
+	for _, name := range names {
+		attrs := objectAttributes(v)
+		if attrs == nil {
+			return tftypes.Value{}
+		}
+		v = attrs[name]
+	}
+	return v
+}
+
+func collectionElements(v tftypes.Value) []tftypes.Value {
+	if v.Type() == nil || v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	var elements []tftypes.Value
+	if !v.Type().Is(tftypes.List{}) && !v.Type().Is(tftypes.Set{}) {
+		return nil
+	}
+	if err := v.As(&elements); err != nil {
+		return nil
+	}
+	return elements
+}
+
+func stringElements(v tftypes.Value) ([]string, bool) {
+	if v.Type() == nil {
+		return nil, false
+	}
+	if v.IsNull() {
+		return nil, true
+	}
+	var out []string
+	for _, element := range collectionElements(v) {
+		s, ok := stringValue(element)
+		if !ok {
+			return nil, false
+		}
+		out = append(out, s)
+	}
+	return out, true
+}
+
+func keyValueMap(v tftypes.Value) map[string]string {
+	out := map[string]string{}
+	for _, element := range collectionElements(v) {
+		attrs := objectAttributes(element)
+		key, ok := stringValue(attrs["key"])
+		if !ok {
+			continue
+		}
+		out[key], _ = stringValue(attrs["value"])
+	}
+	return out
+}
+
+func stringValue(v tftypes.Value) (string, bool) {
+	if v.Type() == nil || !v.Type().Is(tftypes.String) || v.IsNull() || !v.IsKnown() {
+		return "", false
+	}
+	var s string
+	if err := v.As(&s); err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+func int64Value(v tftypes.Value) (int64, bool) {
+	if v.Type() == nil || !v.Type().Is(tftypes.Number) || v.IsNull() || !v.IsKnown() {
+		return 0, false
+	}
+	var f big.Float
+	if err := v.As(&f); err != nil {
+		return 0, false
+	}
+	i, _ := f.Int64()
+	return i, true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,280 @@
+package env
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	driftNodeGroupType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":              tftypes.String,
+		"node_type":         tftypes.String,
+		"capacity_per_zone": tftypes.Number,
+		"zones":             tftypes.List{ElementType: tftypes.String},
+	}}
+	driftTagType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"key":   tftypes.String,
+		"value": tftypes.String,
+	}}
+	driftWindowType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+		"hour": tftypes.Number,
+	}}
+	driftLoadBalancerType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"enabled":          tftypes.Bool,
+		"source_ip_ranges": tftypes.List{ElementType: tftypes.String},
+	}}
+	driftEnvType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":                tftypes.String,
+		"region":              tftypes.String,
+		"spec_revision":       tftypes.Number,
+		"zones":               tftypes.List{ElementType: tftypes.String},
+		"node_groups":         tftypes.List{ElementType: driftNodeGroupType},
+		"tags":                tftypes.List{ElementType: driftTagType},
+		"maintenance_windows": tftypes.List{ElementType: driftWindowType},
+		"load_balancers": tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"public": driftLoadBalancerType,
+		}},
+	}}
+)
+
+type driftEnv struct {
+	region       string
+	revision     int64
+	zones        []string
+	nodeGroups   []driftNodeGroup
+	tags         map[string]string
+	windows      map[string]int64
+	sourceRanges []string
+	publicLB     bool
+}
+
+type driftNodeGroup struct {
+	name     string
+	nodeType string
+	capacity int64
+	zones    []string
+}
+
+func driftStrings(values []string) tftypes.Value {
+	elements := []tftypes.Value{}
+	for _, v := range values {
+		elements = append(elements, tftypes.NewValue(tftypes.String, v))
+	}
+	return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+}
+
+func (e driftEnv) value() tftypes.Value {
+	nodeGroups := []tftypes.Value{}
+	for _, ng := range e.nodeGroups {
+		nodeGroups = append(nodeGroups, tftypes.NewValue(driftNodeGroupType, map[string]tftypes.Value{
+			"name":              tftypes.NewValue(tftypes.String, ng.name),
+			"node_type":         tftypes.NewValue(tftypes.String, ng.nodeType),
+			"capacity_per_zone": tftypes.NewValue(tftypes.Number, ng.capacity),
+			"zones":             driftStrings(ng.zones),
+		}))
+	}
+	tags := []tftypes.Value{}
+	for _, k := range sortedKeys(e.tags) {
+		tags = append(tags, tftypes.NewValue(driftTagType, map[string]tftypes.Value{
+			"key":   tftypes.NewValue(tftypes.String, k),
+			"value": tftypes.NewValue(tftypes.String, e.tags[k]),
+		}))
+	}
+	windows := []tftypes.Value{}
+	for _, name := range sortedKeys(e.windows) {
+		windows = append(windows, tftypes.NewValue(driftWindowType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+			"hour": tftypes.NewValue(tftypes.Number, e.windows[name]),
+		}))
+	}
+	return tftypes.NewValue(driftEnvType, map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "acme-prod"),
+		"region":              tftypes.NewValue(tftypes.String, e.region),
+		"spec_revision":       tftypes.NewValue(tftypes.Number, e.revision),
+		"zones":               driftStrings(e.zones),
+		"node_groups":         tftypes.NewValue(tftypes.List{ElementType: driftNodeGroupType}, nodeGroups),
+		"tags":                tftypes.NewValue(tftypes.List{ElementType: driftTagType}, tags),
+		"maintenance_windows": tftypes.NewValue(tftypes.List{ElementType: driftWindowType}, windows),
+		"load_balancers": tftypes.NewValue(driftEnvType.AttributeTypes["load_balancers"], map[string]tftypes.Value{
+			"public": tftypes.NewValue(driftLoadBalancerType, map[string]tftypes.Value{
+				"enabled":          tftypes.NewValue(tftypes.Bool, e.publicLB),
+				"source_ip_ranges": driftStrings(e.sourceRanges),
+			}),
+		}),
+	})
+}
+
+func driftBaseEnv() driftEnv {
+	return driftEnv{
+		region:   "us-east-1",
+		revision: 3,
+		zones:    []string{"us-east-1a", "us-east-1b"},
+		nodeGroups: []driftNodeGroup{
+			{name: "m5.large", nodeType: "m5.large", capacity: 3, zones: []string{"us-east-1a", "us-east-1b"}},
+		},
+		tags:         map[string]string{"team": "data"},
+		windows:      map[string]int64{"weekly": 3},
+		sourceRanges: []string{"10.0.0.0/8"},
+		publicLB:     true,
+	}
+}
+
+func TestDriftSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		server           func(e *driftEnv)
+		expectChanges    []string
+		expectDisruptive []string
+	}{
+		"no drift": {
+			server: func(e *driftEnv) {},
+		},
+		"spec revision alone is not drift": {
+			server: func(e *driftEnv) { e.revision = 7 },
+		},
+		"capacity increase is reverted disruptively": {
+			server: func(e *driftEnv) { e.nodeGroups[0].capacity = 5 },
+			expectChanges: []string{
+				`node group "m5.large" capacity changed to 5 (configuration has 3)`,
+			},
+			expectDisruptive: []string{
+				`reduces capacity of node group "m5.large" from 5 to 3`,
+			},
+		},
+		"capacity decrease is reverted safely": {
+			server: func(e *driftEnv) { e.nodeGroups[0].capacity = 1 },
+			expectChanges: []string{
+				`node group "m5.large" capacity changed to 1 (configuration has 3)`,
+			},
+		},
+		"node group and zones added on the server": {
+			server: func(e *driftEnv) {
+				e.zones = append(e.zones, "us-east-1c")
+				e.nodeGroups = append(e.nodeGroups, driftNodeGroup{name: "gpu", nodeType: "g5.xlarge", capacity: 1, zones: []string{"us-east-1a"}})
+			},
+			expectChanges: []string{
+				`node group "gpu" (g5.xlarge) was added`,
+				"zones: added us-east-1c",
+			},
+			expectDisruptive: []string{
+				`removes node group "gpu" (g5.xlarge)`,
+				"removes zones us-east-1c",
+			},
+		},
+		"cidr, tags and maintenance windows": {
+			server: func(e *driftEnv) {
+				e.sourceRanges = []string{"0.0.0.0/0"}
+				e.tags = map[string]string{"team": "platform", "owner": "ops"}
+				e.windows = map[string]int64{"weekly": 5}
+			},
+			expectChanges: []string{
+				"load_balancers.public.source_ip_ranges: added 0.0.0.0/0; removed 10.0.0.0/8",
+				`tags: added "owner", changed "team"`,
+				`maintenance_windows: changed "weekly"`,
+			},
+		},
+		"other attributes are listed by name": {
+			server:        func(e *driftEnv) { e.region = "us-west-2" },
+			expectChanges: []string{"other attributes: region"},
+		},
+		"other changes next to a source ip allow-list are listed": {
+			server: func(e *driftEnv) {
+				e.sourceRanges = []string{"0.0.0.0/0"}
+				e.publicLB = false
+			},
+			expectChanges: []string{
+				"load_balancers.public.source_ip_ranges: added 0.0.0.0/0; removed 10.0.0.0/8",
+				"other attributes: load_balancers.public.enabled",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			plan := driftBaseEnv()
+			server := driftBaseEnv()
+			tc.server(&server)
+
+			changes, disruptive := DriftSummary(server.value(), plan.value())
+
+			if got, want := strings.Join(changes, "\n"), strings.Join(tc.expectChanges, "\n"); got != want {
+				t.Errorf("changes:\n%s\nexpected:\n%s", got, want)
+			}
+			if got, want := strings.Join(disruptive, "\n"), strings.Join(tc.expectDisruptive, "\n"); got != want {
+				t.Errorf("disruptive:\n%s\nexpected:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestDriftSummary_UnknownPlanValuesAreSkipped(t *testing.T) {
+	t.Parallel()
+	server := driftBaseEnv()
+	server.region = "us-west-2"
+	planValue := driftBaseEnv().value()
+
+	var attrs map[string]tftypes.Value
+	if err := planValue.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+	attrs["region"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	planValue = tftypes.NewValue(driftEnvType, attrs)
+
+	changes, disruptive := DriftSummary(server.value(), planValue)
+	if len(changes) != 0 || len(disruptive) != 0 {
+		t.Errorf("expected no drift, got %v / %v", changes, disruptive)
+	}
+}
+
+type memoryPrivateState map[string][]byte
+
+func (m memoryPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return m[key], nil
+}
+
+func (m memoryPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	m[key] = value
+	return nil
+}
+
+func TestSeedAppliedSpecRevision(t *testing.T) {
+	t.Parallel()
+
+	var queries atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"awsEnv":{"name":"acme","specRevision":5,"status":{"appliedSpecRevision":4,"pendingDelete":false}}}}`))
+	}))
+	t.Cleanup(srv.Close)
+	r := &EnvResourceBase{Cloud: "aws", Client: client.NewClient(srv.Client(), srv.URL, nil)}
+
+	// An env imported or created by an older provider version has no record.
+	private := memoryPrivateState{}
+	if diags := r.SeedAppliedSpecRevision(context.Background(), private, "acme"); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got := string(private[appliedSpecRevisionKey]); got != "4" {
+		t.Fatalf("expected the applied revision 4 to be recorded, got %q", got)
+	}
+
+	// A revision Terraform applied itself is kept.
+	private = memoryPrivateState{appliedSpecRevisionKey: []byte("2")}
+	if diags := r.SeedAppliedSpecRevision(context.Background(), private, "acme"); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got := string(private[appliedSpecRevisionKey]); got != "2" || queries.Load() != 1 {
+		t.Errorf("expected the recorded revision to be kept without a query, got %q after %d queries", got, queries.Load())
+	}
+}
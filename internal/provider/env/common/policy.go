@@ -0,0 +1,244 @@
+package env
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const policyViolation = "Policy Violation"
+
+// defaultPublicSourceRange is what a public load balancer without
+// source_ip_ranges allows traffic from.
+const defaultPublicSourceRange = "0.0.0.0/0"
+
+// checkPolicy evaluates the provider policy against the planned env. The
+// attributes are looked up by name, as they are spelled in the env schemas
+// (e.g. HCloud has network_zone for region and locations for zones); rules on
+// unknown values are checked once they are known, when applying.
+func checkPolicy(ctx context.Context, cloud string, policy sdk.Policy, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributes := plan.Schema.GetAttributes()
+	get := func(name string) attr.Value {
+		if _, ok := attributes[name]; !ok {
+			return nil
+		}
+		var value attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(name), &value)...)
+		return value
+	}
+
+	if allowed, ok := policy.AllowedRegions[cloud]; ok {
+		for _, name := range []string{"region", "network_zone"} {
+			if region, ok := get(name).(types.String); ok && !region.IsUnknown() && !region.IsNull() && !contains(allowed, region.ValueString()) {
+				diags.AddAttributeError(path.Root(name), policyViolation,
+					fmt.Sprintf("%s %q is not allowed by the provider policy. Allowed %s values: %s.", name, region.ValueString(), cloud, strings.Join(allowed, ", ")))
+			}
+		}
+	}
+
+	if nodeGroups, ok := get("node_groups").(collection); ok && !nodeGroups.IsUnknown() {
+		var envZones types.List
+		for _, name := range []string{"zones", "locations", "zone_ids"} {
+			if zones, ok := get(name).(types.List); ok {
+				envZones = zones
+			}
+		}
+		diags.Append(checkNodeGroups(policy, nodeGroups, envZones)...)
+	}
+
+	if len(policy.RequiredTagKeys) > 0 {
+		for _, name := range []string{"tags", "labels"} {
+			if all, ok := get(name + "_all").(types.List); ok && !all.IsUnknown() {
+				keys := map[string]bool{}
+				for _, element := range all.Elements() {
+					if key, ok := objectAttribute(element, "key").(types.String); ok {
+						keys[key.ValueString()] = true
+					}
+				}
+				var missing []string
+				for _, key := range policy.RequiredTagKeys {
+					if !keys[key] {
+						missing = append(missing, key)
+					}
+				}
+				if len(missing) > 0 {
+					diags.AddAttributeError(path.Root(name), policyViolation,
+						fmt.Sprintf("The provider policy requires the %s %s, missing from %s and the provider defaults.", name, strings.Join(missing, ", "), name))
+				}
+			}
+		}
+	}
+
+	if len(policy.ForbiddenPublicSourceRanges) > 0 {
+		if loadBalancers, ok := get("load_balancers").(types.Object); ok {
+			diags.Append(checkPublicLoadBalancer(policy, objectAttribute(loadBalancers, "public"))...)
+		}
+	}
+
+	if policy.RequireMonitoring {
+		datadog, datadogOK := get("datadog").(types.Object)
+		metrics, metricsOK := get("metrics_endpoint").(types.Object)
+		if datadogOK || metricsOK {
+			datadogEnabled, datadogKnown := enabled(datadog)
+			metricsEnabled, metricsKnown := enabled(metrics)
+			if datadogKnown && metricsKnown && !datadogEnabled && !metricsEnabled {
+				diags.AddAttributeError(path.Root("metrics_endpoint"), policyViolation,
+					"The provider policy requires monitoring: enable the metrics endpoint or Datadog.")
+			}
+		}
+	}
+
+	return diags
+}
+
+// collection is a list or a set: node_groups is a set on HCloud and a list on
+// the other clouds.
+type collection interface {
+	attr.Value
+	Elements() []attr.Value
+}
+
+func checkNodeGroups(policy sdk.Policy, nodeGroups collection, envZones types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var total int64
+	totalKnown := true
+	_, isSet := nodeGroups.(types.Set)
+	for i, element := range nodeGroups.Elements() {
+		group := path.Root("node_groups").AtListIndex(i)
+		if isSet {
+			group = path.Root("node_groups").AtSetValue(element)
+		}
+
+		if nodeType, ok := objectAttribute(element, "node_type").(types.String); ok && len(policy.AllowedNodeTypes) > 0 &&
+			!nodeType.IsUnknown() && !nodeType.IsNull() && !contains(policy.AllowedNodeTypes, nodeType.ValueString()) {
+			diags.AddAttributeError(group.AtName("node_type"), policyViolation,
+				fmt.Sprintf("Node type %q is not allowed by the provider policy. Allowed node types: %s.", nodeType.ValueString(), strings.Join(policy.AllowedNodeTypes, ", ")))
+		}
+
+		capacityName := "capacity_per_zone"
+		capacity, ok := objectAttribute(element, capacityName).(types.Int64)
+		if !ok {
+			capacityName = "capacity_per_location"
+			capacity, ok = objectAttribute(element, capacityName).(types.Int64)
+		}
+		if !ok || capacity.IsUnknown() || capacity.IsNull() {
+			totalKnown = false
+			continue
+		}
+		if policy.MaxCapacityPerZone > 0 && capacity.ValueInt64() > policy.MaxCapacityPerZone {
+			diags.AddAttributeError(group.AtName(capacityName), policyViolation,
+				fmt.Sprintf("%s %d exceeds the maximum of %d set by the provider policy.", capacityName, capacity.ValueInt64(), policy.MaxCapacityPerZone))
+		}
+
+		// A node group without zones of its own spans the env zones.
+		zones := envZones
+		for _, name := range []string{"zones", "locations", "zone_ids"} {
+			if groupZones, ok := objectAttribute(element, name).(types.List); ok && !groupZones.IsNull() {
+				zones = groupZones
+			}
+		}
+		if zones.IsUnknown() || zones.IsNull() {
+			totalKnown = false
+			continue
+		}
+		total += capacity.ValueInt64() * int64(len(zones.Elements()))
+	}
+	if policy.MaxTotalNodes > 0 && totalKnown && total > policy.MaxTotalNodes {
+		diags.AddAttributeError(path.Root("node_groups"), policyViolation,
+			fmt.Sprintf("The node groups add up to %d nodes (capacity per zone times zones), over the maximum of %d set by the provider policy.", total, policy.MaxTotalNodes))
+	}
+	return diags
+}
+
+func checkPublicLoadBalancer(policy sdk.Policy, public attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if isEnabled, known := enabled(public); !known || !isEnabled {
+		return diags
+	}
+	ranges, ok := objectAttribute(public, "source_ip_ranges").(types.List)
+	if !ok || ranges.IsUnknown() {
+		return diags
+	}
+	at := path.Root("load_balancers").AtName("public").AtName("source_ip_ranges")
+	if ranges.IsNull() {
+		if forbidden := forbiddenRange(policy, defaultPublicSourceRange); forbidden != "" {
+			diags.AddAttributeError(at, policyViolation,
+				fmt.Sprintf("The public load balancer allows traffic from %s by default, which includes %s forbidden by the provider policy. Set source_ip_ranges.", defaultPublicSourceRange, forbidden))
+		}
+		return diags
+	}
+	for i, element := range ranges.Elements() {
+		cidr, ok := element.(types.String)
+		if !ok || cidr.IsUnknown() || cidr.IsNull() {
+			continue
+		}
+		if forbidden := forbiddenRange(policy, cidr.ValueString()); forbidden != "" {
+			diags.AddAttributeError(at.AtListIndex(i), policyViolation,
+				fmt.Sprintf("The public load balancer source range %s includes %s forbidden by the provider policy.", cidr.ValueString(), forbidden))
+		}
+	}
+	return diags
+}
+
+// forbiddenRange returns the forbidden public source range that cidr contains,
+// if any.
+func forbiddenRange(policy sdk.Policy, cidr string) string {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return ""
+	}
+	ones, bits := network.Mask.Size()
+	for _, forbidden := range policy.ForbiddenPublicSourceRanges {
+		_, forbiddenNetwork, err := net.ParseCIDR(forbidden)
+		if err != nil {
+			continue
+		}
+		forbiddenOnes, forbiddenBits := forbiddenNetwork.Mask.Size()
+		if bits == forbiddenBits && ones <= forbiddenOnes && network.Contains(forbiddenNetwork.IP) {
+			return forbidden
+		}
+	}
+	return ""
+}
+
+// enabled returns the enabled attribute of object, false if object is null,
+// and whether it is known.
+func enabled(object attr.Value) (bool, bool) {
+	if object == nil || object.IsNull() {
+		return false, true
+	}
+	if object.IsUnknown() {
+		return false, false
+	}
+	value, ok := objectAttribute(object, "enabled").(types.Bool)
+	if !ok || value.IsUnknown() {
+		return false, false
+	}
+	return value.ValueBool(), true
+}
+
+func objectAttribute(object attr.Value, name string) attr.Value {
+	o, ok := object.(types.Object)
+	if !ok || o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	return o.Attributes()[name]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,227 @@
+package env
+
+import (
+	"context"
+	"testing"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// policySchema has the attributes checkPolicy looks at, as the env schemas
+// spell them.
+var policySchema = rschema.Schema{
+	Attributes: map[string]rschema.Attribute{
+		"region": rschema.StringAttribute{Optional: true},
+		"zones":  rschema.ListAttribute{ElementType: types.StringType, Optional: true},
+		"node_groups": rschema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: rschema.NestedAttributeObject{Attributes: map[string]rschema.Attribute{
+				"node_type":         rschema.StringAttribute{Optional: true},
+				"capacity_per_zone": rschema.Int64Attribute{Optional: true},
+				"zones":             rschema.ListAttribute{ElementType: types.StringType, Optional: true},
+			}},
+		},
+		"tags_all": rschema.ListNestedAttribute{
+			Computed:     true,
+			NestedObject: rschema.NestedAttributeObject{Attributes: map[string]rschema.Attribute{"key": rschema.StringAttribute{Optional: true}, "value": rschema.StringAttribute{Optional: true}}},
+		},
+		"load_balancers": rschema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]rschema.Attribute{
+				"public": rschema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]rschema.Attribute{
+						"enabled":          rschema.BoolAttribute{Optional: true},
+						"source_ip_ranges": rschema.ListAttribute{ElementType: types.StringType, Optional: true},
+					},
+				},
+			},
+		},
+		"datadog":          rschema.SingleNestedAttribute{Optional: true, Attributes: map[string]rschema.Attribute{"enabled": rschema.BoolAttribute{Optional: true}}},
+		"metrics_endpoint": rschema.SingleNestedAttribute{Optional: true, Attributes: map[string]rschema.Attribute{"enabled": rschema.BoolAttribute{Optional: true}}},
+	},
+}
+
+func stringList(values ...string) types.List {
+	var elements []attr.Value
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func nodeGroup(nodeType string, capacity int64, zones types.List) attr.Value {
+	return types.ObjectValueMust(
+		map[string]attr.Type{"node_type": types.StringType, "capacity_per_zone": types.Int64Type, "zones": types.ListType{ElemType: types.StringType}},
+		map[string]attr.Value{"node_type": types.StringValue(nodeType), "capacity_per_zone": types.Int64Value(capacity), "zones": zones},
+	)
+}
+
+var nodeGroupType = types.ObjectType{AttrTypes: map[string]attr.Type{"node_type": types.StringType, "capacity_per_zone": types.Int64Type, "zones": types.ListType{ElemType: types.StringType}}}
+
+func policyPlan(t *testing.T, values map[string]attr.Value) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: policySchema, Raw: tftypes.NewValue(policySchema.Type().TerraformType(ctx), nil)}
+	for name, value := range values {
+		if d := plan.SetAttribute(ctx, path.Root(name), value); d.HasError() {
+			t.Fatalf("set %s: %v", name, d.Errors())
+		}
+	}
+	return plan
+}
+
+func TestCheckPolicy(t *testing.T) {
+	publicLB := func(ranges types.List) attr.Value {
+		publicType := map[string]attr.Type{"enabled": types.BoolType, "source_ip_ranges": types.ListType{ElemType: types.StringType}}
+		return types.ObjectValueMust(
+			map[string]attr.Type{"public": types.ObjectType{AttrTypes: publicType}},
+			map[string]attr.Value{"public": types.ObjectValueMust(publicType, map[string]attr.Value{"enabled": types.BoolValue(true), "source_ip_ranges": ranges})},
+		)
+	}
+	enabledObject := func(enabled bool) attr.Value {
+		return types.ObjectValueMust(map[string]attr.Type{"enabled": types.BoolType}, map[string]attr.Value{"enabled": types.BoolValue(enabled)})
+	}
+	tag := func(key string) attr.Value {
+		return types.ObjectValueMust(map[string]attr.Type{"key": types.StringType, "value": types.StringType}, map[string]attr.Value{"key": types.StringValue(key), "value": types.StringValue("v")})
+	}
+	tagType := types.ObjectType{AttrTypes: map[string]attr.Type{"key": types.StringType, "value": types.StringType}}
+
+	tests := []struct {
+		name     string
+		policy   sdk.Policy
+		values   map[string]attr.Value
+		expected []path.Path
+	}{
+		{
+			name:   "Empty policy",
+			values: map[string]attr.Value{"region": types.StringValue("anywhere")},
+		},
+		{
+			name:     "Region not allowed",
+			policy:   sdk.Policy{AllowedRegions: map[string][]string{"aws": {"us-east-1"}}},
+			values:   map[string]attr.Value{"region": types.StringValue("eu-west-1")},
+			expected: []path.Path{path.Root("region")},
+		},
+		{
+			name:   "Region of another cloud",
+			policy: sdk.Policy{AllowedRegions: map[string][]string{"gcp": {"us-east1"}}},
+			values: map[string]attr.Value{"region": types.StringValue("eu-west-1")},
+		},
+		{
+			name:   "Unknown region",
+			policy: sdk.Policy{AllowedRegions: map[string][]string{"aws": {"us-east-1"}}},
+			values: map[string]attr.Value{"region": types.StringUnknown()},
+		},
+		{
+			name:   "Node type and capacity",
+			policy: sdk.Policy{AllowedNodeTypes: []string{"m5.large"}, MaxCapacityPerZone: 5},
+			values: map[string]attr.Value{"node_groups": types.ListValueMust(nodeGroupType, []attr.Value{
+				nodeGroup("m5.large", 5, types.ListNull(types.StringType)),
+				nodeGroup("m5.24xlarge", 50, types.ListNull(types.StringType)),
+			})},
+			expected: []path.Path{
+				path.Root("node_groups").AtListIndex(1).AtName("node_type"),
+				path.Root("node_groups").AtListIndex(1).AtName("capacity_per_zone"),
+			},
+		},
+		{
+			name:   "Total nodes over env zones and node group zones",
+			policy: sdk.Policy{MaxTotalNodes: 12},
+			values: map[string]attr.Value{
+				"zones": stringList("a", "b", "c"),
+				"node_groups": types.ListValueMust(nodeGroupType, []attr.Value{
+					nodeGroup("m5.large", 3, types.ListNull(types.StringType)),
+					nodeGroup("m5.xlarge", 2, stringList("a", "b")),
+				}),
+			},
+			expected: []path.Path{path.Root("node_groups")},
+		},
+		{
+			name:   "Total nodes with unknown zones",
+			policy: sdk.Policy{MaxTotalNodes: 1},
+			values: map[string]attr.Value{
+				"zones":       types.ListUnknown(types.StringType),
+				"node_groups": types.ListValueMust(nodeGroupType, []attr.Value{nodeGroup("m5.large", 3, types.ListNull(types.StringType))}),
+			},
+		},
+		{
+			name:     "Required tag keys",
+			policy:   sdk.Policy{RequiredTagKeys: []string{"owner", "team"}},
+			values:   map[string]attr.Value{"tags_all": types.ListValueMust(tagType, []attr.Value{tag("owner")})},
+			expected: []path.Path{path.Root("tags")},
+		},
+		{
+			name:   "Required tag keys from defaults",
+			policy: sdk.Policy{RequiredTagKeys: []string{"owner"}},
+			values: map[string]attr.Value{"tags_all": types.ListValueMust(tagType, []attr.Value{tag("owner")})},
+		},
+		{
+			name:     "Forbidden public source range",
+			policy:   sdk.Policy{ForbiddenPublicSourceRanges: []string{"0.0.0.0/0"}},
+			values:   map[string]attr.Value{"load_balancers": publicLB(stringList("10.0.0.0/8", "0.0.0.0/0"))},
+			expected: []path.Path{path.Root("load_balancers").AtName("public").AtName("source_ip_ranges").AtListIndex(1)},
+		},
+		{
+			name:     "Public source range containing a forbidden one",
+			policy:   sdk.Policy{ForbiddenPublicSourceRanges: []string{"192.168.1.0/24"}},
+			values:   map[string]attr.Value{"load_balancers": publicLB(stringList("192.168.0.0/16"))},
+			expected: []path.Path{path.Root("load_balancers").AtName("public").AtName("source_ip_ranges").AtListIndex(0)},
+		},
+		{
+			name:     "Public load balancer open by default",
+			policy:   sdk.Policy{ForbiddenPublicSourceRanges: []string{"0.0.0.0/0"}},
+			values:   map[string]attr.Value{"load_balancers": publicLB(types.ListNull(types.StringType))},
+			expected: []path.Path{path.Root("load_balancers").AtName("public").AtName("source_ip_ranges")},
+		},
+		{
+			name:   "Narrow public source range",
+			policy: sdk.Policy{ForbiddenPublicSourceRanges: []string{"0.0.0.0/0"}},
+			values: map[string]attr.Value{"load_balancers": publicLB(stringList("203.0.113.0/24"))},
+		},
+		{
+			name:     "Monitoring required",
+			policy:   sdk.Policy{RequireMonitoring: true},
+			values:   map[string]attr.Value{"datadog": enabledObject(false)},
+			expected: []path.Path{path.Root("metrics_endpoint")},
+		},
+		{
+			name:   "Monitoring with Datadog",
+			policy: sdk.Policy{RequireMonitoring: true},
+			values: map[string]attr.Value{"datadog": enabledObject(true)},
+		},
+		{
+			name:   "Monitoring with the metrics endpoint",
+			policy: sdk.Policy{RequireMonitoring: true},
+			values: map[string]attr.Value{"metrics_endpoint": enabledObject(true)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkPolicy(context.Background(), "aws", tt.policy, policyPlan(t, tt.values))
+			var got []path.Path
+			for _, d := range diags {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok || d.Summary() != policyViolation {
+					t.Fatalf("unexpected diagnostic %s: %s", d.Summary(), d.Detail())
+				}
+				got = append(got, withPath.Path())
+			}
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected violations at %v, got %v (%v)", tt.expected, got, diags)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.expected[i]) {
+					t.Errorf("expected violation at %s, got %s", tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package env
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ReconcileTimeout bounds the read ReconcileCreate makes after a failed create.
+// It has its own budget, since the create may have failed because the
+// caller's context expired.
+var ReconcileTimeout = 30 * time.Second
+
+// ReconcileCreate recovers from a create mutation that failed at the transport
+// level. Mutations are never retried, but the server may have committed the
+// create before the response was lost, in which case the next apply fails with
+// "environment already exists". The env is looked up by name and, if its spec
+// matches the plan, the refreshed model is returned so the caller can adopt it
+// into state. A nil model means the create really failed and createErr must be
+// reported as usual.
+func ReconcileCreate(ctx context.Context, plan tfsdk.Plan, envName string, createErr error, fetch SpecRevisionFunc) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !client.IsTransportError(createErr) {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "create failed at the transport level, checking whether the env was created", map[string]interface{}{"name": envName, "error": createErr.Error()})
+	// The env may have been created since the last cached read of it.
+	fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), ReconcileTimeout)
+	defer cancel()
+	result, d, err := fetch(client.WithoutQueryCache(fetchCtx))
+	diags.Append(d...)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return nil, diags
+		}
+		diags.AddWarning("Unable to Verify Env Creation",
+			fmt.Sprintf("The create request for env %s failed before a response was received, and reading it back failed too: %s\nThe env may have been created; if so, import it with `terraform import`.", envName, client.FormatError(err, envName)))
+		return nil, diags
+	}
+	if diags.HasError() || result == nil {
+		return nil, diags
+	}
+
+	mismatches, d := PlanMismatches(ctx, plan, result.Model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(mismatches) > 0 {
+		diags.AddWarning("Env Exists With a Different Spec",
+			fmt.Sprintf("The create request for env %s failed before a response was received. An env with that name exists, but it differs from the configuration in: %s.\nIt was not adopted; import it with `terraform import` if it is yours.", envName, strings.Join(mismatches, ", ")))
+		return nil, diags
+	}
+
+	tflog.Info(ctx, "adopting env created despite a transport error", map[string]interface{}{"name": envName, "spec_revision": result.SpecRevision})
+	diags.AddWarning("Env Adopted After Create Error",
+		fmt.Sprintf("The create request for env %s failed with: %s\nThe env was created anyway and matches the configuration, so it was adopted into state.", envName, createErr))
+	return result.Model, diags
+}
+
+// PlanMismatches returns the sorted names of the top-level env spec attributes
+// whose planned values differ from model, a model of the same resource schema.
+// Values still unknown in the plan (computed by the server) match anything.
+func PlanMismatches(ctx context.Context, plan tfsdk.Plan, model interface{}) ([]string, diag.Diagnostics) {
+	current := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}
+	diags := current.Set(ctx, model)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var planned, actual map[string]tftypes.Value
+	if err := plan.Raw.As(&planned); err != nil {
+		diags.AddError("Unable to compare env plan", err.Error())
+		return nil, diags
+	}
+	if err := current.Raw.As(&actual); err != nil {
+		diags.AddError("Unable to compare env plan", err.Error())
+		return nil, diags
+	}
+
+	var mismatches []string
+	for name, value := range planned {
+		if specRevisionIgnoredAttributes[name] {
+			continue
+		}
+		if !plannedValueMatches(value, actual[name]) {
+			mismatches = append(mismatches, name)
+		}
+	}
+	sort.Strings(mismatches)
+
+	return mismatches, diags
+}
+
+func plannedValueMatches(planned, actual tftypes.Value) bool {
+	if !planned.IsKnown() {
+		return true
+	}
+	if planned.IsFullyKnown() || planned.IsNull() {
+		return planned.Equal(actual)
+	}
+	if !actual.IsKnown() || actual.IsNull() {
+		return false
+	}
+
+	switch {
+	case planned.Type().Is(tftypes.Object{}), planned.Type().Is(tftypes.Map{}):
+		var p, a map[string]tftypes.Value
+		if planned.As(&p) != nil || actual.As(&a) != nil || len(p) != len(a) {
+			return false
+		}
+		for k, v := range p {
+			if !plannedValueMatches(v, a[k]) {
+				return false
+			}
+		}
+		return true
+	case planned.Type().Is(tftypes.List{}), planned.Type().Is(tftypes.Tuple{}):
+		var p, a []tftypes.Value
+		if planned.As(&p) != nil || actual.As(&a) != nil || len(p) != len(a) {
+			return false
+		}
+		for i := range p {
+			if !plannedValueMatches(p[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	case planned.Type().Is(tftypes.Set{}):
+		// Set elements with unknowns can't be paired, so only the size is checked.
+		var p, a []tftypes.Value
+		return planned.As(&p) == nil && actual.As(&a) == nil && len(p) == len(a)
+	}
+	return false
+}
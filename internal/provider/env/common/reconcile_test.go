@@ -0,0 +1,142 @@
+package env
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func reconcileTestPlan(t *testing.T) tfsdk.Plan {
+	t.Helper()
+	planned := specRevisionTestPrior()
+	planned.SpecRevision = types.Int64Unknown()
+	state := specRevisionTestState(t, planned)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestReconcileCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		createErr    error
+		region       string
+		fetchErr     error
+		missing      bool
+		expectAdopt  bool
+		expectFetch  bool
+		expectDetail string
+	}{
+		"graphql error is not reconciled": {
+			createErr: gqlConflictErr(),
+		},
+		"transport error and env missing": {
+			createErr:   errors.New("read: connection reset by peer"),
+			missing:     true,
+			expectFetch: true,
+		},
+		"transport error and env not found": {
+			createErr:   errors.New("read: connection reset by peer"),
+			fetchErr:    notFoundErr(),
+			expectFetch: true,
+		},
+		"transport error and matching env is adopted": {
+			createErr:    errors.New("read: connection reset by peer"),
+			region:       "us-east-1",
+			expectAdopt:  true,
+			expectFetch:  true,
+			expectDetail: "adopted into state",
+		},
+		"transport error and different env is not adopted": {
+			createErr:    errors.New("read: connection reset by peer"),
+			region:       "eu-west-1",
+			expectFetch:  true,
+			expectDetail: "differs from the configuration in: region",
+		},
+		"transport error and failed read warns": {
+			createErr:    errors.New("read: connection reset by peer"),
+			fetchErr:     errors.New("connection refused"),
+			expectFetch:  true,
+			expectDetail: "import it with `terraform import`",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			fetched := false
+
+			adopted, diags := ReconcileCreate(context.Background(), reconcileTestPlan(t), "acme-prod", tc.createErr,
+				func(ctx context.Context) (*SpecRevisionResult, diag.Diagnostics, error) {
+					fetched = true
+					if tc.fetchErr != nil || tc.missing {
+						return nil, nil, tc.fetchErr
+					}
+					current := specRevisionTestPrior()
+					current.Region = types.StringValue(tc.region)
+					current.SpecRevision = types.Int64Value(1)
+					return &SpecRevisionResult{SpecRevision: 1, Model: &current}, nil, nil
+				},
+			)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags.Errors())
+			}
+			if fetched != tc.expectFetch {
+				t.Errorf("expected fetch=%v, got %v", tc.expectFetch, fetched)
+			}
+			if (adopted != nil) != tc.expectAdopt {
+				t.Errorf("expected adopt=%v, got %v", tc.expectAdopt, adopted)
+			}
+			if tc.expectDetail == "" {
+				if len(diags) > 0 {
+					t.Errorf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if len(diags) == 0 || !strings.Contains(diags[0].Detail(), tc.expectDetail) {
+				t.Errorf("expected detail to contain %q, got %v", tc.expectDetail, diags)
+			}
+		})
+	}
+}
+
+func gqlConflictErr() error {
+	return &clientv2.ErrorResponse{GqlErrors: &gqlerror.List{{Message: "conflict", Path: ast.Path{ast.PathName("createAWSEnv")}}}}
+}
+
+func TestReconcileCreateExpiredContext(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	adopted, diags := ReconcileCreate(ctx, reconcileTestPlan(t), "acme-prod", ctx.Err(),
+		func(ctx context.Context) (*SpecRevisionResult, diag.Diagnostics, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("expected the read to have a deadline")
+			}
+			current := specRevisionTestPrior()
+			current.Region = types.StringValue("us-east-1")
+			current.SpecRevision = types.Int64Value(1)
+			return &SpecRevisionResult{SpecRevision: 1, Model: &current}, nil, nil
+		},
+	)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags.Errors())
+	}
+	if adopted == nil {
+		t.Errorf("expected the env to be adopted, got %v", diags)
+	}
+}
@@ -24,7 +24,7 @@ import (
 type StatusCheckFunc func(ctx context.Context, name string) (bool, error)
 
 // ErrEnvNotFound reports an env that is already gone. A status query can succeed
-// and still return a null env, which carries no SDK error for IsNotFoundError to
+// and still return a null env, which carries no SDK error for client.ErrNotFound to
 // recognize; returning (false, nil) instead would strand a pendingMFA delete in
 // PENDING_MFA until the MFA timeout, so callers return this to mean "deleted".
 var ErrEnvNotFound = errors.New("environment not found")
@@ -34,8 +34,12 @@ var DeleteTimeout = 60 * time.Minute
 var DeletePollInterval = 30 * time.Second
 
 type EnvResourceBase struct {
-	Client *client.Client
-	Auth   *auth.Auth
+	// Cloud is the cloud of the resource, as in its type name, e.g. aws.
+	Cloud    string
+	Client   *client.Client
+	Auth     *auth.Auth
+	Defaults Defaults
+	Policy   sdk.Policy
 }
 
 func (r *EnvResourceBase) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -56,6 +60,8 @@ func (r *EnvResourceBase) Configure(ctx context.Context, req resource.ConfigureR
 
 	r.Client = sdk.Client
 	r.Auth = sdk.Auth
+	r.Defaults = defaultsToModel(sdk.Defaults)
+	r.Policy = sdk.Policy
 }
 
 func (r *EnvResourceBase) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -67,12 +73,15 @@ func (r *EnvResourceBase) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.SetAttribute(ctx, path.Root("allow_delete_while_disconnected"), false)
 	resp.Diagnostics.Append(diags...)
+	diags = resp.State.SetAttribute(ctx, path.Root("allow_spec_revision_mismatch"), false)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(r.SeedAppliedSpecRevision(ctx, resp.Private, req.ID)...)
 }
 
 func (r *EnvResourceBase) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -86,8 +95,18 @@ func (r *EnvResourceBase) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	r.planDefaults(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkPolicy(ctx, r.Cloud, r.Policy, resp.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// spec_revision is server-reassigned each update; unknown-on-change so the plan doesn't pin the stale value.
-	if !req.State.Raw.IsNull() && !req.Plan.Raw.Equal(req.State.Raw) {
+	if !req.State.Raw.IsNull() && !resp.Plan.Raw.Equal(req.State.Raw) {
+		explainDrift(ctx, req, resp)
 		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("spec_revision"), types.Int64Unknown())...)
 	}
 }
@@ -110,11 +129,10 @@ func waitForDeletion(ctx context.Context, resp *resource.DeleteResponse, envName
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"PENDING_MFA", "DELETING"},
 		Target:  []string{"DELETED"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: clientsupport.TracePoll(ctx, "WaitForDeletion", envName, func(ctx context.Context) (interface{}, string, error) {
 			pendingDelete, err := checkStatus(ctx, envName)
 			if err != nil {
-				notFound, _ := client.IsNotFoundError(err)
-				if notFound || errors.Is(err, ErrEnvNotFound) {
+				if errors.Is(err, client.ErrNotFound) || errors.Is(err, ErrEnvNotFound) {
 					tflog.Trace(ctx, "deleted resource", map[string]interface{}{"name": envName})
 					return envName, "DELETED", nil
 				}
@@ -140,7 +158,7 @@ func waitForDeletion(ctx context.Context, resp *resource.DeleteResponse, envName
 			}
 
 			return envName, "DELETING", nil
-		},
+		}),
 		Timeout:      deleteTimeout,
 		PollInterval: pollInterval,
 	}
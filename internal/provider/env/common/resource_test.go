@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -15,7 +16,11 @@ import (
 const testPollInterval = 50 * time.Millisecond
 
 func notFoundErr() error {
-	return fmt.Errorf(`{"networkErrors":null,"graphqlErrors":[{"message":"not found","path":["env"],"extensions":{"code":"NOT_FOUND"}}]}`)
+	return &client.APIError{Errors: []client.GraphQLError{{
+		Message:    "not found",
+		Path:       []interface{}{"env"},
+		Extensions: map[string]interface{}{"code": "NOT_FOUND"},
+	}}}
 }
 
 func TestWaitForDeletion_NotFoundImmediate(t *testing.T) {
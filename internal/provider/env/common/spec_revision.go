@@ -0,0 +1,124 @@
+package env
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SpecRevisionResult is the env as the server currently sees it.
+type SpecRevisionResult struct {
+	SpecRevision int64
+	// Model is the prior state model refreshed with the server spec, so it can
+	// be diffed against the prior state.
+	Model interface{}
+}
+
+// SpecRevisionFunc fetches the current env from the API. It returns a nil
+// result when the env no longer exists.
+type SpecRevisionFunc func(ctx context.Context) (*SpecRevisionResult, diag.Diagnostics, error)
+
+// specRevisionIgnoredAttributes are not part of the env spec: they are either
+// server-assigned bookkeeping or local-only settings that never reach the API.
+var specRevisionIgnoredAttributes = map[string]bool{
+	"id":                              true,
+	"spec_revision":                   true,
+	"timeouts":                        true,
+	"force_destroy":                   true,
+	"force_destroy_clusters":          true,
+	"skip_deprovision_on_destroy":     true,
+	"allow_delete_while_disconnected": true,
+	"allow_spec_revision_mismatch":    true,
+}
+
+// CheckSpecRevision guards an update against out-of-band changes. Updates send
+// the whole spec (UpdateStrategyReplace), so if the env was edited outside of
+// Terraform since the last refresh the update would silently revert it.
+// The server's spec_revision is compared with the one in state right before the
+// update mutation; on mismatch it fails, or warns when allowMismatch is set,
+// listing the attributes that changed on the server.
+func CheckSpecRevision(ctx context.Context, prior tfsdk.State, envName string, allowMismatch bool, fetch SpecRevisionFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var stateRevision types.Int64
+	diags.Append(prior.GetAttribute(ctx, path.Root("spec_revision"), &stateRevision)...)
+	if diags.HasError() || stateRevision.IsNull() || stateRevision.IsUnknown() {
+		return diags
+	}
+
+	// A cached response could predate the very change this check is for.
+	result, d, err := fetch(client.WithoutQueryCache(ctx))
+	diags.Append(d...)
+	if err != nil {
+		clientsupport.AddClientError(&diags, fmt.Sprintf("Unable to read env %s, got error: %s", envName, client.FormatError(err, envName)))
+		return diags
+	}
+	// A missing env or unchanged revision is left to the update mutation itself.
+	if diags.HasError() || result == nil || result.SpecRevision == stateRevision.ValueInt64() {
+		return diags
+	}
+
+	changed, d := ChangedAttributes(ctx, prior, result.Model)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	detail := fmt.Sprintf("Env %s was modified outside of Terraform since the last refresh: spec revision is %d on the server but %d in state.\n", envName, result.SpecRevision, stateRevision.ValueInt64())
+	if len(changed) > 0 {
+		detail += fmt.Sprintf("Attributes changed on the server: %s.\n", strings.Join(changed, ", "))
+	}
+
+	if allowMismatch {
+		detail += "Continuing because `allow_spec_revision_mismatch` is set; the server changes will be overwritten."
+		diags.AddAttributeWarning(path.Root("spec_revision"), "Spec Revision Mismatch", detail)
+		return diags
+	}
+
+	detail += "Run `terraform plan` again to review the server changes, or set `allow_spec_revision_mismatch=true` to overwrite them."
+	diags.AddAttributeError(path.Root("spec_revision"), "Spec Revision Mismatch", detail)
+	return diags
+}
+
+// ChangedAttributes returns the sorted names of the top-level env spec
+// attributes that differ between the prior state and current, a model of the
+// same resource schema.
+func ChangedAttributes(ctx context.Context, prior tfsdk.State, current interface{}) ([]string, diag.Diagnostics) {
+	updated := tfsdk.State{Schema: prior.Schema, Raw: prior.Raw.Copy()}
+	diags := updated.Set(ctx, current)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var before, after map[string]tftypes.Value
+	if err := prior.Raw.As(&before); err != nil {
+		diags.AddError("Unable to compare env state", err.Error())
+		return nil, diags
+	}
+	if err := updated.Raw.As(&after); err != nil {
+		diags.AddError("Unable to compare env state", err.Error())
+		return nil, diags
+	}
+
+	var changed []string
+	for name, value := range after {
+		if specRevisionIgnoredAttributes[name] {
+			continue
+		}
+		if !value.Equal(before[name]) {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed, diags
+}
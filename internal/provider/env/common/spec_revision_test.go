@@ -0,0 +1,137 @@
+package env
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type specRevisionTestModel struct {
+	Name         types.String `tfsdk:"name"`
+	Region       types.String `tfsdk:"region"`
+	Tags         types.List   `tfsdk:"tags"`
+	SpecRevision types.Int64  `tfsdk:"spec_revision"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+}
+
+func specRevisionTestState(t *testing.T, model specRevisionTestModel) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	schema := rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"name":          rschema.StringAttribute{Required: true},
+			"region":        rschema.StringAttribute{Optional: true},
+			"tags":          rschema.ListAttribute{Optional: true, ElementType: types.StringType},
+			"spec_revision": rschema.Int64Attribute{Computed: true},
+			"force_destroy": rschema.BoolAttribute{Optional: true},
+		},
+	}
+	state := tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("set state: %v", diags.Errors())
+	}
+	return state
+}
+
+func specRevisionTestPrior() specRevisionTestModel {
+	return specRevisionTestModel{
+		Name:         types.StringValue("acme-prod"),
+		Region:       types.StringValue("us-east-1"),
+		Tags:         types.ListNull(types.StringType),
+		SpecRevision: types.Int64Value(3),
+		ForceDestroy: types.BoolValue(false),
+	}
+}
+
+func TestChangedAttributes(t *testing.T) {
+	t.Parallel()
+	prior := specRevisionTestPrior()
+	state := specRevisionTestState(t, prior)
+
+	current := prior
+	current.Region = types.StringValue("us-west-2")
+	current.Tags = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("team")})
+	current.SpecRevision = types.Int64Value(4)
+	current.ForceDestroy = types.BoolValue(true)
+
+	changed, diags := ChangedAttributes(context.Background(), state, &current)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags.Errors())
+	}
+	if got := strings.Join(changed, ","); got != "region,tags" {
+		t.Errorf("expected region,tags, got %q", got)
+	}
+}
+
+func TestCheckSpecRevision(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		result        *SpecRevisionResult
+		err           error
+		allowMismatch bool
+		expectErr     bool
+		expectWarn    bool
+		expectDetail  string
+	}{
+		"matching revision passes": {
+			result: &SpecRevisionResult{SpecRevision: 3},
+		},
+		"missing env is left to the update": {
+			result: nil,
+		},
+		"mismatch fails listing changed attributes": {
+			result:       &SpecRevisionResult{SpecRevision: 5},
+			expectErr:    true,
+			expectDetail: "Attributes changed on the server: region.",
+		},
+		"mismatch warns when allowed": {
+			result:        &SpecRevisionResult{SpecRevision: 5},
+			allowMismatch: true,
+			expectWarn:    true,
+			expectDetail:  "spec revision is 5 on the server but 3 in state",
+		},
+		"read error fails": {
+			err:          errors.New("connection refused"),
+			expectErr:    true,
+			expectDetail: "Unable to read env acme-prod",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			prior := specRevisionTestPrior()
+			state := specRevisionTestState(t, prior)
+			if tc.result != nil {
+				current := prior
+				current.Region = types.StringValue("eu-west-1")
+				tc.result.Model = &current
+			}
+
+			diags := CheckSpecRevision(context.Background(), state, "acme-prod", tc.allowMismatch,
+				func(ctx context.Context) (*SpecRevisionResult, diag.Diagnostics, error) {
+					return tc.result, nil, tc.err
+				},
+			)
+
+			if diags.HasError() != tc.expectErr {
+				t.Fatalf("expected error=%v, got %v", tc.expectErr, diags)
+			}
+			if got := diags.WarningsCount() > 0; got != tc.expectWarn {
+				t.Fatalf("expected warning=%v, got %v", tc.expectWarn, diags)
+			}
+			if tc.expectDetail != "" && !strings.Contains(diags[0].Detail(), tc.expectDetail) {
+				t.Errorf("expected detail to contain %q, got %q", tc.expectDetail, diags[0].Detail())
+			}
+		})
+	}
+}
@@ -49,6 +49,9 @@ func (d *GCPEnvDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *GCPEnvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_gcp", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading aws env state source")
 
 	var data GCPEnvDataSourceModel
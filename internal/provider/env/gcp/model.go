@@ -36,12 +36,40 @@ type GCPEnvModel struct {
 	ForceDestroyClusters         types.Bool  `tfsdk:"force_destroy_clusters"`
 	SkipDeprovisionOnDestroy     types.Bool  `tfsdk:"skip_deprovision_on_destroy"`
 	AllowDeleteWhileDisconnected types.Bool  `tfsdk:"allow_delete_while_disconnected"`
+	AllowSpecRevisionMismatch    types.Bool  `tfsdk:"allow_spec_revision_mismatch"`
 }
 
 // Split models: `timeouts` only exists on the resource schema and the framework requires an exact struct/schema match.
 type GCPEnvResourceModel struct {
 	GCPEnvModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	LabelsAll             []common.KeyValueModel          `tfsdk:"labels_all"`
+	MaintenanceWindowsAll []common.MaintenanceWindowModel `tfsdk:"maintenance_windows_all"`
+	Timeouts              timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// withDefaults returns the env to send to the API: its labels and maintenance
+// windows merged with the provider defaults.
+func (model *GCPEnvResourceModel) withDefaults(defaults common.Defaults) *GCPEnvModel {
+	env := model.GCPEnvModel
+	env.Labels = common.MergeTags(defaults.Labels, model.Labels)
+	env.MaintenanceWindows = common.MergeMaintenanceWindows(defaults.MaintenanceWindows, model.MaintenanceWindows)
+	return &env
+}
+
+// applyDefaults splits the labels and maintenance windows of the env as read
+// into the resource's own and all of them, given prior, the env before the
+// read.
+func (model *GCPEnvResourceModel) applyDefaults(prior GCPEnvModel, defaults common.Defaults) {
+	model.Labels, model.LabelsAll = common.SplitTags(prior.Labels, model.Labels, defaults.Labels)
+	model.MaintenanceWindows, model.MaintenanceWindowsAll = common.SplitMaintenanceWindows(prior.MaintenanceWindows, model.MaintenanceWindows, defaults.MaintenanceWindows)
+}
+
+// applyEnvWithDefaults is applyEnv for the resource.
+func (model *GCPEnvResourceModel) applyEnvWithDefaults(ctx context.Context, env *sdk.GetGCPEnv_GCPEnv, defaults common.Defaults) diag.Diagnostics {
+	prior := model.GCPEnvModel
+	diags := model.applyEnv(ctx, env)
+	model.applyDefaults(prior, defaults)
+	return diags
 }
 
 type GCPEnvDataSourceModel struct {
@@ -159,6 +187,32 @@ func (e GCPEnvModel) toSDK(ctx context.Context) (sdk.CreateGCPEnvInput, sdk.Upda
 	return create, update, allDiags
 }
 
+// applyEnv maps the env returned by the API, after reordering its lists to
+// respect the order in the user's configuration.
+func (model *GCPEnvModel) applyEnv(ctx context.Context, env *sdk.GetGCPEnv_GCPEnv) diag.Diagnostics {
+	var allDiags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	env.Spec.NodeGroups = common.ReorderByKey(model.NodeGroups, env.Spec.NodeGroups,
+		func(m common.NodeGroupsModel) string { return m.NodeType.ValueString() },
+		func(s *sdk.GCPEnvSpecFragment_NodeGroups) string { return s.NodeType },
+	)
+	allDiags.Append(reorderNodeGroupZones(ctx, model.NodeGroups, env.Spec.NodeGroups)...)
+	env.Spec.Zones, diags = common.ReorderList(ctx, model.Zones, env.Spec.Zones)
+	allDiags.Append(diags...)
+	env.Spec.Labels = common.ReorderByKey(model.Labels, env.Spec.Labels,
+		func(m common.KeyValueModel) string { return m.Key.ValueString() },
+		func(s *sdk.GCPEnvSpecFragment_Labels) string { return s.Key },
+	)
+	env.Spec.PeeringConnections = common.ReorderByKey(model.PeeringConnections, env.Spec.PeeringConnections,
+		func(m GCPEnvPeeringConnectionModel) string { return m.NetworkName.ValueString() },
+		func(s *sdk.GCPEnvSpecFragment_PeeringConnections) string { return s.NetworkName },
+	)
+	allDiags.Append(model.toModel(*env)...)
+
+	return allDiags
+}
+
 func (model *GCPEnvModel) toModel(env sdk.GetGCPEnv_GCPEnv) diag.Diagnostics {
 	var allDiags diag.Diagnostics
 	model.Name = types.StringValue(env.Name)
@@ -2,13 +2,16 @@ package env
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,7 +22,7 @@ var _ resource.ResourceWithImportState = &GCPEnvResource{}
 var _ resource.ResourceWithValidateConfig = &GCPEnvResource{}
 
 func NewGCPEnvResource() resource.Resource {
-	return &GCPEnvResource{}
+	return &GCPEnvResource{EnvResourceBase: common.EnvResourceBase{Cloud: envstatus.CloudGCP}}
 }
 
 type GCPEnvResource struct {
@@ -47,6 +50,9 @@ func (r *GCPEnvResource) ValidateConfig(ctx context.Context, req resource.Valida
 }
 
 func (r *GCPEnvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_gcp", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *GCPEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -58,7 +64,7 @@ func (r *GCPEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "creating resource", map[string]interface{}{"name": name})
 
-	sdkEnv, _, diags := data.toSDK(ctx)
+	sdkEnv, _, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -67,7 +73,28 @@ func (r *GCPEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	apiResp, err := r.Client.CreateGCPEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to create env %s, got error: %s", name, client.FormatError(err, name)))
+		// The create may have been committed even though the response was lost.
+		adopted, diags := common.ReconcileCreate(ctx, req.Plan, name, err,
+			func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+				current, err := r.Client.GetGCPEnv(ctx, name)
+				if err != nil || current.GCPEnv == nil {
+					return nil, nil, err
+				}
+				model := *data
+				diags := model.applyEnvWithDefaults(ctx, current.GCPEnv, r.Defaults)
+				return &common.SpecRevisionResult{SpecRevision: current.GCPEnv.SpecRevision, Model: &model}, diags, nil
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if adopted == nil {
+			common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to create env %s", name), err, name)
+			return
+		}
+
+		data = adopted.(*GCPEnvResourceModel)
+		data.Id = data.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 		return
 	}
 
@@ -101,9 +128,13 @@ func (r *GCPEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *GCPEnvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_gcp", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *GCPEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -117,8 +148,7 @@ func (r *GCPEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 	apiResp, err := r.Client.GetGCPEnv(ctx, envName)
 
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "removing resource from state", map[string]interface{}{"name": envName})
 			resp.State.RemoveResource(ctx)
 		} else {
@@ -127,23 +157,7 @@ func (r *GCPEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Reorder node groups, zones, labels and peering connections to respect order in the user's configuration
-	apiResp.GCPEnv.Spec.NodeGroups = common.ReorderByKey(data.NodeGroups, apiResp.GCPEnv.Spec.NodeGroups,
-		func(m common.NodeGroupsModel) string { return m.NodeType.ValueString() },
-		func(s *client.GCPEnvSpecFragment_NodeGroups) string { return s.NodeType },
-	)
-	resp.Diagnostics.Append(reorderNodeGroupZones(ctx, data.NodeGroups, apiResp.GCPEnv.Spec.NodeGroups)...)
-	apiResp.GCPEnv.Spec.Zones, diags = common.ReorderList(ctx, data.Zones, apiResp.GCPEnv.Spec.Zones)
-	resp.Diagnostics.Append(diags...)
-	apiResp.GCPEnv.Spec.Labels = common.ReorderByKey(data.Labels, apiResp.GCPEnv.Spec.Labels,
-		func(m common.KeyValueModel) string { return m.Key.ValueString() },
-		func(s *client.GCPEnvSpecFragment_Labels) string { return s.Key },
-	)
-	apiResp.GCPEnv.Spec.PeeringConnections = common.ReorderByKey(data.PeeringConnections, apiResp.GCPEnv.Spec.PeeringConnections,
-		func(m GCPEnvPeeringConnectionModel) string { return m.NetworkName.ValueString() },
-		func(s *client.GCPEnvSpecFragment_PeeringConnections) string { return s.NetworkName },
-	)
-	diags = data.toModel(*apiResp.GCPEnv)
+	diags = data.applyEnvWithDefaults(ctx, apiResp.GCPEnv, r.Defaults)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -152,9 +166,13 @@ func (r *GCPEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.SeedAppliedSpecRevision(ctx, resp.Private, envName)...)
 }
 
 func (r *GCPEnvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_gcp", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *GCPEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -166,15 +184,35 @@ func (r *GCPEnvResource) Update(ctx context.Context, req resource.UpdateRequest,
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "updating resource", map[string]interface{}{"name": name})
 
-	_, sdkEnv, diags := data.toSDK(ctx)
+	_, sdkEnv, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(common.CheckSpecRevision(ctx, req.State, name, data.AllowSpecRevisionMismatch.ValueBool(),
+		func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+			var prior *GCPEnvResourceModel
+			diags := req.State.Get(ctx, &prior)
+			if diags.HasError() {
+				return nil, diags, nil
+			}
+			current, err := r.Client.GetGCPEnv(ctx, name)
+			if err != nil || current.GCPEnv == nil {
+				return nil, diags, err
+			}
+			diags.Append(prior.applyEnvWithDefaults(ctx, current.GCPEnv, r.Defaults)...)
+			return &common.SpecRevisionResult{SpecRevision: current.GCPEnv.SpecRevision, Model: prior}, diags, nil
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, err := r.Client.UpdateGCPEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update env %s, got error: %s", name, client.FormatError(err, name)))
+		common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to update env %s", name), err, name)
 		return
 	}
 
@@ -207,9 +245,13 @@ func (r *GCPEnvResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *GCPEnvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_gcp", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *GCPEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -226,8 +268,7 @@ func (r *GCPEnvResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	envStatus, err := r.Client.GetGCPEnvStatus(ctx, envName)
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "deleted resource", map[string]interface{}{"name": envName})
 		} else {
 			clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read env status %s, got error: %s", envName, err))
@@ -238,6 +279,7 @@ func (r *GCPEnvResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if len(envStatus.GCPEnv.Status.Errors) > 0 {
 		for _, err := range envStatus.GCPEnv.Status.Errors {
 			resp.Diagnostics.Append(common.ValidateDisconnected(
+				envstatus.CloudGCP,
 				envName,
 				string(err.Code),
 				envStatus.GCPEnv.Status.AppliedSpecRevision,
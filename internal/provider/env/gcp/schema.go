@@ -30,6 +30,7 @@ func (r *GCPEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"load_balancers":            getLoadBalancersAttribute(false, true, true),
 			"load_balancing_strategy":   common.GetLoadBalancingStrategyAttribute(false, true, true),
 			"maintenance_windows":       common.GetMaintenanceWindowAttribute(false, true, false),
+			"maintenance_windows_all":   common.GetMaintenanceWindowsAllAttribute(),
 			"cidr":                      common.GetCIDRAttribute(true, false, false),
 			"zones":                     common.GetZonesAttribute(false, true, true, common.GCP_ZONES_DESCRIPTION),
 			"node_groups":               common.GetNodeGroupsAttribute(true, false, false),
@@ -38,6 +39,7 @@ func (r *GCPEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"peering_connections":       getPeeringConnectionsAttribute(false, true, false),
 			"private_service_consumers": getPrivateServiceConsumersAttribute(false, true, false),
 			"labels":                    getLabelsAttribute(false, true, false),
+			"labels_all":                common.GetTagsAllAttribute(common.GCP_LABELS_ALL_DESCRIPTION),
 			"metrics_endpoint":          common.GetMetricsEndpointAttribute(false, true, true),
 			"datadog":                   common.GetDatadogAttribute(false, true, false),
 			"spec_revision":             common.SpecRevisionAttribute,
@@ -46,6 +48,7 @@ func (r *GCPEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, true, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, true, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, true, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, true, true),
 		},
 		Blocks: map[string]rschema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -84,6 +87,7 @@ func (d *GCPEnvDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, false, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, false, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, false, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, false, true),
 		},
 	}
 }
@@ -0,0 +1,32 @@
+package env
+
+import (
+	"context"
+	"testing"
+
+	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestHCloudMutationErrorPaths(t *testing.T) {
+	r := NewHCloudEnvResource().(*HCloudEnvResource)
+	var schema resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schema)
+
+	tests := map[string]struct {
+		inputPath []interface{}
+		want      string
+	}{
+		"network zone": {inputPath: []interface{}{"spec", "networkZone"}, want: "network_zone"},
+		"locations":    {inputPath: []interface{}{"spec", "locations", 0}, want: "locations[0]"},
+		"node group":   {inputPath: []interface{}{"spec", "nodeGroups", 1, "capacityPerLocation"}, want: "node_groups"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := common.AttributePath(context.Background(), schema.Schema, tc.inputPath)
+			if !ok || got.String() != tc.want {
+				t.Errorf("got %s (ok=%v), want %s", got, ok, tc.want)
+			}
+		})
+	}
+}
@@ -32,12 +32,36 @@ type HCloudEnvModel struct {
 	ForceDestroyClusters         types.Bool  `tfsdk:"force_destroy_clusters"`
 	SkipDeprovisionOnDestroy     types.Bool  `tfsdk:"skip_deprovision_on_destroy"`
 	AllowDeleteWhileDisconnected types.Bool  `tfsdk:"allow_delete_while_disconnected"`
+	AllowSpecRevisionMismatch    types.Bool  `tfsdk:"allow_spec_revision_mismatch"`
 }
 
 // Split models: `timeouts` only exists on the resource schema and the framework requires an exact struct/schema match.
 type HCloudEnvResourceModel struct {
 	HCloudEnvModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	MaintenanceWindowsAll []common.MaintenanceWindowModel `tfsdk:"maintenance_windows_all"`
+	Timeouts              timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// withDefaults returns the env to send to the API: its maintenance windows
+// merged with the provider defaults.
+func (model *HCloudEnvResourceModel) withDefaults(defaults common.Defaults) *HCloudEnvModel {
+	env := model.HCloudEnvModel
+	env.MaintenanceWindows = common.MergeMaintenanceWindows(defaults.MaintenanceWindows, model.MaintenanceWindows)
+	return &env
+}
+
+// applyDefaults splits the maintenance windows of the env as read into the
+// resource's own and all of them, given prior, the env before the read.
+func (model *HCloudEnvResourceModel) applyDefaults(prior HCloudEnvModel, defaults common.Defaults) {
+	model.MaintenanceWindows, model.MaintenanceWindowsAll = common.SplitMaintenanceWindows(prior.MaintenanceWindows, model.MaintenanceWindows, defaults.MaintenanceWindows)
+}
+
+// applyEnvWithDefaults is applyEnv for the resource.
+func (model *HCloudEnvResourceModel) applyEnvWithDefaults(ctx context.Context, env *client.GetHCloudEnv_HcloudEnv, defaults common.Defaults) diag.Diagnostics {
+	prior := model.HCloudEnvModel
+	diags := model.applyEnv(ctx, env)
+	model.applyDefaults(prior, defaults)
+	return diags
 }
 
 type HCloudEnvDataSourceModel struct {
@@ -143,6 +167,24 @@ func (e HCloudEnvModel) toSDK(ctx context.Context) (client.CreateHCloudEnvInput,
 	return create, update, allDiags
 }
 
+// applyEnv maps the env returned by the API, after reordering its lists to
+// respect the order in the user's configuration.
+func (model *HCloudEnvModel) applyEnv(ctx context.Context, env *client.GetHCloudEnv_HcloudEnv) diag.Diagnostics {
+	var allDiags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	env.Spec.NodeGroups = common.ReorderByKey(model.NodeGroups, env.Spec.NodeGroups,
+		func(m NodeGroupsModel) string { return m.NodeType.ValueString() },
+		func(s *client.HCloudEnvSpecFragment_NodeGroups) string { return s.NodeType },
+	)
+	allDiags.Append(reorderNodeGroupLocations(ctx, model.NodeGroups, env.Spec.NodeGroups)...)
+	env.Spec.Locations, diags = common.ReorderList(ctx, model.Locations, env.Spec.Locations)
+	allDiags.Append(diags...)
+	allDiags.Append(model.toModel(*env)...)
+
+	return allDiags
+}
+
 func (model *HCloudEnvModel) toModel(env client.GetHCloudEnv_HcloudEnv) diag.Diagnostics {
 	var allDiags diag.Diagnostics
 
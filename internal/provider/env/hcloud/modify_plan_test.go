@@ -1,11 +1,92 @@
 package env
 
 import (
+	"context"
 	"testing"
 
 	"github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/testutil"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestHCloudModifyPlanSpecRevision(t *testing.T) {
 	testutil.AssertModifyPlanSpecRevision(t, &HCloudEnvResource{})
 }
+
+func TestHCloudModifyPlanPolicy(t *testing.T) {
+	ctx := context.Background()
+	r := NewHCloudEnvResource().(*HCloudEnvResource)
+	r.Policy = sdk.Policy{
+		AllowedNodeTypes:   []string{"cpx31"},
+		MaxCapacityPerZone: 3,
+		MaxTotalNodes:      8,
+	}
+
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+	objType := sresp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for n, at := range objType.AttributeTypes {
+		vals[n] = tftypes.NewValue(at, nil)
+	}
+	locations := func(names ...string) tftypes.Value {
+		var elements []tftypes.Value
+		for _, name := range names {
+			elements = append(elements, tftypes.NewValue(tftypes.String, name))
+		}
+		if elements == nil {
+			return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+	nodeGroupsType := objType.AttributeTypes["node_groups"].(tftypes.Set)
+	nodeGroup := func(nodeType string, capacity int64, groupLocations tftypes.Value) tftypes.Value {
+		groupVals := map[string]tftypes.Value{}
+		for n, at := range nodeGroupsType.ElementType.(tftypes.Object).AttributeTypes {
+			groupVals[n] = tftypes.NewValue(at, nil)
+		}
+		groupVals["node_type"] = tftypes.NewValue(tftypes.String, nodeType)
+		groupVals["capacity_per_location"] = tftypes.NewValue(tftypes.Number, capacity)
+		groupVals["locations"] = groupLocations
+		return tftypes.NewValue(nodeGroupsType.ElementType, groupVals)
+	}
+	vals["name"] = tftypes.NewValue(tftypes.String, "env")
+	vals["locations"] = locations("fsn1", "nbg1")
+	vals["node_groups"] = tftypes.NewValue(nodeGroupsType, []tftypes.Value{
+		nodeGroup("cpx31", 2, locations()),
+		nodeGroup("ccx63", 5, locations("fsn1")),
+	})
+	plan := tfsdk.Plan{Raw: tftypes.NewValue(objType, vals), Schema: sresp.Schema}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(objType, nil), Schema: sresp.Schema},
+		Plan:  plan,
+	}, resp)
+
+	var nodeGroups types.Set
+	if d := plan.GetAttribute(ctx, path.Root("node_groups"), &nodeGroups); d.HasError() {
+		t.Fatal(d.Errors())
+	}
+	large := path.Root("node_groups").AtSetValue(nodeGroups.Elements()[1])
+	expected := []path.Path{large.AtName("node_type"), large.AtName("capacity_per_location"), path.Root("node_groups")}
+	var paths []path.Path
+	for _, d := range resp.Diagnostics.Errors() {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			paths = append(paths, withPath.Path())
+		}
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected policy errors at %v, got %v", expected, resp.Diagnostics.Errors())
+	}
+	for i := range paths {
+		if !paths[i].Equal(expected[i]) {
+			t.Errorf("expected a policy error at %s, got %s", expected[i], paths[i])
+		}
+	}
+}
@@ -2,13 +2,16 @@ package env
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,7 +22,7 @@ var _ resource.ResourceWithImportState = &HCloudEnvResource{}
 var _ resource.ResourceWithValidateConfig = &HCloudEnvResource{}
 
 func NewHCloudEnvResource() resource.Resource {
-	return &HCloudEnvResource{}
+	return &HCloudEnvResource{EnvResourceBase: common.EnvResourceBase{Cloud: envstatus.CloudHCloud}}
 }
 
 type HCloudEnvResource struct {
@@ -47,6 +50,9 @@ func (r *HCloudEnvResource) ValidateConfig(ctx context.Context, req resource.Val
 }
 
 func (r *HCloudEnvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_hcloud", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *HCloudEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -58,7 +64,7 @@ func (r *HCloudEnvResource) Create(ctx context.Context, req resource.CreateReque
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "creating resource", map[string]interface{}{"name": name})
 
-	sdkEnv, _, diags := data.toSDK(ctx)
+	sdkEnv, _, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -67,7 +73,28 @@ func (r *HCloudEnvResource) Create(ctx context.Context, req resource.CreateReque
 	apiResp, err := r.Client.CreateHCloudEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to create env %s, got error: %s", name, client.FormatError(err, name)))
+		// The create may have been committed even though the response was lost.
+		adopted, diags := common.ReconcileCreate(ctx, req.Plan, name, err,
+			func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+				current, err := r.Client.GetHCloudEnv(ctx, name)
+				if err != nil || current.HcloudEnv == nil {
+					return nil, nil, err
+				}
+				model := *data
+				diags := model.applyEnvWithDefaults(ctx, current.HcloudEnv, r.Defaults)
+				return &common.SpecRevisionResult{SpecRevision: current.HcloudEnv.SpecRevision, Model: &model}, diags, nil
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if adopted == nil {
+			common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to create env %s", name), err, name)
+			return
+		}
+
+		data = adopted.(*HCloudEnvResourceModel)
+		data.Id = data.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 		return
 	}
 
@@ -93,9 +120,13 @@ func (r *HCloudEnvResource) Create(ctx context.Context, req resource.CreateReque
 	tflog.Trace(ctx, "created resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *HCloudEnvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_hcloud", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *HCloudEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -109,8 +140,7 @@ func (r *HCloudEnvResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResp, err := r.Client.GetHCloudEnv(ctx, envName)
 
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "removing resource from state", map[string]interface{}{"name": envName})
 			resp.State.RemoveResource(ctx)
 		} else {
@@ -119,15 +149,7 @@ func (r *HCloudEnvResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Reorder node groups  and locations to respect order in the user's configuration
-	apiResp.HcloudEnv.Spec.NodeGroups = common.ReorderByKey(data.NodeGroups, apiResp.HcloudEnv.Spec.NodeGroups,
-		func(m NodeGroupsModel) string { return m.NodeType.ValueString() },
-		func(s *client.HCloudEnvSpecFragment_NodeGroups) string { return s.NodeType },
-	)
-	resp.Diagnostics.Append(reorderNodeGroupLocations(ctx, data.NodeGroups, apiResp.HcloudEnv.Spec.NodeGroups)...)
-	apiResp.HcloudEnv.Spec.Locations, diags = common.ReorderList(ctx, data.Locations, apiResp.HcloudEnv.Spec.Locations)
-	resp.Diagnostics.Append(diags...)
-	diags = data.toModel(*apiResp.HcloudEnv)
+	diags = data.applyEnvWithDefaults(ctx, apiResp.HcloudEnv, r.Defaults)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -136,9 +158,13 @@ func (r *HCloudEnvResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.SeedAppliedSpecRevision(ctx, resp.Private, envName)...)
 }
 
 func (r *HCloudEnvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_hcloud", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *HCloudEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -150,15 +176,35 @@ func (r *HCloudEnvResource) Update(ctx context.Context, req resource.UpdateReque
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "updating resource", map[string]interface{}{"name": name})
 
-	_, sdkEnv, diags := data.toSDK(ctx)
+	_, sdkEnv, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(common.CheckSpecRevision(ctx, req.State, name, data.AllowSpecRevisionMismatch.ValueBool(),
+		func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+			var prior *HCloudEnvResourceModel
+			diags := req.State.Get(ctx, &prior)
+			if diags.HasError() {
+				return nil, diags, nil
+			}
+			current, err := r.Client.GetHCloudEnv(ctx, name)
+			if err != nil || current.HcloudEnv == nil {
+				return nil, diags, err
+			}
+			diags.Append(prior.applyEnvWithDefaults(ctx, current.HcloudEnv, r.Defaults)...)
+			return &common.SpecRevisionResult{SpecRevision: current.HcloudEnv.SpecRevision, Model: prior}, diags, nil
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, err := r.Client.UpdateHCloudEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update env %s, got error: %s", name, client.FormatError(err, name)))
+		common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to update env %s", name), err, name)
 		return
 	}
 
@@ -183,9 +229,13 @@ func (r *HCloudEnvResource) Update(ctx context.Context, req resource.UpdateReque
 	tflog.Trace(ctx, "updated resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *HCloudEnvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_hcloud", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *HCloudEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -202,8 +252,7 @@ func (r *HCloudEnvResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	envStatus, err := r.Client.GetHCloudEnvStatus(ctx, envName)
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "deleted resource", map[string]interface{}{"name": envName})
 		} else {
 			clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read env status %s, got error: %s", envName, err))
@@ -214,6 +263,7 @@ func (r *HCloudEnvResource) Delete(ctx context.Context, req resource.DeleteReque
 	if len(envStatus.HcloudEnv.Status.Errors) > 0 {
 		for _, err := range envStatus.HcloudEnv.Status.Errors {
 			resp.Diagnostics.Append(common.ValidateDisconnected(
+				envstatus.CloudHCloud,
 				envName,
 				string(err.Code),
 				envStatus.HcloudEnv.Status.AppliedSpecRevision,
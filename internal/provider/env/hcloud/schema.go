@@ -34,6 +34,7 @@ func (r *HCloudEnvResource) Schema(ctx context.Context, req resource.SchemaReque
 			"load_balancers":                  getLoadBalancersAttribute(false, true, true),
 			"load_balancing_strategy":         common.GetLoadBalancingStrategyAttribute(false, true, true),
 			"maintenance_windows":             common.GetMaintenanceWindowAttribute(false, true, false),
+			"maintenance_windows_all":         common.GetMaintenanceWindowsAllAttribute(),
 			"cidr":                            common.GetCIDRAttribute(true, false, false),
 			"locations":                       common.GetZonesAttribute(false, true, true, common.HCLOUD_LOCATIONS_DESCRIPTION),
 			"node_groups":                     getNodeGroupsAttribute(true, false, false),
@@ -46,6 +47,7 @@ func (r *HCloudEnvResource) Schema(ctx context.Context, req resource.SchemaReque
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, true, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, true, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, true, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, true, true),
 		},
 		Blocks: map[string]rschema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -82,6 +84,7 @@ func (d *HCloudEnvDataSource) Schema(ctx context.Context, req datasource.SchemaR
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, false, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, false, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, false, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, false, true),
 		},
 	}
 }
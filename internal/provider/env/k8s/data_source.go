@@ -49,6 +49,9 @@ func (d *K8SEnvDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *K8SEnvDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_k8s", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "Reading aws env state source")
 
 	var data K8SEnvDataSourceModel
@@ -29,12 +29,36 @@ type K8SEnvModel struct {
 	ForceDestroyClusters         types.Bool  `tfsdk:"force_destroy_clusters"`
 	SkipDeprovisionOnDestroy     types.Bool  `tfsdk:"skip_deprovision_on_destroy"`
 	AllowDeleteWhileDisconnected types.Bool  `tfsdk:"allow_delete_while_disconnected"`
+	AllowSpecRevisionMismatch    types.Bool  `tfsdk:"allow_spec_revision_mismatch"`
 }
 
 // Split models: `timeouts` only exists on the resource schema and the framework requires an exact struct/schema match.
 type K8SEnvResourceModel struct {
 	K8SEnvModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	MaintenanceWindowsAll []common.MaintenanceWindowModel `tfsdk:"maintenance_windows_all"`
+	Timeouts              timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+// withDefaults returns the env to send to the API: its maintenance windows
+// merged with the provider defaults.
+func (model *K8SEnvResourceModel) withDefaults(defaults common.Defaults) *K8SEnvModel {
+	env := model.K8SEnvModel
+	env.MaintenanceWindows = common.MergeMaintenanceWindows(defaults.MaintenanceWindows, model.MaintenanceWindows)
+	return &env
+}
+
+// applyDefaults splits the maintenance windows of the env as read into the
+// resource's own and all of them, given prior, the env before the read.
+func (model *K8SEnvResourceModel) applyDefaults(prior K8SEnvModel, defaults common.Defaults) {
+	model.MaintenanceWindows, model.MaintenanceWindowsAll = common.SplitMaintenanceWindows(prior.MaintenanceWindows, model.MaintenanceWindows, defaults.MaintenanceWindows)
+}
+
+// applyEnvWithDefaults is applyEnv for the resource.
+func (model *K8SEnvResourceModel) applyEnvWithDefaults(ctx context.Context, env *client.GetK8SEnv_K8sEnv, defaults common.Defaults) diag.Diagnostics {
+	prior := model.K8SEnvModel
+	diags := model.applyEnv(ctx, env)
+	model.applyDefaults(prior, defaults)
+	return diags
 }
 
 type K8SEnvDataSourceModel struct {
@@ -156,6 +180,19 @@ func (e K8SEnvModel) toSDK(ctx context.Context) (client.CreateK8SEnvInput, clien
 	return create, update, allDiags
 }
 
+// applyEnv maps the env returned by the API, after reordering its lists to
+// respect the order in the user's configuration.
+func (model *K8SEnvModel) applyEnv(ctx context.Context, env *client.GetK8SEnv_K8sEnv) diag.Diagnostics {
+	var allDiags diag.Diagnostics
+	var diags diag.Diagnostics
+
+	env.Spec.NodeGroups, diags = reorderNodeGroups(ctx, model.NodeGroups, env.Spec.NodeGroups)
+	allDiags.Append(diags...)
+	allDiags.Append(model.toModel(env.Name, env.SpecRevision, *env.Spec)...)
+
+	return allDiags
+}
+
 func (model *K8SEnvModel) toModel(name string, specRevision int64, spec client.K8SEnvSpecFragment) diag.Diagnostics {
 	var allDiags diag.Diagnostics
 
@@ -2,13 +2,16 @@ package env
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,7 +20,7 @@ var _ resource.Resource = &K8SEnvResource{}
 var _ resource.ResourceWithImportState = &K8SEnvResource{}
 
 func NewK8SEnvResource() resource.Resource {
-	return &K8SEnvResource{}
+	return &K8SEnvResource{EnvResourceBase: common.EnvResourceBase{Cloud: envstatus.CloudK8S}}
 }
 
 type K8SEnvResource struct {
@@ -29,6 +32,9 @@ func (r *K8SEnvResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *K8SEnvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_k8s", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *K8SEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -40,7 +46,7 @@ func (r *K8SEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "creating resource", map[string]interface{}{"name": name})
 
-	sdkEnv, _, diags := data.toSDK(ctx)
+	sdkEnv, _, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -48,7 +54,28 @@ func (r *K8SEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	apiResp, err := r.Client.CreateK8SEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to create env %s, got error: %s", name, client.FormatError(err, name)))
+		// The create may have been committed even though the response was lost.
+		adopted, diags := common.ReconcileCreate(ctx, req.Plan, name, err,
+			func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+				current, err := r.Client.GetK8SEnv(ctx, name)
+				if err != nil || current.K8sEnv == nil {
+					return nil, nil, err
+				}
+				model := *data
+				diags := model.applyEnvWithDefaults(ctx, current.K8sEnv, r.Defaults)
+				return &common.SpecRevisionResult{SpecRevision: current.K8sEnv.SpecRevision, Model: &model}, diags, nil
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if adopted == nil {
+			common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to create env %s", name), err, name)
+			return
+		}
+
+		data = adopted.(*K8SEnvResourceModel)
+		data.Id = data.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 		return
 	}
 
@@ -59,8 +86,10 @@ func (r *K8SEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.NodeGroups, diags = nodeGroupsToModel(apiResp.CreateK8SEnv.Spec.NodeGroups)
 	resp.Diagnostics.Append(diags...)
 	data.SpecRevision = types.Int64Value(apiResp.CreateK8SEnv.SpecRevision)
+	prior := data.K8SEnvModel
 	diags = data.toModel(data.Name.ValueString(), apiResp.CreateK8SEnv.SpecRevision, *apiResp.CreateK8SEnv.Spec)
 	resp.Diagnostics.Append(diags...)
+	data.applyDefaults(prior, r.Defaults)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -68,9 +97,13 @@ func (r *K8SEnvResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Trace(ctx, "created resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *K8SEnvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_k8s", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *K8SEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -84,8 +117,7 @@ func (r *K8SEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 	apiResp, err := r.Client.GetK8SEnv(ctx, envName)
 
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "removing resource from state", map[string]interface{}{"name": envName})
 			resp.State.RemoveResource(ctx)
 		} else {
@@ -94,10 +126,7 @@ func (r *K8SEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Reorder node groups  and zones to respect order in the user's configuration
-	apiResp.K8sEnv.Spec.NodeGroups, diags = reorderNodeGroups(ctx, data.NodeGroups, apiResp.K8sEnv.Spec.NodeGroups)
-	resp.Diagnostics.Append(diags...)
-	diags = data.toModel(apiResp.K8sEnv.Name, apiResp.K8sEnv.SpecRevision, *apiResp.K8sEnv.Spec)
+	diags = data.applyEnvWithDefaults(ctx, apiResp.K8sEnv, r.Defaults)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -106,9 +135,13 @@ func (r *K8SEnvResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.SeedAppliedSpecRevision(ctx, resp.Private, envName)...)
 }
 
 func (r *K8SEnvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_k8s", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *K8SEnvResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -120,22 +153,44 @@ func (r *K8SEnvResource) Update(ctx context.Context, req resource.UpdateRequest,
 	name := data.Name.ValueString()
 	tflog.Trace(ctx, "updating resource", map[string]interface{}{"name": name})
 
-	_, sdkEnv, diags := data.toSDK(ctx)
+	_, sdkEnv, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(common.CheckSpecRevision(ctx, req.State, name, data.AllowSpecRevisionMismatch.ValueBool(),
+		func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+			var prior *K8SEnvResourceModel
+			diags := req.State.Get(ctx, &prior)
+			if diags.HasError() {
+				return nil, diags, nil
+			}
+			current, err := r.Client.GetK8SEnv(ctx, name)
+			if err != nil || current.K8sEnv == nil {
+				return nil, diags, err
+			}
+			diags.Append(prior.applyEnvWithDefaults(ctx, current.K8sEnv, r.Defaults)...)
+			return &common.SpecRevisionResult{SpecRevision: current.K8sEnv.SpecRevision, Model: prior}, diags, nil
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, err := r.Client.UpdateK8SEnv(ctx, sdkEnv)
 
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update env %s, got error: %s", name, client.FormatError(err, name)))
+		common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to update env %s", name), err, name)
 		return
 	}
 
 	apiResp.UpdateK8SEnv.Spec.NodeGroups, diags = reorderNodeGroups(ctx, data.NodeGroups, apiResp.UpdateK8SEnv.Spec.NodeGroups)
 	resp.Diagnostics.Append(diags...)
+	prior := data.K8SEnvModel
 	diags = data.toModel(name, apiResp.UpdateK8SEnv.SpecRevision, *apiResp.UpdateK8SEnv.Spec)
 	resp.Diagnostics.Append(diags...)
+	data.applyDefaults(prior, r.Defaults)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -143,9 +198,13 @@ func (r *K8SEnvResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Trace(ctx, "updated resource", map[string]interface{}{"name": name})
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *K8SEnvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_k8s", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *K8SEnvResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -162,8 +221,7 @@ func (r *K8SEnvResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	envStatus, err := r.Client.GetK8SEnvStatus(ctx, envName)
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "deleted resource", map[string]interface{}{"name": envName})
 		} else {
 			clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read env status %s, got error: %s", envName, err))
@@ -174,6 +232,7 @@ func (r *K8SEnvResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if len(envStatus.K8sEnv.Status.Errors) > 0 {
 		for _, err := range envStatus.K8sEnv.Status.Errors {
 			resp.Diagnostics.Append(common.ValidateDisconnected(
+				envstatus.CloudK8S,
 				envName,
 				string(err.Code),
 				envStatus.K8sEnv.Status.AppliedSpecRevision,
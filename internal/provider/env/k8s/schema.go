@@ -34,6 +34,7 @@ func (r *K8SEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"load_balancers":                  getLoadBalancersAttribute(false, true, true),
 			"load_balancing_strategy":         common.GetLoadBalancingStrategyAttribute(false, true, true),
 			"maintenance_windows":             common.GetMaintenanceWindowAttribute(false, true, false),
+			"maintenance_windows_all":         common.GetMaintenanceWindowsAllAttribute(),
 			"logs":                            getLogsAttributes(false, true, true),
 			"metrics":                         getMetricsAttribute(false, true, true),
 			"distribution":                    getDistributionAttribute(true, false, false),
@@ -44,6 +45,7 @@ func (r *K8SEnvResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, true, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, true, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, true, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, true, true),
 		},
 		Blocks: map[string]rschema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -77,6 +79,7 @@ func (d *K8SEnvDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, false, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, false, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, false, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, false, true),
 		},
 	}
 }
@@ -0,0 +1,162 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/auth"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &CertificateEphemeralResource{}
+
+func NewCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &CertificateEphemeralResource{}
+}
+
+// CertificateEphemeralResource issues a certificate that is never persisted:
+// Terraform opens it, i.e. a key is generated and signed, on every plan and
+// apply that needs it.
+type CertificateEphemeralResource struct {
+	auth *auth.Auth
+}
+
+type CertificateEphemeralResourceModel struct {
+	EnvironmentName   types.String `tfsdk:"env_name"`
+	PEM               types.String `tfsdk:"pem"`
+	CertificatePEM    types.String `tfsdk:"certificate_pem"`
+	PrivateKeyPEM     types.String `tfsdk:"private_key_pem"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	SubjectCN         types.String `tfsdk:"subject_cn"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+}
+
+func (r *CertificateEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_certificate"
+}
+
+func (r *CertificateEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Altinity.Cloud environment authentication certificate that is never stored in the Terraform plan or state (Terraform 1.10+).
+			A new private key is generated and signed every time Terraform needs the certificate, e.g. to configure a provider or set a write-only attribute.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			"env_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the environment.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"pem": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The Altinity.Cloud PEM certificate followed by its private key, as the `pem` of the `altinitycloud_env_certificate` resource.",
+			},
+			"certificate_pem": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The signed PEM certificate.",
+			},
+			"private_key_pem": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The PEM private key of the certificate.",
+			},
+			"not_before": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Start of the validity period of the certificate (RFC 3339).",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiry of the certificate (RFC 3339).",
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Serial number of the certificate, in decimal.",
+			},
+			"subject_cn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Common name of the subject of the certificate.",
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 fingerprint of the DER certificate, in lowercase hex.",
+			},
+		},
+	}
+}
+
+func (r *CertificateEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk, ok := req.ProviderData.(*sdk.AltinityCloudSDK)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.AltinityCloudSDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.auth = sdk.Auth
+}
+
+func (r *CertificateEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_certificate", "Open", req.Config)
+	defer func() { endSpan(resp.Result, resp.Diagnostics) }()
+
+	var data CertificateEphemeralResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "opening ephemeral resource")
+	crt, key, err := r.auth.GenerateCertificate(ctx, data.EnvironmentName.ValueString())
+	if err != nil {
+		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable generate certificate, got error: %s", err))
+		return
+	}
+	if err := data.set(crt, key); err != nil {
+		resp.Diagnostics.AddError("Invalid Certificate", fmt.Sprintf("Unable to parse the generated certificate, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "opened ephemeral resource")
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (m *CertificateEphemeralResourceModel) set(crt, key string) error {
+	metadata, err := parseMetadata(crt)
+	if err != nil {
+		return err
+	}
+	m.PEM = types.StringValue(crt + "\n" + key)
+	m.CertificatePEM = types.StringValue(crt)
+	m.PrivateKeyPEM = types.StringValue(key)
+	m.NotBefore = metadata.NotBefore
+	m.NotAfter = metadata.NotAfter
+	m.SerialNumber = metadata.SerialNumber
+	m.SubjectCN = metadata.SubjectCN
+	m.FingerprintSHA256 = metadata.FingerprintSHA256
+	return nil
+}
@@ -0,0 +1,83 @@
+package certificate
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseCertificate returns the certificate of data, the PEM certificate
+// followed by its private key.
+func parseCertificate(data string) (*x509.Certificate, error) {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no CERTIFICATE PEM block found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// certificateMetadata are the attributes parsed from a PEM certificate.
+type certificateMetadata struct {
+	NotBefore         types.String
+	NotAfter          types.String
+	SerialNumber      types.String
+	SubjectCN         types.String
+	FingerprintSHA256 types.String
+}
+
+func parseMetadata(data string) (certificateMetadata, error) {
+	cert, err := parseCertificate(data)
+	if err != nil {
+		return certificateMetadata{}, err
+	}
+	fingerprint := sha256.Sum256(cert.Raw)
+	return certificateMetadata{
+		NotBefore:         types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339)),
+		NotAfter:          types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339)),
+		SerialNumber:      types.StringValue(cert.SerialNumber.String()),
+		SubjectCN:         types.StringValue(cert.Subject.CommonName),
+		FingerprintSHA256: types.StringValue(hex.EncodeToString(fingerprint[:])),
+	}, nil
+}
+
+// setMetadata sets the certificate metadata attributes from the PEM.
+func (m *CertificateResourceModel) setMetadata() error {
+	metadata, err := parseMetadata(m.PEM.ValueString())
+	if err != nil {
+		return err
+	}
+	m.NotBefore = metadata.NotBefore
+	m.NotAfter = metadata.NotAfter
+	m.SerialNumber = metadata.SerialNumber
+	m.SubjectCN = metadata.SubjectCN
+	m.FingerprintSHA256 = metadata.FingerprintSHA256
+	return nil
+}
+
+// dueForRenewal reports whether the certificate expires within renew_before of
+// now. Unset, unknown or unparsable values never are.
+func (m *CertificateResourceModel) dueForRenewal(now time.Time) bool {
+	if m.RenewBefore.IsNull() || m.RenewBefore.IsUnknown() || m.NotAfter.IsNull() || m.NotAfter.IsUnknown() {
+		return false
+	}
+	renewBefore, err := time.ParseDuration(m.RenewBefore.ValueString())
+	if err != nil {
+		return false
+	}
+	notAfter, err := time.Parse(time.RFC3339, m.NotAfter.ValueString())
+	if err != nil {
+		return false
+	}
+	return !now.Add(renewBefore).Before(notAfter)
+}
@@ -5,6 +5,12 @@ import (
 )
 
 type CertificateResourceModel struct {
-	EnvironmentName types.String `tfsdk:"env_name"`
-	PEM             types.String `tfsdk:"pem"`
+	EnvironmentName   types.String `tfsdk:"env_name"`
+	RenewBefore       types.String `tfsdk:"renew_before"`
+	PEM               types.String `tfsdk:"pem"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	SubjectCN         types.String `tfsdk:"subject_cn"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
 }
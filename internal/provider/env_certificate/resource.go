@@ -3,25 +3,29 @@ package certificate
 import (
 	"context"
 	"fmt"
+	"time"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/auth"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &CertificateResource{}
+var _ resource.ResourceWithModifyPlan = &CertificateResource{}
 
 func NewCertificateResource() resource.Resource {
-	return &CertificateResource{}
+	return &CertificateResource{now: time.Now}
 }
 
 type CertificateResource struct {
 	client *client.Client
 	auth   *auth.Auth
+	now    func() time.Time
 }
 
 func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,6 +53,9 @@ func (r *CertificateResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *CertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_certificate", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *CertificateResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -64,6 +71,10 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 	data.PEM = types.StringValue(crt + "\n" + key)
+	if err := data.setMetadata(); err != nil {
+		resp.Diagnostics.AddError("Invalid Certificate", fmt.Sprintf("Unable to parse the generated certificate, got error: %s", err))
+		return
+	}
 	tflog.Trace(ctx, "created resource")
 
 	diags = resp.State.Set(ctx, &data)
@@ -71,13 +82,62 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_certificate", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *CertificateResourceModel
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to read from the API: the metadata comes from the PEM, e.g. for
+	// certificates issued before it was exported.
+	if err := data.setMetadata(); err != nil {
+		resp.Diagnostics.AddError("Invalid Certificate", fmt.Sprintf("Unable to parse the certificate of environment %q, got error: %s", data.EnvironmentName.ValueString(), err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan replaces the certificate, so that it is re-issued, once it
+// expires within renew_before.
+func (r *CertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan CertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.NotAfter = state.NotAfter
+	if !plan.dueForRenewal(r.now()) {
+		return
+	}
+
+	tflog.Debug(ctx, "certificate due for renewal", map[string]interface{}{"not_after": state.NotAfter.ValueString()})
+	plan.PEM = types.StringUnknown()
+	plan.NotBefore = types.StringUnknown()
+	plan.NotAfter = types.StringUnknown()
+	plan.SerialNumber = types.StringUnknown()
+	plan.SubjectCN = types.StringUnknown()
+	plan.FingerprintSHA256 = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("not_after"))
 }
 
 func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_certificate", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *CertificateResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -86,21 +146,18 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Only renew_before can change in place, which doesn't re-issue the
+	// certificate: the plan keeps it.
 	tflog.Trace(ctx, "updating resource")
 
-	crt, key, err := r.auth.GenerateCertificate(ctx, data.EnvironmentName.ValueString())
-	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable generate certificate, got error: %s", err))
-		return
-	}
-	data.PEM = types.StringValue(crt + "\n" + key)
-	tflog.Trace(ctx, "updated resource")
-
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_certificate", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *CertificateResourceModel
 
 	diags := req.State.Get(ctx, &data)
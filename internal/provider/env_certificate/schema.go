@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/provider/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,10 +28,44 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"renew_before": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Re-issue the certificate when it expires within this duration, e.g. `720h`: the plan replaces it once `not_after` is that close. " +
+					"Changing it doesn't re-issue the certificate by itself.",
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
 			"pem": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
 				MarkdownDescription: "The Altinity.Cloud PEM certificate.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"not_before": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Start of the validity period of the certificate (RFC 3339).",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiry of the certificate (RFC 3339).",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Serial number of the certificate, in decimal.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"subject_cn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Common name of the subject of the certificate.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 fingerprint of the DER certificate, in lowercase hex.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
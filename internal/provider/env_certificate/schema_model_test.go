@@ -9,3 +9,7 @@ import (
 func TestCertificateResourceModelMatchesSchema(t *testing.T) {
 	schematest.AssertResourceModelMatchesSchema(t, &CertificateResource{}, &CertificateResourceModel{})
 }
+
+func TestCertificateEphemeralResourceModelMatchesSchema(t *testing.T) {
+	schematest.AssertEphemeralResourceModelMatchesSchema(t, &CertificateEphemeralResource{}, &CertificateEphemeralResourceModel{})
+}
@@ -47,6 +47,9 @@ func (d *AWSEnvHostedDataSource) Configure(ctx context.Context, req datasource.C
 }
 
 func (d *AWSEnvHostedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_aws_hosted", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading hosted aws env data source")
 
 	var data AWSEnvHostedResourceModel
@@ -76,6 +79,8 @@ func (d *AWSEnvHostedDataSource) Read(ctx context.Context, req datasource.ReadRe
 	customDomains, diags := common.ListToModel(apiResp.AWSEnvHosted.Spec.CustomDomains)
 	resp.Diagnostics.Append(diags...)
 	data.CustomDomains = customDomains
+	// Data sources have no provider defaults to tell apart.
+	data.MaintenanceWindowsAll = data.MaintenanceWindows
 	data.Id = data.Name
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -12,29 +12,31 @@ import (
 )
 
 type AWSEnvHostedResourceModel struct {
-	Id                 types.String                    `tfsdk:"id"`
-	Name               types.String                    `tfsdk:"name"`
-	Region             types.String                    `tfsdk:"region"`
-	CIDR               types.String                    `tfsdk:"cidr"`
-	ZoneIDs            types.List                      `tfsdk:"zone_ids"`
-	ResourcePrefix     types.String                    `tfsdk:"resource_prefix"`
-	KmsKeyArn          types.String                    `tfsdk:"kms_key_arn"`
-	CustomDomains      types.List                      `tfsdk:"custom_domains"`
-	LoadBalancers      *LoadBalancersModel             `tfsdk:"load_balancers"`
-	NodeGroups         []hosted.NodeGroupsModel        `tfsdk:"node_groups"`
-	MaintenanceWindows []common.MaintenanceWindowModel `tfsdk:"maintenance_windows"`
-	Endpoints          []EndpointModel                 `tfsdk:"endpoints"`
-	ExternalBuckets    []ExternalBucketModel           `tfsdk:"external_buckets"`
-	Backups            *BackupsModel                   `tfsdk:"backups"`
-	Iceberg            *IcebergModel                   `tfsdk:"iceberg"`
-	MetricsEndpoint    *hosted.MetricsEndpointModel    `tfsdk:"metrics_endpoint"`
-	Datadog            *common.DatadogModel            `tfsdk:"datadog"`
+	Id                    types.String                    `tfsdk:"id"`
+	Name                  types.String                    `tfsdk:"name"`
+	Region                types.String                    `tfsdk:"region"`
+	CIDR                  types.String                    `tfsdk:"cidr"`
+	ZoneIDs               types.List                      `tfsdk:"zone_ids"`
+	ResourcePrefix        types.String                    `tfsdk:"resource_prefix"`
+	KmsKeyArn             types.String                    `tfsdk:"kms_key_arn"`
+	CustomDomains         types.List                      `tfsdk:"custom_domains"`
+	LoadBalancers         *LoadBalancersModel             `tfsdk:"load_balancers"`
+	NodeGroups            []hosted.NodeGroupsModel        `tfsdk:"node_groups"`
+	MaintenanceWindows    []common.MaintenanceWindowModel `tfsdk:"maintenance_windows"`
+	MaintenanceWindowsAll []common.MaintenanceWindowModel `tfsdk:"maintenance_windows_all"`
+	Endpoints             []EndpointModel                 `tfsdk:"endpoints"`
+	ExternalBuckets       []ExternalBucketModel           `tfsdk:"external_buckets"`
+	Backups               *BackupsModel                   `tfsdk:"backups"`
+	Iceberg               *IcebergModel                   `tfsdk:"iceberg"`
+	MetricsEndpoint       *hosted.MetricsEndpointModel    `tfsdk:"metrics_endpoint"`
+	Datadog               *common.DatadogModel            `tfsdk:"datadog"`
 
 	SpecRevision                 types.Int64    `tfsdk:"spec_revision"`
 	ForceDestroy                 types.Bool     `tfsdk:"force_destroy"`
 	ForceDestroyClusters         types.Bool     `tfsdk:"force_destroy_clusters"`
 	SkipDeprovisionOnDestroy     types.Bool     `tfsdk:"skip_deprovision_on_destroy"`
 	AllowDeleteWhileDisconnected types.Bool     `tfsdk:"allow_delete_while_disconnected"`
+	AllowSpecRevisionMismatch    types.Bool     `tfsdk:"allow_spec_revision_mismatch"`
 	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -188,6 +190,23 @@ func (e AWSEnvHostedResourceModel) toSDK(ctx context.Context) (sdk.CreateAWSEnvH
 	return create, update, allDiags
 }
 
+// withDefaults returns the env to send to the API: its maintenance windows
+// merged with the provider defaults.
+func (model *AWSEnvHostedResourceModel) withDefaults(defaults common.Defaults) *AWSEnvHostedResourceModel {
+	env := *model
+	env.MaintenanceWindows = common.MergeMaintenanceWindows(defaults.MaintenanceWindows, model.MaintenanceWindows)
+	return &env
+}
+
+// applySpecWithDefaults is applySpec for the resource: it splits the
+// maintenance windows read into the resource's own and all of them.
+func (model *AWSEnvHostedResourceModel) applySpecWithDefaults(ctx context.Context, name string, spec *sdk.AWSEnvHostedSpecFragment, specRevision int64, defaults common.Defaults) diag.Diagnostics {
+	prior := model.MaintenanceWindows
+	diags := model.applySpec(ctx, name, spec, specRevision)
+	model.MaintenanceWindows, model.MaintenanceWindowsAll = common.SplitMaintenanceWindows(prior, model.MaintenanceWindows, defaults.MaintenanceWindows)
+	return diags
+}
+
 // applySpec maps the spec every create/read/update returns, after reordering the
 // lists the API may hand back in a different order than the user configured them.
 func (model *AWSEnvHostedResourceModel) applySpec(ctx context.Context, name string, spec *sdk.AWSEnvHostedSpecFragment, specRevision int64) diag.Diagnostics {
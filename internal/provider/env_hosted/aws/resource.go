@@ -2,11 +2,14 @@ package hosted_env
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	common "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/common"
+	envstatus "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,7 +21,7 @@ var _ resource.ResourceWithImportState = &AWSEnvHostedResource{}
 var _ resource.ResourceWithValidateConfig = &AWSEnvHostedResource{}
 
 func NewAWSEnvHostedResource() resource.Resource {
-	return &AWSEnvHostedResource{}
+	return &AWSEnvHostedResource{EnvResourceBase: common.EnvResourceBase{Cloud: envstatus.CloudAWSHosted}}
 }
 
 type AWSEnvHostedResource struct {
@@ -46,6 +49,9 @@ func (r *AWSEnvHostedResource) ValidateConfig(ctx context.Context, req resource.
 }
 
 func (r *AWSEnvHostedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws_hosted", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvHostedResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -56,7 +62,7 @@ func (r *AWSEnvHostedResource) Create(ctx context.Context, req resource.CreateRe
 	envName := data.Name.ValueString()
 	tflog.Trace(ctx, "creating resource", map[string]interface{}{"name": envName})
 
-	sdkEnv, _, diags := data.toSDK(ctx)
+	sdkEnv, _, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -64,11 +70,32 @@ func (r *AWSEnvHostedResource) Create(ctx context.Context, req resource.CreateRe
 
 	apiResp, err := r.Client.CreateAWSEnvHosted(ctx, sdkEnv)
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to create env %s, got error: %s", envName, client.FormatError(err, envName)))
+		// The create may have been committed even though the response was lost.
+		adopted, diags := common.ReconcileCreate(ctx, req.Plan, envName, err,
+			func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+				current, err := r.Client.GetAWSEnvHosted(ctx, envName)
+				if err != nil || current.AWSEnvHosted == nil {
+					return nil, nil, err
+				}
+				model := *data
+				diags := model.applySpecWithDefaults(ctx, current.AWSEnvHosted.Name, current.AWSEnvHosted.Spec, current.AWSEnvHosted.SpecRevision, r.Defaults)
+				return &common.SpecRevisionResult{SpecRevision: current.AWSEnvHosted.SpecRevision, Model: &model}, diags, nil
+			},
+		)
+		resp.Diagnostics.Append(diags...)
+		if adopted == nil {
+			common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to create env %s", envName), err, envName)
+			return
+		}
+
+		data = adopted.(*AWSEnvHostedResourceModel)
+		data.Id = data.Name
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 		return
 	}
 
-	resp.Diagnostics.Append(data.applySpec(ctx, envName, apiResp.CreateAWSEnvHosted.Spec, apiResp.CreateAWSEnvHosted.SpecRevision)...)
+	resp.Diagnostics.Append(data.applySpecWithDefaults(ctx, envName, apiResp.CreateAWSEnvHosted.Spec, apiResp.CreateAWSEnvHosted.SpecRevision, r.Defaults)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -76,9 +103,13 @@ func (r *AWSEnvHostedResource) Create(ctx context.Context, req resource.CreateRe
 
 	tflog.Trace(ctx, "created resource", map[string]interface{}{"name": envName})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *AWSEnvHostedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws_hosted", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvHostedResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -91,8 +122,7 @@ func (r *AWSEnvHostedResource) Read(ctx context.Context, req resource.ReadReques
 
 	apiResp, err := r.Client.GetAWSEnvHosted(ctx, envName)
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "removing resource from state", map[string]interface{}{"name": envName})
 			resp.State.RemoveResource(ctx)
 		} else {
@@ -107,16 +137,20 @@ func (r *AWSEnvHostedResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	resp.Diagnostics.Append(data.applySpec(ctx, apiResp.AWSEnvHosted.Name, apiResp.AWSEnvHosted.Spec, apiResp.AWSEnvHosted.SpecRevision)...)
+	resp.Diagnostics.Append(data.applySpecWithDefaults(ctx, apiResp.AWSEnvHosted.Name, apiResp.AWSEnvHosted.Spec, apiResp.AWSEnvHosted.SpecRevision, r.Defaults)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	data.Id = data.Name
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.SeedAppliedSpecRevision(ctx, resp.Private, envName)...)
 }
 
 func (r *AWSEnvHostedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws_hosted", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvHostedResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -127,28 +161,51 @@ func (r *AWSEnvHostedResource) Update(ctx context.Context, req resource.UpdateRe
 	envName := data.Name.ValueString()
 	tflog.Trace(ctx, "updating resource", map[string]interface{}{"name": envName})
 
-	_, sdkEnv, diags := data.toSDK(ctx)
+	_, sdkEnv, diags := data.withDefaults(r.Defaults).toSDK(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(common.CheckSpecRevision(ctx, req.State, envName, data.AllowSpecRevisionMismatch.ValueBool(),
+		func(ctx context.Context) (*common.SpecRevisionResult, diag.Diagnostics, error) {
+			var prior *AWSEnvHostedResourceModel
+			diags := req.State.Get(ctx, &prior)
+			if diags.HasError() {
+				return nil, diags, nil
+			}
+			current, err := r.Client.GetAWSEnvHosted(ctx, envName)
+			if err != nil || current.AWSEnvHosted == nil {
+				return nil, diags, err
+			}
+			diags.Append(prior.applySpecWithDefaults(ctx, current.AWSEnvHosted.Name, current.AWSEnvHosted.Spec, current.AWSEnvHosted.SpecRevision, r.Defaults)...)
+			return &common.SpecRevisionResult{SpecRevision: current.AWSEnvHosted.SpecRevision, Model: prior}, diags, nil
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, err := r.Client.UpdateAWSEnvHosted(ctx, sdkEnv)
 	if err != nil {
-		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to update env %s, got error: %s", envName, client.FormatError(err, envName)))
+		common.AddMutationError(ctx, &resp.Diagnostics, req.Plan.Schema, fmt.Sprintf("Unable to update env %s", envName), err, envName)
 		return
 	}
 
-	resp.Diagnostics.Append(data.applySpec(ctx, envName, apiResp.UpdateAWSEnvHosted.Spec, apiResp.UpdateAWSEnvHosted.SpecRevision)...)
+	resp.Diagnostics.Append(data.applySpecWithDefaults(ctx, envName, apiResp.UpdateAWSEnvHosted.Spec, apiResp.UpdateAWSEnvHosted.SpecRevision, r.Defaults)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	tflog.Trace(ctx, "updated resource", map[string]interface{}{"name": envName})
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(common.SetAppliedSpecRevision(ctx, resp.Private, data.SpecRevision.ValueInt64())...)
 }
 
 func (r *AWSEnvHostedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_aws_hosted", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *AWSEnvHostedResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -164,8 +221,7 @@ func (r *AWSEnvHostedResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	envStatus, err := r.Client.GetAWSEnvHostedStatus(ctx, envName)
 	if err != nil {
-		notFound, _ := client.IsNotFoundError(err)
-		if notFound {
+		if errors.Is(err, client.ErrNotFound) {
 			tflog.Trace(ctx, "deleted resource", map[string]interface{}{"name": envName})
 		} else {
 			clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read env status %s, got error: %s", envName, err))
@@ -181,6 +237,7 @@ func (r *AWSEnvHostedResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	for _, statusErr := range envStatus.AWSEnvHosted.Status.Errors {
 		resp.Diagnostics.Append(common.ValidateDisconnected(
+			envstatus.CloudAWSHosted,
 			envName,
 			string(statusErr.Code),
 			envStatus.AWSEnvHosted.Status.AppliedSpecRevision,
@@ -28,29 +28,31 @@ func (r *AWSEnvHostedResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema = rschema.Schema{
 		MarkdownDescription: heredoc.Doc(`Altinity-hosted AWS environment resource. The environment runs in an AWS account owned by Altinity.`),
 		Attributes: map[string]rschema.Attribute{
-			"id":                  common.IDAttribute,
-			"name":                common.NameAttribute,
-			"region":              common.GetRegionAttribute(true, false, false, common.AWS_REGION_DESCRIPTION),
-			"cidr":                getCIDRAttribute(),
-			"zone_ids":            hosted.GetZoneIDsAttribute(true, false, false, common.HOSTED_AWS_ZONE_IDS_DESCRIPTION),
-			"resource_prefix":     getResourcePrefixAttribute(false, true, true),
-			"kms_key_arn":         getKmsKeyArnAttribute(false, true, false),
-			"custom_domains":      getCustomDomainsAttribute(false, true, false),
-			"load_balancers":      getLoadBalancersAttribute(false, true, true),
-			"node_groups":         hosted.GetNodeGroupsAttribute(true, false, false, common.AWS_NODE_GROUP_NODE_TYPE_DESCRIPTION),
-			"maintenance_windows": common.GetMaintenanceWindowAttribute(false, true, false),
-			"endpoints":           getEndpointsAttribute(false, true, false),
-			"external_buckets":    getExternalBucketsAttribute(false, true, false),
-			"backups":             getBackupsAttribute(false, true, false),
-			"iceberg":             getIcebergAttribute(false, true, false),
-			"metrics_endpoint":    common.GetMetricsEndpointAttribute(false, true, true),
-			"datadog":             common.GetDatadogAttribute(false, true, false),
+			"id":                      common.IDAttribute,
+			"name":                    common.NameAttribute,
+			"region":                  common.GetRegionAttribute(true, false, false, common.AWS_REGION_DESCRIPTION),
+			"cidr":                    getCIDRAttribute(),
+			"zone_ids":                hosted.GetZoneIDsAttribute(true, false, false, common.HOSTED_AWS_ZONE_IDS_DESCRIPTION),
+			"resource_prefix":         getResourcePrefixAttribute(false, true, true),
+			"kms_key_arn":             getKmsKeyArnAttribute(false, true, false),
+			"custom_domains":          getCustomDomainsAttribute(false, true, false),
+			"load_balancers":          getLoadBalancersAttribute(false, true, true),
+			"node_groups":             hosted.GetNodeGroupsAttribute(true, false, false, common.AWS_NODE_GROUP_NODE_TYPE_DESCRIPTION),
+			"maintenance_windows":     common.GetMaintenanceWindowAttribute(false, true, false),
+			"maintenance_windows_all": common.GetMaintenanceWindowsAllAttribute(),
+			"endpoints":               getEndpointsAttribute(false, true, false),
+			"external_buckets":        getExternalBucketsAttribute(false, true, false),
+			"backups":                 getBackupsAttribute(false, true, false),
+			"iceberg":                 getIcebergAttribute(false, true, false),
+			"metrics_endpoint":        common.GetMetricsEndpointAttribute(false, true, true),
+			"datadog":                 common.GetDatadogAttribute(false, true, false),
 
 			"spec_revision":                   common.SpecRevisionAttribute,
 			"force_destroy":                   common.GetForceDestroyAttribute(false, true, true),
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, true, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, true, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, true, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, true, true),
 		},
 		Blocks: map[string]rschema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -64,24 +66,25 @@ func (d *AWSEnvHostedDataSource) Schema(ctx context.Context, req datasource.Sche
 	resp.Schema = dschema.Schema{
 		MarkdownDescription: heredoc.Doc(`Altinity-hosted AWS environment data source.`),
 		Attributes: map[string]dschema.Attribute{
-			"id":                  common.IDAttribute,
-			"name":                common.NameAttribute,
-			"region":              common.GetRegionAttribute(false, false, true, common.AWS_REGION_DESCRIPTION),
-			"cidr":                getCIDRAttribute(),
-			"zone_ids":            hosted.GetZoneIDsAttribute(false, false, true, common.HOSTED_AWS_ZONE_IDS_DESCRIPTION),
-			"resource_prefix":     getResourcePrefixAttribute(false, false, true),
-			"kms_key_arn":         getKmsKeyArnAttribute(false, false, true),
-			"custom_domains":      getCustomDomainsAttribute(false, false, true),
-			"load_balancers":      getLoadBalancersAttribute(false, false, true),
-			"node_groups":         hosted.GetNodeGroupsAttribute(false, false, true, common.AWS_NODE_GROUP_NODE_TYPE_DESCRIPTION),
-			"maintenance_windows": common.GetMaintenanceWindowAttribute(false, false, true),
-			"endpoints":           getEndpointsAttribute(false, false, true),
-			"external_buckets":    getExternalBucketsAttribute(false, false, true),
-			"backups":             getBackupsAttribute(false, false, true),
-			"iceberg":             getIcebergAttribute(false, false, true),
-			"metrics_endpoint":    common.GetMetricsEndpointAttribute(false, false, true),
-			"datadog":             common.GetDatadogAttribute(false, false, true),
-			"spec_revision":       common.SpecRevisionAttribute,
+			"id":                      common.IDAttribute,
+			"name":                    common.NameAttribute,
+			"region":                  common.GetRegionAttribute(false, false, true, common.AWS_REGION_DESCRIPTION),
+			"cidr":                    getCIDRAttribute(),
+			"zone_ids":                hosted.GetZoneIDsAttribute(false, false, true, common.HOSTED_AWS_ZONE_IDS_DESCRIPTION),
+			"resource_prefix":         getResourcePrefixAttribute(false, false, true),
+			"kms_key_arn":             getKmsKeyArnAttribute(false, false, true),
+			"custom_domains":          getCustomDomainsAttribute(false, false, true),
+			"load_balancers":          getLoadBalancersAttribute(false, false, true),
+			"node_groups":             hosted.GetNodeGroupsAttribute(false, false, true, common.AWS_NODE_GROUP_NODE_TYPE_DESCRIPTION),
+			"maintenance_windows":     common.GetMaintenanceWindowAttribute(false, false, true),
+			"maintenance_windows_all": common.GetMaintenanceWindowsAllAttribute(),
+			"endpoints":               getEndpointsAttribute(false, false, true),
+			"external_buckets":        getExternalBucketsAttribute(false, false, true),
+			"backups":                 getBackupsAttribute(false, false, true),
+			"iceberg":                 getIcebergAttribute(false, false, true),
+			"metrics_endpoint":        common.GetMetricsEndpointAttribute(false, false, true),
+			"datadog":                 common.GetDatadogAttribute(false, false, true),
+			"spec_revision":           common.SpecRevisionAttribute,
 
 			// these options are not used in data sources,
 			// but we need to include them in the schema to avoid conversion errors.
@@ -89,6 +92,7 @@ func (d *AWSEnvHostedDataSource) Schema(ctx context.Context, req datasource.Sche
 			"force_destroy_clusters":          common.GetForceDestroyClustersAttribute(false, false, true),
 			"skip_deprovision_on_destroy":     common.GetSkipProvisioningOnDestroyAttribute(false, false, true),
 			"allow_delete_while_disconnected": common.GetAllowDeleteWhileDisconnectedAttribute(false, false, true),
+			"allow_spec_revision_mismatch":    common.GetAllowSpecRevisionMismatchAttribute(false, false, true),
 		},
 	}
 }
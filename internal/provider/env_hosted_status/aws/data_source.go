@@ -27,6 +27,9 @@ func (d *AWSEnvHostedStatusDataSource) Metadata(ctx context.Context, req datasou
 }
 
 func (d *AWSEnvHostedStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_aws_hosted_status", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading hosted aws env status data source")
 
 	var data AWSEnvHostedStatusModel
@@ -82,7 +85,7 @@ func (d *AWSEnvHostedStatusDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	if !common.WaitForSpecRevision(ctx, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), poll, &resp.Diagnostics, readTimeout) {
+	if !common.WaitForSpecRevision(ctx, common.CloudAWSHosted, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), d.SharedPoll(common.CloudAWSHosted, poll), &resp.Diagnostics, readTimeout) {
 		return
 	}
 
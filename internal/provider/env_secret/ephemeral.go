@@ -0,0 +1,116 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/crypto"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &SecretEphemeralResource{}
+
+func NewSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &SecretEphemeralResource{}
+}
+
+// SecretEphemeralResource encrypts a value that is never persisted, nor is the
+// encrypted value: Terraform opens it on every plan and apply that needs it.
+type SecretEphemeralResource struct {
+	crypto *crypto.Crypto
+}
+
+type SecretEphemeralResourceModel struct {
+	PEM         types.String `tfsdk:"pem"`
+	Value       types.String `tfsdk:"value"`
+	SecretValue types.String `tfsdk:"secret_value"`
+}
+
+func (r *SecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_env_secret"
+}
+
+func (r *SecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: heredoc.Doc(`
+			Altinity.Cloud secret that is never stored in the Terraform plan or state (Terraform 1.10+).
+			The value is encrypted every time Terraform needs it, so ` + "`pem`" + ` and ` + "`value`" + ` can be ephemeral too, e.g. from the ` + "`altinitycloud_env_certificate`" + ` ephemeral resource.
+		`),
+
+		Attributes: map[string]schema.Attribute{
+			"pem": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The Altinity.Cloud PEM certificate required to encrypt the value.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The value to be encrypted.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"secret_value": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The encrypted value. Being ephemeral, it can only be used where ephemeral values are allowed, e.g. write-only attributes and provider configs.",
+			},
+		},
+	}
+}
+
+func (r *SecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk, ok := req.ProviderData.(*sdk.AltinityCloudSDK)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.AltinityCloudSDK, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.crypto = sdk.Crypto
+}
+
+func (r *SecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_secret", "Open", req.Config)
+	defer func() { endSpan(resp.Result, resp.Diagnostics) }()
+
+	var data SecretEphemeralResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "opening ephemeral resource")
+	secretValue, err := r.crypto.Encrypt(ctx, data.PEM.ValueString(), data.Value.ValueString())
+	if err != nil {
+		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable encrypt, got error: %s", err))
+		return
+	}
+	data.SecretValue = types.StringValue(secretValue)
+	tflog.Trace(ctx, "opened ephemeral resource")
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -5,7 +5,10 @@ import (
 )
 
 type SecretResourceModel struct {
-	PEM         types.String `tfsdk:"pem"`
-	Value       types.String `tfsdk:"value"`
-	SecretValue types.String `tfsdk:"secret_value"`
+	PEM            types.String `tfsdk:"pem"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	SecretValue    types.String `tfsdk:"secret_value"`
+	KeyFingerprint types.String `tfsdk:"key_fingerprint"`
 }
@@ -8,12 +8,16 @@ import (
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/crypto"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &SecretResource{}
+var _ resource.ResourceWithModifyPlan = &SecretResource{}
 
 func NewSecretResource() resource.Resource {
 	return &SecretResource{}
@@ -49,6 +53,9 @@ func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_secret", "Create", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *SecretResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -57,14 +64,21 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	value, diags := r.value(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "creating resource")
-	secretValue, err := r.crypto.Encrypt(ctx, data.PEM.ValueString(), data.Value.ValueString())
+	secretValue, err := r.crypto.Encrypt(ctx, data.PEM.ValueString(), value)
 
 	if err != nil {
 		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable encrypt, got error: %s", err))
 		return
 	}
 	data.SecretValue = types.StringValue(secretValue)
+	data.KeyFingerprint = types.StringValue(crypto.EncryptedKeyFingerprint(secretValue))
 	tflog.Trace(ctx, "created resource")
 
 	diags = resp.State.Set(ctx, &data)
@@ -72,13 +86,68 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_secret", "Read", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *SecretResourceModel
 
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The env public key may have been rotated since the value was encrypted;
+	// ModifyPlan compares the fingerprints. The key is cached for the
+	// Crypto KeyTTL, so a rotation shows at most that long after it happened.
+	keyFingerprint, err := r.crypto.KeyFingerprint(ctx, data.PEM.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Encryption Key Not Checked",
+			fmt.Sprintf("Unable to fetch the environment public key to check whether it was rotated, got error: %s", err),
+		)
+		return
+	}
+	data.KeyFingerprint = types.StringValue(keyFingerprint)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan re-encrypts the value, in place, when the env public key read by
+// Read isn't the one secret_value was encrypted with.
+func (r *SecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan SecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	encryptedWith := crypto.EncryptedKeyFingerprint(state.SecretValue.ValueString())
+	if state.KeyFingerprint.IsNull() || encryptedWith == "" || encryptedWith == state.KeyFingerprint.ValueString() {
+		return
+	}
+
+	tflog.Debug(ctx, "encryption key rotated", map[string]interface{}{"encrypted_with": encryptedWith, "key_fingerprint": state.KeyFingerprint.ValueString()})
+	plan.SecretValue = types.StringUnknown()
+	plan.KeyFingerprint = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("secret_value"),
+		"Encryption Key Rotated",
+		fmt.Sprintf("The environment public key changed from %s to %s: the value will be encrypted again with the new key.", encryptedWith, state.KeyFingerprint.ValueString()),
+	)
 }
 
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_secret", "Update", req.Plan)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *SecretResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
@@ -87,21 +156,45 @@ func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	tflog.Trace(ctx, "updating resource")
+	value, diags := r.value(ctx, req.Config, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	secretValue, err := r.crypto.Encrypt(ctx, data.PEM.ValueString(), data.Value.ValueString())
+	tflog.Trace(ctx, "updating resource")
+	secretValue, err := r.crypto.Encrypt(ctx, data.PEM.ValueString(), value)
 	if err != nil {
 		clientsupport.AddClientError(&resp.Diagnostics, fmt.Sprintf("Unable generate secret, got error: %s", err))
 		return
 	}
 	data.SecretValue = types.StringValue(secretValue)
+	data.KeyFingerprint = types.StringValue(crypto.EncryptedKeyFingerprint(secretValue))
 	tflog.Trace(ctx, "updated resource")
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// value returns the value to encrypt: value, else value_wo, which is only in
+// the config.
+func (r *SecretResource) value(ctx context.Context, config tfsdk.Config, data *SecretResourceModel) (string, diag.Diagnostics) {
+	if !data.Value.IsNull() {
+		return data.Value.ValueString(), nil
+	}
+	var valueWO types.String
+	diags := config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)
+	if !diags.HasError() && (valueWO.IsNull() || valueWO.IsUnknown()) {
+		// Never encrypt an empty value in place of the secret.
+		diags.AddAttributeError(path.Root("value_wo"), "Missing Secret Value", "Neither value nor value_wo is set in the configuration.")
+	}
+	return valueWO.ValueString(), diags
+}
+
 func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "altinitycloud_env_secret", "Delete", req.State)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	var data *SecretResourceModel
 
 	diags := req.State.Get(ctx, &data)
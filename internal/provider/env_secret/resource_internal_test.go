@@ -0,0 +1,359 @@
+package secret
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/crypto"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSecretResourceValue(t *testing.T) {
+	ctx := context.Background()
+	r := &SecretResource{}
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+
+	tests := []struct {
+		name     string
+		value    types.String
+		valueWO  types.String
+		expected string
+	}{
+		{name: "value", value: types.StringValue("plain"), valueWO: types.StringNull(), expected: "plain"},
+		{name: "value_wo", value: types.StringNull(), valueWO: types.StringValue("write-only"), expected: "write-only"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := SecretResourceModel{
+				PEM:            types.StringValue("pem"),
+				Value:          tt.value,
+				ValueWO:        tt.valueWO,
+				ValueWOVersion: types.Int64Null(),
+				SecretValue:    types.StringUnknown(),
+				KeyFingerprint: types.StringUnknown(),
+			}
+			// Built through a state, the config has no Set.
+			state := tfsdk.State{Schema: sresp.Schema, Raw: tftypes.NewValue(sresp.Schema.Type().TerraformType(ctx), nil)}
+			if d := state.Set(ctx, &data); d.HasError() {
+				t.Fatal(d.Errors())
+			}
+			config := tfsdk.Config{Schema: sresp.Schema, Raw: state.Raw}
+
+			// As in the plan, where write-only values are always null.
+			planned := data
+			planned.ValueWO = types.StringNull()
+			value, diags := r.value(ctx, config, &planned)
+			if diags.HasError() {
+				t.Fatal(diags.Errors())
+			}
+			if value != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestSecretModifyPlanKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	r := &SecretResource{}
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+
+	tests := []struct {
+		name           string
+		keyFingerprint types.String
+		reencrypt      bool
+	}{
+		{name: "Same key", keyFingerprint: types.StringValue("aaaa")},
+		{name: "Rotated key", keyFingerprint: types.StringValue("bbbb"), reencrypt: true},
+		{name: "Key not read yet", keyFingerprint: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := SecretResourceModel{
+				PEM:            types.StringValue("pem"),
+				Value:          types.StringValue("plain"),
+				ValueWO:        types.StringNull(),
+				ValueWOVersion: types.Int64Null(),
+				SecretValue:    types.StringValue("aaaa.0123"),
+				KeyFingerprint: tt.keyFingerprint,
+			}
+			state := tfsdk.State{Schema: sresp.Schema, Raw: tftypes.NewValue(sresp.Schema.Type().TerraformType(ctx), nil)}
+			if d := state.Set(ctx, &data); d.HasError() {
+				t.Fatal(d.Errors())
+			}
+			plan := tfsdk.Plan{Schema: sresp.Schema, Raw: state.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics.Errors())
+			}
+
+			var planned SecretResourceModel
+			if d := resp.Plan.Get(ctx, &planned); d.HasError() {
+				t.Fatal(d.Errors())
+			}
+			if planned.SecretValue.IsUnknown() != tt.reencrypt || planned.KeyFingerprint.IsUnknown() != tt.reencrypt {
+				t.Errorf("expected re-encryption %t, got secret_value %s and key_fingerprint %s", tt.reencrypt, planned.SecretValue, planned.KeyFingerprint)
+			}
+			if resp.RequiresReplace != nil {
+				t.Errorf("expected an in-place update, got requires replace %v", resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+// envKeyServer serves the env public key, which rotate replaces.
+type envKeyServer struct {
+	mu  sync.Mutex
+	key *rsa.PrivateKey
+}
+
+func (s *envKeyServer) rotate(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.key = key
+	return key
+}
+
+// newTestCrypto returns a Crypto fetching the env public key from a test
+// server, and the server.
+func newTestCrypto(t *testing.T) (*crypto.Crypto, *envKeyServer) {
+	t.Helper()
+	keys := &envKeyServer{}
+	keys.rotate(t)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys.mu.Lock()
+		defer keys.mu.Unlock()
+		_ = pem.Encode(w, &pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&keys.key.PublicKey)})
+	}))
+	t.Cleanup(srv.Close)
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(srv.Certificate())
+	return crypto.NewCrypto(rootCAs, srv.URL), keys
+}
+
+// clientPEM returns a client certificate followed by its key, as the
+// certificate resource stores them.
+func clientPEM(t *testing.T) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "acme-staging"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := crypto.EncodeRSAPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})) + string(keyPEM)
+}
+
+func decrypt(t *testing.T, key *rsa.PrivateKey, value string) string {
+	t.Helper()
+	keyPEM, err := crypto.EncodeRSAPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := (&crypto.Crypto{}).Decrypt(string(keyPEM), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decrypted
+}
+
+// rawValue returns the raw value of the secret schema for data.
+func rawValue(t *testing.T, schema tfsdk.State, data SecretResourceModel) tftypes.Value {
+	t.Helper()
+	if d := schema.Set(context.Background(), &data); d.HasError() {
+		t.Fatal(d.Errors())
+	}
+	return schema.Raw
+}
+
+func TestSecretResourceUpdate(t *testing.T) {
+	ctx := context.Background()
+	c, keys := newTestCrypto(t)
+	r := &SecretResource{crypto: c}
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+	empty := tfsdk.State{Schema: sresp.Schema, Raw: tftypes.NewValue(sresp.Schema.Type().TerraformType(ctx), nil)}
+	clientCert := clientPEM(t)
+
+	tests := []struct {
+		name     string
+		value    types.String
+		valueWO  types.String
+		expected string
+	}{
+		{name: "value", value: types.StringValue("plain"), valueWO: types.StringNull(), expected: "plain"},
+		{name: "value_wo set only in the config", value: types.StringNull(), valueWO: types.StringValue("write-only"), expected: "write-only"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prior := SecretResourceModel{
+				PEM:            types.StringValue(clientCert),
+				Value:          tt.value,
+				ValueWO:        types.StringNull(),
+				ValueWOVersion: types.Int64Value(1),
+				SecretValue:    types.StringValue("old.0123"),
+				KeyFingerprint: types.StringValue("old"),
+			}
+			planned := prior
+			planned.ValueWOVersion = types.Int64Value(2)
+			planned.SecretValue = types.StringUnknown()
+			planned.KeyFingerprint = types.StringUnknown()
+			config := planned
+			config.ValueWO = tt.valueWO
+			config.SecretValue = types.StringNull()
+			config.KeyFingerprint = types.StringNull()
+
+			resp := &resource.UpdateResponse{State: empty}
+			r.Update(ctx, resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: sresp.Schema, Raw: rawValue(t, empty, config)},
+				Plan:   tfsdk.Plan{Schema: sresp.Schema, Raw: rawValue(t, empty, planned)},
+				State:  tfsdk.State{Schema: sresp.Schema, Raw: rawValue(t, empty, prior)},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics.Errors())
+			}
+
+			var got SecretResourceModel
+			if d := resp.State.Get(ctx, &got); d.HasError() {
+				t.Fatal(d.Errors())
+			}
+			keys.mu.Lock()
+			key := keys.key
+			keys.mu.Unlock()
+			if decrypted := decrypt(t, key, got.SecretValue.ValueString()); decrypted != tt.expected {
+				t.Errorf("expected %q encrypted, got %q", tt.expected, decrypted)
+			}
+			if !got.ValueWO.IsNull() {
+				t.Errorf("expected value_wo not stored, got %s", got.ValueWO)
+			}
+		})
+	}
+}
+
+func TestSecretResourceUpdateWithoutValue(t *testing.T) {
+	ctx := context.Background()
+	r := &SecretResource{}
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+	empty := tfsdk.State{Schema: sresp.Schema, Raw: tftypes.NewValue(sresp.Schema.Type().TerraformType(ctx), nil)}
+
+	data := SecretResourceModel{
+		PEM:            types.StringValue("pem"),
+		Value:          types.StringNull(),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: types.Int64Value(2),
+		SecretValue:    types.StringUnknown(),
+		KeyFingerprint: types.StringUnknown(),
+	}
+	resp := &resource.UpdateResponse{State: empty}
+	r.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: sresp.Schema, Raw: rawValue(t, empty, data)},
+		Plan:   tfsdk.Plan{Schema: sresp.Schema, Raw: rawValue(t, empty, data)},
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error instead of encrypting an empty value")
+	}
+}
+
+func TestSecretResourceKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	c, keys := newTestCrypto(t)
+	// Every fetch sees the current key, as after KeyTTL.
+	c.KeyTTL = 0
+	r := &SecretResource{crypto: c}
+	sresp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sresp)
+	empty := tfsdk.State{Schema: sresp.Schema, Raw: tftypes.NewValue(sresp.Schema.Type().TerraformType(ctx), nil)}
+
+	planned := SecretResourceModel{
+		PEM:            types.StringValue(clientPEM(t)),
+		Value:          types.StringNull(),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: types.Int64Value(1),
+		SecretValue:    types.StringUnknown(),
+		KeyFingerprint: types.StringUnknown(),
+	}
+	config := planned
+	config.ValueWO = types.StringValue("write-only")
+	config.SecretValue = types.StringNull()
+	config.KeyFingerprint = types.StringNull()
+	configValue := tfsdk.Config{Schema: sresp.Schema, Raw: rawValue(t, empty, config)}
+
+	createResp := &resource.CreateResponse{State: empty}
+	r.Create(ctx, resource.CreateRequest{Config: configValue, Plan: tfsdk.Plan{Schema: sresp.Schema, Raw: rawValue(t, empty, planned)}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatal(createResp.Diagnostics.Errors())
+	}
+
+	rotated := keys.rotate(t)
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics.Errors())
+	}
+
+	plan := tfsdk.Plan{Schema: sresp.Schema, Raw: readResp.State.Raw}
+	planResp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: configValue, State: readResp.State, Plan: plan}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatal(planResp.Diagnostics.Errors())
+	}
+	if len(planResp.Diagnostics.Warnings()) != 1 {
+		t.Errorf("expected a key rotation warning, got %v", planResp.Diagnostics)
+	}
+
+	updateResp := &resource.UpdateResponse{State: empty}
+	r.Update(ctx, resource.UpdateRequest{Config: configValue, Plan: planResp.Plan, State: readResp.State}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatal(updateResp.Diagnostics.Errors())
+	}
+
+	var got SecretResourceModel
+	if d := updateResp.State.Get(ctx, &got); d.HasError() {
+		t.Fatal(d.Errors())
+	}
+	if decrypted := decrypt(t, rotated, got.SecretValue.ValueString()); decrypted != "write-only" {
+		t.Errorf("expected the value encrypted with the rotated key, got %q", decrypted)
+	}
+	if got.KeyFingerprint.ValueString() != crypto.EncryptedKeyFingerprint(got.SecretValue.ValueString()) {
+		t.Errorf("expected key_fingerprint %s, got %s", crypto.EncryptedKeyFingerprint(got.SecretValue.ValueString()), got.KeyFingerprint)
+	}
+}
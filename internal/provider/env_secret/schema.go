@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -24,18 +26,41 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"value": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The value to be encrypted.",
+				MarkdownDescription: "The value to be encrypted. Exactly one of `value` and `value_wo` must be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "The value to be encrypted, never stored in the plan or state (Terraform 1.11+). " +
+					"It is only encrypted on create and when `value_wo_version` changes.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"value_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change it to encrypt `value_wo` again, e.g. after changing it.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
+			},
 			"secret_value": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
 				MarkdownDescription: "The encrypted value.",
 			},
+			"key_fingerprint": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Fingerprint of the environment public key, checked on every refresh. The provider caches the key for up to a minute. " +
+					"When the key is rotated, the plan updates the secret in place to encrypt the value with the new key.",
+			},
 		},
 	}
 }
@@ -9,3 +9,7 @@ import (
 func TestSecretResourceModelMatchesSchema(t *testing.T) {
 	schematest.AssertResourceModelMatchesSchema(t, &SecretResource{}, &SecretResourceModel{})
 }
+
+func TestSecretEphemeralResourceModelMatchesSchema(t *testing.T) {
+	schematest.AssertEphemeralResourceModelMatchesSchema(t, &SecretEphemeralResource{}, &SecretEphemeralResourceModel{})
+}
@@ -27,6 +27,9 @@ func (d *AWSEnvStatusDataSource) Metadata(ctx context.Context, req datasource.Me
 }
 
 func (d *AWSEnvStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_aws_status", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading aws env status data source")
 
 	var data AWSEnvStatusModel
@@ -85,7 +88,7 @@ func (d *AWSEnvStatusDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	if !common.WaitForSpecRevision(ctx, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), poll, &resp.Diagnostics, readTimeout) {
+	if !common.WaitForSpecRevision(ctx, common.CloudAWS, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), d.SharedPoll(common.CloudAWS, poll), &resp.Diagnostics, readTimeout) {
 		return
 	}
 
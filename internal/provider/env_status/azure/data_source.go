@@ -27,6 +27,9 @@ func (d *AzureEnvStatusDataSource) Metadata(ctx context.Context, req datasource.
 }
 
 func (d *AzureEnvStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_azure_status", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading azure env status data source")
 
 	var data AzureEnvStatusModel
@@ -85,7 +88,7 @@ func (d *AzureEnvStatusDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	if !common.WaitForSpecRevision(ctx, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), poll, &resp.Diagnostics, readTimeout) {
+	if !common.WaitForSpecRevision(ctx, common.CloudAzure, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), d.SharedPoll(common.CloudAzure, poll), &resp.Diagnostics, readTimeout) {
 		return
 	}
 
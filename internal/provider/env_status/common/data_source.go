@@ -9,9 +9,12 @@ import (
 	clientsupport "github.com/altinity/terraform-provider-altinitycloud/internal/provider/common"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
 	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var MATCH_SPEC_TIMEOUT = time.Duration(60) * time.Minute
@@ -19,6 +22,7 @@ var MATCH_SPEC_POLL_INTERVAL = 30 * time.Second
 
 type EnvStatusDataSourceBase struct {
 	Client *client.Client
+	Cache  *client.QueryCache
 }
 
 func (d *EnvStatusDataSourceBase) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -38,6 +42,7 @@ func (d *EnvStatusDataSourceBase) Configure(ctx context.Context, req datasource.
 	}
 
 	d.Client = sdk.Client
+	d.Cache = sdk.Cache
 }
 
 // EnvError represents a provisioning error from the API.
@@ -56,10 +61,31 @@ type PollResult struct {
 // PollFunc is a callback that fetches the current env status from the API.
 type PollFunc func(ctx context.Context, envName string) (*PollResult, error)
 
+// SharedPoll returns poll wrapped so that concurrent WaitForSpecRevision calls
+// for the same env, e.g. from several status data sources, share one poller: a
+// result is reused by every waiter for half a poll interval, and a mutation of
+// the env discards it.
+func (d *EnvStatusDataSourceBase) SharedPoll(cloud string, poll PollFunc) PollFunc {
+	if d.Cache == nil {
+		return poll
+	}
+	return func(ctx context.Context, envName string) (*PollResult, error) {
+		var result PollResult
+		err := d.Cache.Do(ctx, fmt.Sprintf("poll/%s/%s", cloud, envName), envName, MATCH_SPEC_POLL_INTERVAL/2, func(ctx context.Context) (interface{}, error) {
+			return poll(ctx, envName)
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+}
+
 // WaitForSpecRevision polls the environment status until the applied spec revision
 // matches the target revision. It handles TTY output, DISCONNECTED errors, and timeouts.
+// Provisioning errors are reported with the remediation hints for cloud.
 // Returns true if the target revision was reached, false otherwise (errors added to diags).
-func WaitForSpecRevision(ctx context.Context, envName string, targetRevision int64, verbose bool, poll PollFunc, diags *diag.Diagnostics, readTimeout time.Duration) bool {
+func WaitForSpecRevision(ctx context.Context, cloud string, envName string, targetRevision int64, verbose bool, poll PollFunc, diags *diag.Diagnostics, readTimeout time.Duration) bool {
 	if readTimeout == 0 {
 		readTimeout = MATCH_SPEC_TIMEOUT
 	}
@@ -79,7 +105,7 @@ func WaitForSpecRevision(ctx context.Context, envName string, targetRevision int
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{"WAITING", "CONNECTING"},
 		Target:  []string{"READY"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: clientsupport.TracePoll(ctx, "WaitForSpecRevision", envName, func(ctx context.Context) (interface{}, string, error) {
 			result, err := poll(ctx, envName)
 			if err != nil {
 				return nil, "", fmt.Errorf("unable to read env status %s, got error: %s", envName, client.FormatError(err, envName))
@@ -88,6 +114,7 @@ func WaitForSpecRevision(ctx context.Context, envName string, targetRevision int
 			if !result.Found {
 				return nil, "", fmt.Errorf("environment %s was not found", envName)
 			}
+			trace.SpanFromContext(ctx).SetAttributes(tracing.AttrSpecRevision.Int64(result.AppliedSpecRevision))
 
 			elapsed := time.Since(start).Round(time.Second)
 
@@ -115,11 +142,7 @@ func WaitForSpecRevision(ctx context.Context, envName string, targetRevision int
 					return result, "CONNECTING", nil
 				}
 
-				var errorDetails string
-				for _, e := range blockingErrors {
-					errorDetails += fmt.Sprintf("%s: %s\n", e.Code, e.Message)
-				}
-				return nil, "", fmt.Errorf("environment %s has provisioning errors:\n%s", envName, errorDetails)
+				return nil, "", fmt.Errorf("environment %s has provisioning errors:\n%s", envName, FormatEnvErrors(cloud, blockingErrors))
 			}
 
 			if result.AppliedSpecRevision >= targetRevision {
@@ -133,7 +156,7 @@ func WaitForSpecRevision(ctx context.Context, envName string, targetRevision int
 				tty.printf("%s: [%s] waiting...\n", prefix, elapsed)
 			}
 			return result, "WAITING", nil
-		},
+		}, attribute.Int64("altinitycloud.target_spec_revision", targetRevision)),
 		Timeout:      readTimeout,
 		PollInterval: MATCH_SPEC_POLL_INTERVAL,
 	}
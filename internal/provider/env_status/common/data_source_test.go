@@ -0,0 +1,59 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+)
+
+func TestSharedPoll(t *testing.T) {
+	t.Parallel()
+	d := &EnvStatusDataSourceBase{Cache: client.NewQueryCache(client.DefaultQueryCacheTTL)}
+
+	release := make(chan struct{})
+	var polls atomic.Int32
+	poll := func(ctx context.Context, envName string) (*PollResult, error) {
+		polls.Add(1)
+		<-release
+		return &PollResult{AppliedSpecRevision: 3, Errors: []EnvError{{Code: "DISCONNECTED"}}, Found: true}, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*PollResult, 3)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Each waiter wraps its own poll func, as every data source read does.
+			result, err := d.SharedPoll(CloudAWS, poll)(context.Background(), "acme")
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = result
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if polls.Load() != 1 {
+		t.Errorf("expected concurrent waiters to share one poll, got %d", polls.Load())
+	}
+	for i, result := range results {
+		if result == nil || result.AppliedSpecRevision != 3 || len(result.Errors) != 1 || !result.Found {
+			t.Errorf("waiter %d got %+v", i, result)
+		}
+	}
+
+	d.Cache.Invalidate("acme")
+	if _, err := d.SharedPoll(CloudAWS, poll)(context.Background(), "acme"); err != nil {
+		t.Fatal(err)
+	}
+	if polls.Load() != 2 {
+		t.Errorf("expected a mutation of the env to discard the shared result, got %d polls", polls.Load())
+	}
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Clouds as used in the env resource and status data source type names
+// (altinitycloud_env_<cloud>, altinitycloud_env_<cloud>_status).
+const (
+	CloudAWS       = "aws"
+	CloudAWSHosted = "aws_hosted"
+	CloudAzure     = "azure"
+	CloudGCP       = "gcp"
+	CloudHCloud    = "hcloud"
+	CloudK8S       = "k8s"
+)
+
+// anyCloud keys the hints that don't depend on the cloud.
+const anyCloud = ""
+
+// remediations holds the next steps for each EnvStatusErrorCode, per cloud.
+// Lookups fall back to the anyCloud entry of the code.
+var remediations = map[string]map[string]string{
+	"INTERNAL": {
+		anyCloud:       "This is an Altinity.Cloud error, not a problem with your configuration. Run `terraform apply` again; if it persists, contact Altinity support with the env name and the error message.",
+		CloudAWSHosted: "This is an Altinity.Cloud error in the hosted env, not a problem with your configuration. Run `terraform apply` again; if it persists, contact Altinity support with the env name and the error message.",
+	},
+	"DISCONNECTED": {
+		CloudAWS:       "Altinity.Cloud lost contact with the cloud-connect agent in your AWS account. Check that the resources of the terraform-altinitycloud-connect-aws module still exist (IAM roles, the cloud-connect instance and its VPC/NAT egress on port 443) and were not removed by a `terraform destroy` of that module or by an SCP/permissions boundary change.",
+		CloudAWSHosted: "Altinity.Cloud lost contact with the hosted env. This is handled by Altinity; run `terraform apply` again later and contact Altinity support if it persists.",
+		CloudAzure:     "Altinity.Cloud lost contact with the cloud-connect agent in your Azure subscription. Check that the service principal and role assignments created for Altinity.Cloud still exist and that outbound HTTPS (port 443) is allowed from the env's virtual network.",
+		CloudGCP:       "Altinity.Cloud lost contact with the cloud-connect agent in your GCP project. Check that the service account and IAM bindings granted to Altinity.Cloud still exist and that Cloud NAT / firewall rules allow outbound HTTPS (port 443) from the env's VPC.",
+		CloudHCloud:    "Altinity.Cloud lost contact with the cloud-connect agent in your Hetzner Cloud project. Check that the API token given to Altinity.Cloud has not been revoked and that the cloud-connect server is running with outbound HTTPS (port 443).",
+		CloudK8S:       "Altinity.Cloud lost contact with the cloud-connect agent in your cluster. Check it with `kubectl -n altinity-cloud-system get pods` and `kubectl -n altinity-cloud-system logs deploy/cloud-connect`, and make sure the terraform-altinitycloud-connect module is still applied and the cluster allows outbound HTTPS (port 443).",
+	},
+	"K8S_DISCONNECTED": {
+		anyCloud:       "Altinity.Cloud can't reach the Kubernetes API of the env. Check that the cluster is running and healthy, then inspect the agent with `kubectl -n altinity-cloud-system get pods` and `kubectl -n altinity-cloud-system logs deploy/cloud-connect`.",
+		CloudAWSHosted: "Altinity.Cloud can't reach the Kubernetes API of the hosted env. This is handled by Altinity; contact Altinity support if it persists.",
+	},
+	"CLOUD_PROVIDER_ACCESS_DENIED": {
+		CloudAWS:       "AWS denied an API call made on behalf of Altinity.Cloud. Check that the IAM roles created by the terraform-altinitycloud-connect-aws module are intact, that a permissions boundary (`enable_permissions_boundary`) or an organization SCP does not block the action named in the message, and that any external buckets are also listed in the module's `external_buckets`.",
+		CloudAWSHosted: "AWS denied an API call made on behalf of Altinity.Cloud in the hosted env. Check any cross-account resources you referenced (e.g. peering or PrivateLink principals), otherwise contact Altinity support.",
+		CloudAzure:     "Azure denied an API call made on behalf of Altinity.Cloud. Check the role assignments of the Altinity.Cloud service principal on the subscription/resource group, and Azure Policy assignments that may deny the resource type named in the message.",
+		CloudGCP:       "GCP denied an API call made on behalf of Altinity.Cloud. Check the IAM roles granted to the Altinity.Cloud service account on the project, organization policy constraints, and that the required APIs (Compute Engine, Kubernetes Engine) are enabled.",
+		CloudHCloud:    "Hetzner Cloud denied an API call made on behalf of Altinity.Cloud. Check that the API token given to Altinity.Cloud is valid and has Read & Write permissions on the project.",
+		CloudK8S:       "The Kubernetes API or the underlying cloud denied a request made on behalf of Altinity.Cloud. Check the RBAC created by the terraform-altinitycloud-connect module and, for volumes/load balancers, the cluster's cloud permissions (e.g. the node or CSI driver IAM role).",
+	},
+	"CLOUD_PROVIDER_QUOTA_EXCEEDED": {
+		CloudAWS:       "An AWS service quota was hit. Check the EC2 vCPU limits for the instance families in `node_groups` (Service Quotas > EC2 > Running On-Demand instances), plus VPC, Elastic IP and EBS quotas in the env region; request an increase or reduce `capacity_per_zone`.",
+		CloudAWSHosted: "A quota of the hosted env was hit. Reduce the requested capacity or contact Altinity support to raise it.",
+		CloudAzure:     "An Azure quota was hit. Check the regional vCPU quota of the VM families in `node_groups` (Subscriptions > Usage + quotas), plus public IP and disk quotas; request an increase or reduce `capacity_per_zone`.",
+		CloudGCP:       "A GCP quota was hit. Check the regional CPUS, SSD_TOTAL_GB and IN_USE_ADDRESSES quotas (IAM & Admin > Quotas) for the machine types in `node_groups`; request an increase or reduce `capacity_per_zone`.",
+		CloudHCloud:    "A Hetzner Cloud resource limit was hit. Check the server, volume and primary IP limits of the project (Project > Limits) and request an increase or reduce `capacity_per_location`.",
+		CloudK8S:       "The cluster ran out of capacity. Check ResourceQuota/LimitRange objects in the env namespaces, the cluster autoscaler limits and that `node_groups` match node pools that can scale.",
+	},
+	"CLOUD_PROVIDER_RESOURCE_NOT_FOUND": {
+		CloudAWS:       "AWS could not find a resource the env refers to. Check that IDs in the configuration (VPC, peering, PrivateLink principals, KMS keys, external buckets) exist in the account and region of the env, and that the instance types in `node_groups` are offered in the chosen `zones`.",
+		CloudAWSHosted: "AWS could not find a resource the hosted env refers to. Check the IDs in the configuration (e.g. peering VPCs, PrivateLink principals) exist in the env region.",
+		CloudAzure:     "Azure could not find a resource the env refers to. Check that the resource group, VNet, private link and key IDs exist in the subscription and region, and that the VM sizes in `node_groups` are available in the chosen `zones`.",
+		CloudGCP:       "GCP could not find a resource the env refers to. Check that the network, peering and Private Service Connect targets exist in the project and region, and that the machine types in `node_groups` are offered in the chosen `zones`.",
+		CloudHCloud:    "Hetzner Cloud could not find a resource the env refers to. Check that networks and the server types in `node_groups` exist in the chosen `locations`.",
+		CloudK8S:       "A Kubernetes object the env refers to was not found. Check that the storage classes, node selectors and tolerations in the configuration match what the cluster provides.",
+	},
+	"CLOUD_PROVIDER_BAD_REQUEST": {
+		anyCloud: "The cloud provider rejected a request built from the env configuration. Check the attribute named in the message (CIDRs, zones, instance types, tags) against the provider's limits, fix it and run `terraform apply` again.",
+		CloudK8S: "The Kubernetes API rejected an object built from the env configuration. Check the attribute named in the message (storage classes, node selectors, tolerations) and admission policies such as Gatekeeper or Kyverno that may reject it.",
+	},
+}
+
+// Remediation returns the next steps for an env status error code on cloud, or
+// "" if the code is unknown. The hint ends with a pointer to the status data
+// source, which can be used to wait for the fix to be applied.
+func Remediation(cloud string, code string) string {
+	hint := Hint(cloud, code)
+	if hint == "" || cloud == "" {
+		return hint
+	}
+	return fmt.Sprintf("%s See `applied_spec_revision` of the `altinitycloud_env_%s_status` data source (with `wait_for_applied_spec_revision`) to follow the env until it recovers.", hint, cloud)
+}
+
+// Hint returns the next steps for an env status error code on cloud, without
+// the pointer to the status data source, or "" if the code is unknown.
+func Hint(cloud string, code string) string {
+	hints, ok := remediations[code]
+	if !ok {
+		return ""
+	}
+	hint, ok := hints[cloud]
+	if !ok {
+		hint = hints[anyCloud]
+	}
+	return hint
+}
+
+// FormatEnvErrors renders env status errors one per line, each followed by its
+// remediation hint, if any.
+func FormatEnvErrors(cloud string, errs []EnvError) string {
+	var b strings.Builder
+	for _, e := range errs {
+		fmt.Fprintf(&b, "%s: %s\n", e.Code, e.Message)
+		if hint := Remediation(cloud, e.Code); hint != "" {
+			fmt.Fprintf(&b, "  Hint: %s\n", hint)
+		}
+	}
+	return b.String()
+}
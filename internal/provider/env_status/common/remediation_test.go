@@ -0,0 +1,104 @@
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRemediation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cloud    string
+		code     string
+		contains []string
+	}{
+		"AWS quota points at EC2 vCPU limits": {
+			cloud:    CloudAWS,
+			code:     "CLOUD_PROVIDER_QUOTA_EXCEEDED",
+			contains: []string{"vCPU", "capacity_per_zone", "`altinitycloud_env_aws_status`"},
+		},
+		"GCP quota points at regional quotas": {
+			cloud:    CloudGCP,
+			code:     "CLOUD_PROVIDER_QUOTA_EXCEEDED",
+			contains: []string{"CPUS", "`altinitycloud_env_gcp_status`"},
+		},
+		"HCloud quota uses the hcloud attribute names": {
+			cloud:    CloudHCloud,
+			code:     "CLOUD_PROVIDER_QUOTA_EXCEEDED",
+			contains: []string{"capacity_per_location"},
+		},
+		"Azure access denied points at role assignments": {
+			cloud:    CloudAzure,
+			code:     "CLOUD_PROVIDER_ACCESS_DENIED",
+			contains: []string{"role assignments"},
+		},
+		"K8s disconnected explains how to inspect cloud-connect": {
+			cloud:    CloudK8S,
+			code:     "DISCONNECTED",
+			contains: []string{"kubectl -n altinity-cloud-system", "`altinitycloud_env_k8s_status`"},
+		},
+		"cloud-independent hint falls back to the default": {
+			cloud:    CloudGCP,
+			code:     "INTERNAL",
+			contains: []string{"Altinity support", "`altinitycloud_env_gcp_status`"},
+		},
+		"hosted env has its own hint": {
+			cloud:    CloudAWSHosted,
+			code:     "K8S_DISCONNECTED",
+			contains: []string{"hosted env", "`altinitycloud_env_aws_hosted_status`"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got := Remediation(tc.cloud, tc.code)
+			for _, want := range tc.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("Remediation(%q, %q) = %q, want to contain %q", tc.cloud, tc.code, got, want)
+				}
+			}
+		})
+	}
+
+	if got := Remediation(CloudAWS, "SOMETHING_NEW"); got != "" {
+		t.Errorf("expected no hint for an unknown code, got %q", got)
+	}
+}
+
+func TestRemediationCoversAllCodes(t *testing.T) {
+	t.Parallel()
+
+	codes := []string{"INTERNAL", "DISCONNECTED", "CLOUD_PROVIDER_ACCESS_DENIED", "CLOUD_PROVIDER_QUOTA_EXCEEDED", "CLOUD_PROVIDER_RESOURCE_NOT_FOUND", "CLOUD_PROVIDER_BAD_REQUEST", "K8S_DISCONNECTED"}
+	clouds := []string{CloudAWS, CloudAWSHosted, CloudAzure, CloudGCP, CloudHCloud, CloudK8S}
+	for _, code := range codes {
+		for _, cloud := range clouds {
+			if Remediation(cloud, code) == "" {
+				t.Errorf("missing remediation for %s on %s", code, cloud)
+			}
+		}
+	}
+}
+
+func TestFormatEnvErrors(t *testing.T) {
+	t.Parallel()
+
+	got := FormatEnvErrors(CloudAWS, []EnvError{
+		{Code: "CLOUD_PROVIDER_ACCESS_DENIED", Message: "not authorized to perform ec2:RunInstances"},
+		{Code: "SOMETHING_NEW", Message: "unexpected"},
+	})
+	lines := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %q", got)
+	}
+	if lines[0] != "CLOUD_PROVIDER_ACCESS_DENIED: not authorized to perform ec2:RunInstances" {
+		t.Errorf("unexpected first line %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], "  Hint: AWS denied") {
+		t.Errorf("expected a hint after the error, got %q", lines[1])
+	}
+	if lines[2] != "SOMETHING_NEW: unexpected" {
+		t.Errorf("unknown codes should be printed without a hint, got %q", lines[2])
+	}
+}
@@ -27,6 +27,9 @@ func (d *GCPEnvStatusDataSource) Metadata(ctx context.Context, req datasource.Me
 }
 
 func (d *GCPEnvStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_gcp_status", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading gcp env status data source")
 
 	var data GCPEnvStatusModel
@@ -85,7 +88,7 @@ func (d *GCPEnvStatusDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	if !common.WaitForSpecRevision(ctx, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), poll, &resp.Diagnostics, readTimeout) {
+	if !common.WaitForSpecRevision(ctx, common.CloudGCP, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), d.SharedPoll(common.CloudGCP, poll), &resp.Diagnostics, readTimeout) {
 		return
 	}
 
@@ -27,6 +27,9 @@ func (d *HCloudEnvStatusDataSource) Metadata(ctx context.Context, req datasource
 }
 
 func (d *HCloudEnvStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, endSpan := clientsupport.TraceCRUD(ctx, "data.altinitycloud_env_hcloud_status", "Read", req.Config)
+	defer func() { endSpan(resp.State, resp.Diagnostics) }()
+
 	tflog.Trace(ctx, "reading hcloud env status data source")
 
 	var data HCloudEnvStatusModel
@@ -85,7 +88,7 @@ func (d *HCloudEnvStatusDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	if !common.WaitForSpecRevision(ctx, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), poll, &resp.Diagnostics, readTimeout) {
+	if !common.WaitForSpecRevision(ctx, common.CloudHCloud, envName, waitForAppliedSpecRevision, data.Verbose.ValueBool(), d.SharedPoll(common.CloudHCloud, poll), &resp.Diagnostics, readTimeout) {
 		return
 	}
 
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/altinity/terraform-provider-altinitycloud/pkg/altinitycloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"golang.org/x/net/http/httpguts"
+)
+
+// reservedHeaders are set by the provider itself and can't be overridden with
+// the headers attribute.
+var reservedHeaders = []string{"Authorization", "Content-Type", "User-Agent"}
+
+// networkSettings applies the provider TLS, proxy and header attributes to
+// policy and returns the CA pool to verify Altinity.Cloud with (nil for the
+// system pool).
+func networkSettings(ctx context.Context, data *altinityCloudProviderModel, policy *altinitycloud.TransportPolicy) (*x509.CertPool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var caPEM []byte
+	if !data.CACrt.IsNull() {
+		caPEM = append(caPEM, data.CACrt.ValueString()...)
+		caPEM = append(caPEM, '\n')
+	}
+	if !data.CACrtFile.IsNull() {
+		content, err := os.ReadFile(data.CACrtFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_crt_file"), "Failed to load CA certificate", err.Error())
+			return nil, diags
+		}
+		caPEM = append(caPEM, content...)
+	}
+	var rootCAs *x509.CertPool
+	if caPEM != nil {
+		var err error
+		rootCAs, err = loadCertPool(string(caPEM), data.CACrtAppendSystemPool.ValueBool())
+		if err != nil {
+			diags.AddError("Failed to load CA certificate", err.Error())
+			return nil, diags
+		}
+	}
+
+	certPEM, certAttribute := data.ClientCrt.ValueString(), "client_crt"
+	if !data.ClientCrtFile.IsNull() {
+		content, err := os.ReadFile(data.ClientCrtFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_crt_file"), "Failed to load client certificate", err.Error())
+			return nil, diags
+		}
+		certPEM, certAttribute = string(content), "client_crt_file"
+	}
+	keyPEM, keyAttribute := data.ClientKey.ValueString(), "client_key"
+	if !data.ClientKeyFile.IsNull() {
+		content, err := os.ReadFile(data.ClientKeyFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_key_file"), "Failed to load client key", err.Error())
+			return nil, diags
+		}
+		keyPEM, keyAttribute = string(content), "client_key_file"
+	}
+	switch {
+	case certPEM != "" && keyPEM != "":
+		cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+		if err != nil {
+			diags.AddAttributeError(path.Root(certAttribute), "Invalid Client Certificate", fmt.Sprintf("The client certificate and key can't be used together: %s", err))
+			return nil, diags
+		}
+		policy.ClientCertificates = append(policy.ClientCertificates, cert)
+	case certPEM != "":
+		diags.AddAttributeError(path.Root(certAttribute), "Missing Client Key", "client_key or client_key_file is required with a client certificate.")
+	case keyPEM != "":
+		diags.AddAttributeError(path.Root(keyAttribute), "Missing Client Certificate", "client_crt or client_crt_file is required with a client key.")
+	}
+
+	if !data.ProxyURL.IsNull() {
+		proxy, err := altinitycloud.ProxyFunc(data.ProxyURL.ValueString(), data.NoProxy.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", err.Error())
+		}
+		policy.Proxy = proxy
+	} else if !data.NoProxy.IsNull() {
+		diags.AddAttributeError(path.Root("no_proxy"), "Missing Proxy URL", "no_proxy only applies with proxy_url. To bypass a proxy set with HTTPS_PROXY, set the NO_PROXY env var instead.")
+	}
+
+	if !data.Headers.IsNull() {
+		var headers map[string]string
+		diags.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+		for name := range headers {
+			for _, reserved := range reservedHeaders {
+				if strings.EqualFold(name, reserved) {
+					diags.AddAttributeError(path.Root("headers").AtMapKey(name), "Invalid Header", fmt.Sprintf("The %s header is set by the provider.", reserved))
+				}
+			}
+			if !httpguts.ValidHeaderFieldName(name) {
+				diags.AddAttributeError(path.Root("headers").AtMapKey(name), "Invalid Header", fmt.Sprintf("%q is not a valid HTTP header name.", name))
+			}
+		}
+		policy.Headers = headers
+	}
+
+	return rootCAs, diags
+}
+
+// loadCertPool returns a pool of the certificates in cert, on top of the
+// system pool if appendSystem is set.
+func loadCertPool(cert string, appendSystem bool) (*x509.CertPool, error) {
+	clientCAs := x509.NewCertPool()
+	if appendSystem {
+		systemCAs, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the system CA pool: %w", err)
+		}
+		clientCAs = systemCAs
+	}
+	ok := clientCAs.AppendCertsFromPEM([]byte(cert))
+	if !ok {
+		return nil, errors.New("pem: failed to append certificates")
+	}
+	return clientCAs, nil
+}
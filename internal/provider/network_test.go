@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/pkg/altinitycloud"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testKeyPair returns a self-signed certificate and its key, PEM-encoded.
+func testKeyPair(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gateway-client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestNetworkSettings(t *testing.T) {
+	t.Parallel()
+	certPEM, keyPEM := testKeyPair(t)
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "client.key")
+	if err := os.WriteFile(keyFile, []byte(keyPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	data := &altinityCloudProviderModel{
+		CACrt:         types.StringValue(certPEM),
+		ClientCrt:     types.StringValue(certPEM),
+		ClientKeyFile: types.StringValue(keyFile),
+		ProxyURL:      types.StringValue("http://proxy.example.com:3128"),
+		Headers:       types.MapValueMust(types.StringType, map[string]attr.Value{"X-Gateway-Key": types.StringValue("k3y")}),
+	}
+	policy := altinitycloud.DefaultTransportPolicy()
+	rootCAs, diags := networkSettings(context.Background(), data, &policy)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if rootCAs == nil || len(policy.ClientCertificates) != 1 || policy.Proxy == nil || policy.Headers["X-Gateway-Key"] != "k3y" {
+		t.Errorf("expected every setting to be applied, got CAs %v and policy %+v", rootCAs, policy)
+	}
+}
+
+func TestNetworkSettingsErrors(t *testing.T) {
+	t.Parallel()
+	certPEM, _ := testKeyPair(t)
+
+	tests := map[string]*altinityCloudProviderModel{
+		"missing CA file":    {CACrtFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))},
+		"invalid CA":         {CACrt: types.StringValue("not a PEM")},
+		"certificate no key": {ClientCrt: types.StringValue(certPEM)},
+		"key no certificate": {ClientKey: types.StringValue("key")},
+		"mismatched pair":    {ClientCrt: types.StringValue(certPEM), ClientKey: types.StringValue(certPEM)},
+		"invalid proxy":      {ProxyURL: types.StringValue("ftp://proxy.example.com")},
+		"no_proxy alone":     {NoProxy: types.StringValue("localhost")},
+		"reserved header":    {Headers: types.MapValueMust(types.StringType, map[string]attr.Value{"authorization": types.StringValue("Bearer x")})},
+		"invalid header":     {Headers: types.MapValueMust(types.StringType, map[string]attr.Value{"X Gateway": types.StringValue("x")})},
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			policy := altinitycloud.DefaultTransportPolicy()
+			if _, diags := networkSettings(context.Background(), data, &policy); !diags.HasError() {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestLoadCertPoolAppendsToSystemPool(t *testing.T) {
+	t.Parallel()
+	certPEM, _ := testKeyPair(t)
+	system, err := x509.SystemCertPool()
+	if err != nil {
+		t.Skipf("no system CA pool: %s", err)
+	}
+
+	only, err := loadCertPool(certPEM, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appended, err := loadCertPool(certPEM, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if only.Equal(appended) && !system.Equal(x509.NewCertPool()) {
+		t.Error("expected the system pool to be kept when appending")
+	}
+}
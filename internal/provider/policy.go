@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/provider/validators"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policyModel is the provider policy block.
+type policyModel struct {
+	AllowedRegions              types.Map      `tfsdk:"allowed_regions"`
+	MaxCapacityPerZone          types.Int64    `tfsdk:"max_capacity_per_zone"`
+	MaxTotalNodes               types.Int64    `tfsdk:"max_total_nodes"`
+	RequiredTagKeys             []types.String `tfsdk:"required_tag_keys"`
+	ForbiddenPublicSourceRanges []types.String `tfsdk:"forbidden_public_source_ranges"`
+	AllowedNodeTypes            []types.String `tfsdk:"allowed_node_types"`
+	RequireMonitoring           types.Bool     `tfsdk:"require_monitoring"`
+}
+
+var policyBlock = schema.SingleNestedBlock{
+	MarkdownDescription: "Organization guardrails checked by every env resource of the provider instance when planning: a plan breaking a rule fails with an error on the offending attribute. " +
+		"Rules on values unknown until apply are checked once they are known. Unset rules don't restrict anything. Data sources are not affected.",
+	Attributes: map[string]schema.Attribute{
+		"allowed_regions": schema.MapAttribute{
+			MarkdownDescription: "Allowed regions by cloud: `aws`, `aws_hosted`, `azure`, `gcp` or `hcloud` (network zones). Clouds without an entry are not restricted.",
+			ElementType:         types.ListType{ElemType: types.StringType},
+			Optional:            true,
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(stringvalidator.OneOf("aws", "aws_hosted", "azure", "gcp", "hcloud")),
+			},
+		},
+		"max_capacity_per_zone": schema.Int64Attribute{
+			MarkdownDescription: "Maximum capacity per zone (per location on HCloud) of a node group.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"max_total_nodes": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of nodes of an env: the capacity per zone of its node groups times their zones, added up.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"required_tag_keys": schema.ListAttribute{
+			MarkdownDescription: "Tag keys every AWS and Azure env, and label keys every GCP env, must have, provider `defaults` included.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"forbidden_public_source_ranges": schema.ListAttribute{
+			MarkdownDescription: "CIDRs a public load balancer must not allow traffic from, e.g. `0.0.0.0/0`: its source IP ranges, `0.0.0.0/0` when unset, must not contain any of them.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(validators.CIDR()),
+			},
+		},
+		"allowed_node_types": schema.ListAttribute{
+			MarkdownDescription: "Allowed node group node types.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"require_monitoring": schema.BoolAttribute{
+			MarkdownDescription: "Require the metrics endpoint or Datadog enabled on every env.",
+			Optional:            true,
+		},
+	},
+}
+
+// policy converts the policy block for the env resources.
+func policy(ctx context.Context, data *policyModel) (sdk.Policy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if data == nil {
+		return sdk.Policy{}, diags
+	}
+	p := sdk.Policy{
+		MaxCapacityPerZone:          data.MaxCapacityPerZone.ValueInt64(),
+		MaxTotalNodes:               data.MaxTotalNodes.ValueInt64(),
+		RequiredTagKeys:             stringValues(data.RequiredTagKeys),
+		ForbiddenPublicSourceRanges: stringValues(data.ForbiddenPublicSourceRanges),
+		AllowedNodeTypes:            stringValues(data.AllowedNodeTypes),
+		RequireMonitoring:           data.RequireMonitoring.ValueBool(),
+	}
+	if !data.AllowedRegions.IsNull() && !data.AllowedRegions.IsUnknown() {
+		diags.Append(data.AllowedRegions.ElementsAs(ctx, &p.AllowedRegions, false)...)
+	}
+	return p, diags
+}
+
+func stringValues(values []types.String) []string {
+	var s []string
+	for _, v := range values {
+		s = append(s, v.ValueString())
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/altinity/terraform-provider-altinitycloud/pkg/altinitycloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// preflight checks, before any resource or data source runs, that the API
+// accepts the token and still supports the schema the provider was built
+// with. Only an invalid token is an error: an API briefly out of reach or not
+// allowing introspection must not block Terraform.
+func preflight(ctx context.Context, c *altinitycloud.Client, apiURL string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := c.Ping(ctx); err != nil {
+		if errors.Is(err, altinitycloud.ErrUnauthorized) {
+			diags.AddError("Invalid Altinity.Cloud API Token",
+				fmt.Sprintf("%s rejected the API token: %s\n\n"+
+					"Check the token set with api_token, api_token_file or api_token_command, the %s env var or the profile.",
+					apiURL, client.FormatError(err, ""), ENV_VAR_API_TOKEN))
+			return diags
+		}
+		diags.AddWarning("Altinity.Cloud Preflight Check Failed",
+			fmt.Sprintf("Could not query %s: %s\n\nThe API schema was not checked.", apiURL, client.FormatError(err, "")))
+		return diags
+	}
+
+	issues, err := c.CheckSchema(ctx)
+	if err != nil {
+		diags.AddWarning("Altinity.Cloud Schema Check Skipped",
+			fmt.Sprintf("Could not introspect the API schema of %s: %s", apiURL, client.FormatError(err, "")))
+		return diags
+	}
+	if len(issues) == 0 {
+		return diags
+	}
+	var lines []string
+	for _, issue := range issues {
+		lines = append(lines, "- "+issue.String())
+	}
+	diags.AddWarning("Altinity.Cloud API Schema Mismatch",
+		fmt.Sprintf("The API at %s has changed since this provider version was built:\n\n%s\n\n"+
+			"Resources and data sources using these fields may fail. Upgrade the provider, or check the changelog if it is already the latest version.",
+			apiURL, strings.Join(lines, "\n")))
+	return diags
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/altinity/terraform-provider-altinitycloud/pkg/altinitycloud"
+)
+
+func TestPreflight(t *testing.T) {
+	tests := map[string]struct {
+		responses   map[string]string
+		status      int
+		wantError   string
+		wantWarning string
+	}{
+		"invalid token": {
+			status:    http.StatusUnauthorized,
+			responses: map[string]string{"Ping": `{"errors":[{"message":"Invalid API token"}]}`},
+			wantError: "Invalid Altinity.Cloud API Token",
+		},
+		"introspection disabled": {
+			status: http.StatusOK,
+			responses: map[string]string{
+				"Ping":          `{"data":{"__typename":"Query"}}`,
+				"Introspection": `{"errors":[{"message":"introspection is disabled"}]}`,
+			},
+			wantWarning: "Altinity.Cloud Schema Check Skipped",
+		},
+		"schema mismatch": {
+			status: http.StatusOK,
+			responses: map[string]string{
+				"Ping":          `{"data":{"__typename":"Query"}}`,
+				"Introspection": `{"data":{"__schema":{"types":[]}}}`,
+			},
+			wantWarning: "Altinity.Cloud API Schema Mismatch",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					OperationName string `json:"operationName"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.responses[body.OperationName]))
+			}))
+			defer srv.Close()
+
+			c, err := altinitycloud.New(altinitycloud.WithAPIURL(srv.URL), altinitycloud.WithToken("t0ken"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			diags := preflight(context.Background(), c, srv.URL)
+
+			if tc.wantError != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tc.wantError {
+					t.Fatalf("expected error %q, got %v", tc.wantError, diags)
+				}
+				return
+			}
+			if diags.HasError() || len(diags.Warnings()) != 1 || diags.Warnings()[0].Summary() != tc.wantWarning {
+				t.Fatalf("expected warning %q only, got %v", tc.wantWarning, diags)
+			}
+			if tc.wantWarning == "Altinity.Cloud API Schema Mismatch" && !strings.Contains(diags.Warnings()[0].Detail(), "- Query.awsEnv (used by GetAWSEnv") {
+				t.Errorf("expected the missing fields in the detail, got:\n%s", diags.Warnings()[0].Detail())
+			}
+		})
+	}
+}
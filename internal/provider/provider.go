@@ -2,11 +2,11 @@ package provider
 
 import (
 	"context"
-	"crypto/x509"
-	"errors"
 	"fmt"
+	"math"
 	"os"
 	"runtime"
+	"strconv"
 	"time"
 
 	env_aws "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env/aws"
@@ -23,13 +23,14 @@ import (
 	env_status_gcp "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/gcp"
 	env_status_hcloud "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/hcloud"
 	env_status_k8s "github.com/altinity/terraform-provider-altinitycloud/internal/provider/env_status/k8s"
-	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/auth"
-	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
-	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/crypto"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/credentials"
 	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+	"github.com/altinity/terraform-provider-altinitycloud/pkg/altinitycloud"
 
-	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -37,13 +38,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-const DEFAULT_API_URL = "https://anywhere.altinity.cloud"
-const GRAPHQL_API_PATH = "/api/v1/graphql"
+const DEFAULT_API_URL = altinitycloud.DefaultAPIURL
 
-const ENV_VAR_API_URL = "ALTINITYCLOUD_API_URL"
-const ENV_VAR_API_TOKEN = "ALTINITYCLOUD_API_TOKEN"
+const ENV_VAR_API_URL = credentials.EnvAPIURL
+const ENV_VAR_API_TOKEN = credentials.EnvAPIToken
+const ENV_VAR_READ_ONLY = "ALTINITYCLOUD_READ_ONLY"
+const ENV_VAR_AUDIT_LOG = "ALTINITYCLOUD_AUDIT_LOG"
+const ENV_VAR_PREFLIGHT = "ALTINITYCLOUD_PREFLIGHT"
+const ENV_VAR_PROFILE = credentials.EnvProfile
+const ENV_VAR_CONFIG_FILE = credentials.EnvConfigFile
 
 var _ provider.Provider = &altinityCloudProvider{}
+var _ provider.ProviderWithEphemeralResources = &altinityCloudProvider{}
 
 // altinityCloudProvider defines the provider implementation.
 type altinityCloudProvider struct {
@@ -54,7 +60,42 @@ type altinityCloudProvider struct {
 type altinityCloudProviderModel struct {
 	ApiURL   types.String `tfsdk:"api_url"`
 	ApiToken types.String `tfsdk:"api_token"`
-	CACrt    types.String `tfsdk:"ca_crt"`
+	// Token sources and profile, see apiSettings.
+	ApiTokenFile    types.String `tfsdk:"api_token_file"`
+	ApiTokenCommand types.String `tfsdk:"api_token_command"`
+	Profile         types.String `tfsdk:"profile"`
+	CACrt           types.String `tfsdk:"ca_crt"`
+	// Network settings, see networkSettings.
+	CACrtFile             types.String    `tfsdk:"ca_crt_file"`
+	CACrtAppendSystemPool types.Bool      `tfsdk:"ca_crt_append_system_pool"`
+	ClientCrt             types.String    `tfsdk:"client_crt"`
+	ClientCrtFile         types.String    `tfsdk:"client_crt_file"`
+	ClientKey             types.String    `tfsdk:"client_key"`
+	ClientKeyFile         types.String    `tfsdk:"client_key_file"`
+	ProxyURL              types.String    `tfsdk:"proxy_url"`
+	NoProxy               types.String    `tfsdk:"no_proxy"`
+	Headers               types.Map       `tfsdk:"headers"`
+	ReadOnly              types.Bool      `tfsdk:"read_only"`
+	AuditLog              types.String    `tfsdk:"audit_log"`
+	Preflight             types.Bool      `tfsdk:"preflight"`
+	Transport             *transportModel `tfsdk:"transport"`
+	Defaults              *defaultsModel  `tfsdk:"defaults"`
+	Policy                *policyModel    `tfsdk:"policy"`
+}
+
+// transportModel describes the provider transport block.
+type transportModel struct {
+	MaxRetries                types.Int64   `tfsdk:"max_retries"`
+	InitialBackoff            types.String  `tfsdk:"initial_backoff"`
+	MaxBackoff                types.String  `tfsdk:"max_backoff"`
+	Jitter                    types.Float64 `tfsdk:"jitter"`
+	RequestTimeout            types.String  `tfsdk:"request_timeout"`
+	ExtraRetryableStatusCodes types.List    `tfsdk:"extra_retryable_status_codes"`
+	MaxConcurrentRequests     types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond         types.Float64 `tfsdk:"requests_per_second"`
+	RequestsBurst             types.Int64   `tfsdk:"requests_burst"`
+	CircuitBreakerThreshold   types.Int64   `tfsdk:"circuit_breaker_threshold"`
+	CircuitBreakerCooldown    types.String  `tfsdk:"circuit_breaker_cooldown"`
 }
 
 func (p *altinityCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -71,7 +112,46 @@ func (p *altinityCloudProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional: true,
 			},
 			"ca_crt": schema.StringAttribute{
-				MarkdownDescription: "CA bundle for Altinity.Cloud.",
+				MarkdownDescription: "CA bundle (PEM) to verify Altinity.Cloud with. It replaces the system CA pool unless `ca_crt_append_system_pool` is `true`.",
+				Optional:            true,
+			},
+			"ca_crt_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a CA bundle (PEM) file to verify Altinity.Cloud with, e.g. the CA of a TLS-intercepting corporate proxy. Combined with `ca_crt` if both are set.",
+				Optional:            true,
+			},
+			"ca_crt_append_system_pool": schema.BoolAttribute{
+				MarkdownDescription: "Trust the system CA pool in addition to `ca_crt` and `ca_crt_file` instead of only them (default `false`).",
+				Optional:            true,
+			},
+			"client_crt": schema.StringAttribute{
+				MarkdownDescription: "Client certificate (PEM) presented to servers asking for one, e.g. an mTLS gateway in front of Altinity.Cloud. Requires `client_key` or `client_key_file`.",
+				Optional:            true,
+			},
+			"client_crt_file": schema.StringAttribute{
+				MarkdownDescription: "Path of the client certificate (PEM) file, instead of `client_crt`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "Private key (PEM) of the client certificate.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path of the private key (PEM) file of the client certificate, instead of `client_key`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send every request through, e.g. `http://proxy.example.com:3128` (`http`, `https` and `socks5` are supported). " +
+					"Defaults to the `HTTPS_PROXY` and `NO_PROXY` env vars.",
+				Optional: true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated hosts, domains (`.example.com`) and CIDR ranges to reach without going through `proxy_url`. Defaults to the `NO_PROXY` env var.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Static HTTP headers sent with every request, e.g. for a gateway that requires its own API key. `Authorization`, `Content-Type` and `User-Agent` are set by the provider and can't be overridden.",
+				ElementType:         types.StringType,
 				Optional:            true,
 			},
 			"api_token": schema.StringAttribute{
@@ -81,10 +161,198 @@ func (p *altinityCloudProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_token_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file holding the Altinity.Cloud API Token, e.g. one kept up to date by a secrets manager agent. " +
+					"The file is read again whenever it changes.",
+				Optional: true,
+			},
+			"api_token_command": schema.StringAttribute{
+				MarkdownDescription: "Command run with the system shell to get the Altinity.Cloud API Token, like git credential helpers or AWS `credential_process`. " +
+					"It prints either the bare token or a JSON object `{\"token\": \"...\", \"expires_at\": \"2026-01-02T15:04:05Z\"}`; " +
+					"with `expires_at` the command is run again shortly before the token expires.",
+				Optional: true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Name of the profile of the config file (`~/.config/altinitycloud/config`, or the `%s` env var) to take the API URL, token and CA from, "+
+					"when they are not set otherwise. Defaults to the `%s` env var, then to the `default` profile if there is one.", ENV_VAR_CONFIG_FILE, ENV_VAR_PROFILE),
+				Optional: true,
+			},
+			"audit_log": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Path of a local file to append an audit trail to: one JSON line per GraphQL mutation and certificate signing, "+
+					"with the timestamp, operation, env name, returned `mutationId` and spec revision, and the request variables with secrets masked. "+
+					"Can also be set with the `%s` env var.", ENV_VAR_AUDIT_LOG),
+				Optional: true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Block every operation that would change anything in Altinity.Cloud (GraphQL mutations and certificate issuance), "+
+					"e.g. to run `terraform plan` in CI with production tokens. Blocked calls fail with an error naming the operation. "+
+					"Defaults to `false` unless `%s` env var is set to `true`.", ENV_VAR_READ_ONLY),
+				Optional: true,
+			},
+			"preflight": schema.BoolAttribute{
+				MarkdownDescription: fmt.Sprintf("Check the API before any resource or data source runs: fail if the API token is rejected, "+
+					"and warn about the fields the provider uses that the API no longer supports or deprecates, e.g. after a control plane upgrade. "+
+					"Costs two queries per Terraform run. Defaults to `false` unless `%s` env var is set to `true`.", ENV_VAR_PREFLIGHT),
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"defaults": defaultsBlock,
+			"policy":   policyBlock,
+			"transport": schema.SingleNestedBlock{
+				MarkdownDescription: "How requests to Altinity.Cloud are timed out, retried and throttled. Applies to GraphQL queries and to certificate signing and public key requests; GraphQL mutations are never retried. " +
+					"The concurrency and rate limits and the circuit breaker are shared by all the resources and data sources of the provider instance, and also apply to mutations.",
+				Attributes: map[string]schema.Attribute{
+					"max_retries": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Number of retries after a failed attempt (default `%d`).", defaultTransport.MaxRetries),
+						Optional:            true,
+					},
+					"initial_backoff": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Wait before the first retry, doubled on every further retry, e.g. `1s` (default `%s`). A `Retry-After` header sent with a 429 or 503 response takes precedence.", defaultTransport.InitialBackoff),
+						Optional:            true,
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Upper bound of the wait between retries, including one requested by a `Retry-After` header (default `%s`).", defaultTransport.MaxBackoff),
+						Optional:            true,
+					},
+					"jitter": schema.Float64Attribute{
+						MarkdownDescription: fmt.Sprintf("Fraction by which each wait is randomized, between `0` and `1` (default `%g`).", defaultTransport.Jitter),
+						Optional:            true,
+					},
+					"request_timeout": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Timeout of a single request attempt, e.g. `2m` (default `%s`).", defaultTransport.RequestTimeout),
+						Optional:            true,
+					},
+					"extra_retryable_status_codes": schema.ListAttribute{
+						MarkdownDescription: "HTTP status codes to retry in addition to `429`, `502`, `503` and `504`.",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+					},
+					"max_concurrent_requests": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of requests in flight at once, e.g. `8`; further requests wait for a free slot. Not limited by default.",
+						Optional:            true,
+					},
+					"requests_per_second": schema.Float64Attribute{
+						MarkdownDescription: "Average number of requests sent per second at most, e.g. `5`. Requests over the rate wait for their turn. Not limited by default.",
+						Optional:            true,
+					},
+					"requests_burst": schema.Int64Attribute{
+						MarkdownDescription: "Number of requests that may be sent at once above `requests_per_second` (default `requests_per_second` rounded up).",
+						Optional:            true,
+					},
+					"circuit_breaker_threshold": schema.Int64Attribute{
+						MarkdownDescription: "Number of consecutive failed requests (5xx responses, connection errors and timeouts) after which requests fail immediately instead of being sent, until `circuit_breaker_cooldown` has passed, e.g. `10`. The circuit breaker is disabled by default.",
+						Optional:            true,
+					},
+					"circuit_breaker_cooldown": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("How long requests fail immediately once the circuit breaker has tripped, e.g. `1m` (default `%s`). Then a single request is sent to probe the API: if it succeeds, requests are sent again.", sdkHttp.DefaultCircuitBreakerCooldown),
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
 
+var defaultTransport = altinitycloud.DefaultTransportPolicy()
+
+// transportPolicy builds the transport policy from the provider transport
+// block, starting from the defaults, with a new limiter and circuit breaker.
+func transportPolicy(ctx context.Context, data *transportModel) (altinitycloud.TransportPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := altinitycloud.DefaultTransportPolicy()
+	if data == nil {
+		// All null: the defaults.
+		data = &transportModel{}
+	}
+
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(path.Root("transport").AtName("max_retries"), "Invalid Transport Setting", "max_retries must not be negative.")
+		}
+		policy.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	maxConcurrentRequests := sdkHttp.DefaultMaxConcurrentRequests
+	threshold := sdkHttp.DefaultCircuitBreakerThreshold
+	burst := 0
+	cooldown := sdkHttp.DefaultCircuitBreakerCooldown
+	durations := []struct {
+		name  string
+		value types.String
+		field *time.Duration
+	}{
+		{"initial_backoff", data.InitialBackoff, &policy.InitialBackoff},
+		{"max_backoff", data.MaxBackoff, &policy.MaxBackoff},
+		{"request_timeout", data.RequestTimeout, &policy.RequestTimeout},
+		{"circuit_breaker_cooldown", data.CircuitBreakerCooldown, &cooldown},
+	}
+	for _, d := range durations {
+		if d.value.IsNull() {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value.ValueString())
+		if err != nil || parsed <= 0 {
+			diags.AddAttributeError(path.Root("transport").AtName(d.name), "Invalid Transport Setting", fmt.Sprintf("%s must be a positive duration such as \"30s\", got %q.", d.name, d.value.ValueString()))
+			continue
+		}
+		*d.field = parsed
+	}
+	if !data.Jitter.IsNull() {
+		if data.Jitter.ValueFloat64() < 0 || data.Jitter.ValueFloat64() > 1 {
+			diags.AddAttributeError(path.Root("transport").AtName("jitter"), "Invalid Transport Setting", "jitter must be between 0 and 1.")
+		}
+		policy.Jitter = data.Jitter.ValueFloat64()
+	}
+	if !data.ExtraRetryableStatusCodes.IsNull() {
+		var codes []int64
+		diags.Append(data.ExtraRetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+		for _, code := range codes {
+			if code < 400 || code > 599 {
+				diags.AddAttributeError(path.Root("transport").AtName("extra_retryable_status_codes"), "Invalid Transport Setting", fmt.Sprintf("%d is not an HTTP error status code.", code))
+				continue
+			}
+			policy.ExtraRetryableStatusCodes = append(policy.ExtraRetryableStatusCodes, int(code))
+		}
+	}
+	if policy.MaxBackoff < policy.InitialBackoff {
+		diags.AddAttributeError(path.Root("transport").AtName("max_backoff"), "Invalid Transport Setting", "max_backoff must not be shorter than initial_backoff.")
+	}
+
+	counts := []struct {
+		name  string
+		value types.Int64
+		field *int
+	}{
+		{"max_concurrent_requests", data.MaxConcurrentRequests, &maxConcurrentRequests},
+		{"requests_burst", data.RequestsBurst, &burst},
+		{"circuit_breaker_threshold", data.CircuitBreakerThreshold, &threshold},
+	}
+	for _, c := range counts {
+		if c.value.IsNull() {
+			continue
+		}
+		if c.value.ValueInt64() < 0 {
+			diags.AddAttributeError(path.Root("transport").AtName(c.name), "Invalid Transport Setting", fmt.Sprintf("%s must not be negative.", c.name))
+			continue
+		}
+		*c.field = int(c.value.ValueInt64())
+	}
+	var requestsPerSecond float64
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+		if requestsPerSecond <= 0 {
+			diags.AddAttributeError(path.Root("transport").AtName("requests_per_second"), "Invalid Transport Setting", "requests_per_second must be positive.")
+		}
+	}
+	if burst == 0 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+	policy.Limiter = altinitycloud.NewLimiter(maxConcurrentRequests, requestsPerSecond, burst)
+	policy.Breaker = altinitycloud.NewCircuitBreaker(threshold, cooldown)
+
+	return policy, diags
+}
+
 func (p *altinityCloudProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data altinityCloudProviderModel
 
@@ -94,72 +362,99 @@ func (p *altinityCloudProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
-	apiToken := os.Getenv(ENV_VAR_API_TOKEN)
-	apiUrl := os.Getenv(ENV_VAR_API_URL)
-	caCrt := data.CACrt.ValueStringPointer()
-
-	// Overwrite env variables with TF config values
-	if !data.ApiToken.IsNull() {
-		apiToken = data.ApiToken.ValueString()
+	apiUrl, apiToken, diags := apiSettings(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Fail early, with a single diagnostic, on a token file or command that
+	// doesn't work.
+	if _, err := apiToken.Token(ctx); err != nil {
+		resp.Diagnostics.AddError("Failed to get Altinity.Cloud API Token", err.Error())
+		return
 	}
 
-	if !data.ApiURL.IsNull() {
-		apiUrl = data.ApiURL.ValueString()
+	readOnly, diags := boolSetting(data.ReadOnly, ENV_VAR_READ_ONLY, "Invalid Read-Only Setting")
+	resp.Diagnostics.Append(diags...)
+	runPreflight, diags := boolSetting(data.Preflight, ENV_VAR_PREFLIGHT, "Invalid Preflight Setting")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Use default value for API URL if is not set
-	if apiUrl == "" {
-		apiUrl = DEFAULT_API_URL
+	auditLogPath := os.Getenv(ENV_VAR_AUDIT_LOG)
+	if !data.AuditLog.IsNull() {
+		auditLogPath = data.AuditLog.ValueString()
 	}
 
-	if apiToken == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_token"),
-			"Missing Altinity.Cloud API Token",
-			fmt.Sprintf("%s environment variable or \"api_token\" provider attribute required.\n"+
-				"See https://github.com/altinity/terraform-provider-altinitycloud for details.", ENV_VAR_API_TOKEN),
-		)
+	transport, diags := transportPolicy(ctx, data.Transport)
+	resp.Diagnostics.Append(diags...)
+	rootCAs, diags := networkSettings(ctx, &data, &transport)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var rootCAs *x509.CertPool
-	if caCrt != nil {
-		var err error
-		rootCAs, err = loadCertPool(*caCrt)
+	opts := []altinitycloud.Option{
+		altinitycloud.WithAPIURL(apiUrl),
+		altinitycloud.WithTokenSource(apiToken),
+		altinitycloud.WithRootCAs(rootCAs),
+		altinitycloud.WithTransportPolicy(transport),
+		altinitycloud.WithReadOnly(readOnly),
+		altinitycloud.WithUserAgent(userAgent(p.version)),
+	}
+	if auditLogPath != "" {
+		auditLog, err := altinitycloud.OpenAuditLog(auditLogPath)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to load CA certificate", err.Error())
+			resp.Diagnostics.AddAttributeError(path.Root("audit_log"), "Failed to open audit log", err.Error())
 			return
 		}
+		opts = append(opts, altinitycloud.WithAuditLog(auditLog))
 	}
 
-	httpClient, err := sdkHttp.NewClient(rootCAs)
+	c, err := altinitycloud.New(opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Failed to configure HTTP client",
+			"Failed to configure Altinity.Cloud client",
 			err.Error(),
 		)
 		return
 	}
+	if runPreflight {
+		resp.Diagnostics.Append(preflight(ctx, c, apiUrl)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	clients := sdk.ClientOf(c)
+	clients.Defaults = defaults(data.Defaults)
+	clients.Policy, diags = policy(ctx, data.Policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	client := client.NewClient(
-		httpClient,
-		apiUrl+GRAPHQL_API_PATH,
-		nil,
-		client.WithRetry(3, 500*time.Millisecond),
-		client.WithBearerAuthorization(ctx, apiToken),
-		client.WithUserAgent(ctx, userAgent(p.version)),
-	)
+	resp.DataSourceData = clients
+	resp.ResourceData = clients
+	resp.EphemeralResourceData = clients
+}
 
-	auth := auth.NewAuth(rootCAs, apiUrl, apiToken)
-	crypto := crypto.NewCrypto(rootCAs, apiUrl)
-	sdk := &sdk.AltinityCloudSDK{
-		Client: client,
-		Auth:   auth,
-		Crypto: crypto,
+// boolSetting returns attribute, else the boolean value of the envVar env var,
+// else false.
+func boolSetting(attribute types.Bool, envVar, summary string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !attribute.IsNull() {
+		return attribute.ValueBool(), diags
 	}
-
-	resp.DataSourceData = sdk
-	resp.ResourceData = sdk
+	v := os.Getenv(envVar)
+	if v == "" {
+		return false, diags
+	}
+	value, err := strconv.ParseBool(v)
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("%s must be a boolean, got %q.", envVar, v))
+	}
+	return value, diags
 }
 
 func (p *altinityCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -175,6 +470,13 @@ func (p *altinityCloudProvider) Resources(ctx context.Context) []func() resource
 	}
 }
 
+func (p *altinityCloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		env_certificate.NewCertificateEphemeralResource,
+		env_secret.NewSecretEphemeralResource,
+	}
+}
+
 func (p *altinityCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		env_aws.NewAWSEnvDataSource,
@@ -201,15 +503,6 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func loadCertPool(cert string) (*x509.CertPool, error) {
-	clientCAs := x509.NewCertPool()
-	ok := clientCAs.AppendCertsFromPEM([]byte(cert))
-	if !ok {
-		return nil, errors.New("pem: failed to append certificates")
-	}
-	return clientCAs, nil
-}
-
 func userAgent(version string) string {
 	return fmt.Sprintf("%s/%s (%s; %s) go/%s",
 		"terraform",
@@ -1,6 +1,7 @@
-// Package schematest asserts that a resource/data source model struct matches
-// its schema. A mismatch is invisible at compile time and crashes every plan or
-// read with "mismatch between struct and object", so every pair gets a test.
+// Package schematest asserts that a resource/data source/ephemeral resource
+// model struct matches its schema. A mismatch is invisible at compile time and
+// crashes every plan or read with "mismatch between struct and object", so
+// every pair gets a test.
 package schematest
 
 import (
@@ -9,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -22,6 +24,10 @@ type SchemaDataSource interface {
 	Schema(context.Context, datasource.SchemaRequest, *datasource.SchemaResponse)
 }
 
+type SchemaEphemeralResource interface {
+	Schema(context.Context, ephemeral.SchemaRequest, *ephemeral.SchemaResponse)
+}
+
 func AssertResourceModelMatchesSchema(t *testing.T, r SchemaResource, model any) {
 	t.Helper()
 	ctx := context.Background()
@@ -48,6 +54,19 @@ func AssertDataSourceModelMatchesSchema(t *testing.T, d SchemaDataSource, model
 	assertNoDiags(t, state.Get(ctx, model))
 }
 
+func AssertEphemeralResourceModelMatchesSchema(t *testing.T, r SchemaEphemeralResource, model any) {
+	t.Helper()
+	ctx := context.Background()
+
+	resp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, resp)
+	assertNoDiags(t, resp.Diagnostics)
+	assertNoDiags(t, resp.Schema.ValidateImplementation(ctx))
+
+	result := tfsdk.EphemeralResultData{Schema: resp.Schema, Raw: populated(t, resp.Schema.Type().TerraformType(ctx))}
+	assertNoDiags(t, result.Get(ctx, model))
+}
+
 // Containers are built known and non-empty on purpose: the framework stops
 // descending at a null value, so an all-null fixture would only ever check the
 // top-level attributes and miss a mismatch inside a nested model.
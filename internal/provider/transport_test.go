@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransportPolicyLimitsAreOptIn(t *testing.T) {
+	policy, diags := transportPolicy(context.Background(), nil)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if policy.Breaker != nil {
+		t.Error("expected the circuit breaker to be disabled by default")
+	}
+	// More requests than Terraform's default parallelism must not queue up.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 20; i++ {
+		if _, err := policy.Limiter.Acquire(ctx); err != nil {
+			t.Fatalf("request %d waited for a slot: %v", i, err)
+		}
+	}
+
+	policy, diags = transportPolicy(context.Background(), &transportModel{
+		MaxConcurrentRequests:   types.Int64Value(2),
+		CircuitBreakerThreshold: types.Int64Value(10),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if policy.Breaker == nil {
+		t.Error("expected the circuit breaker to be enabled")
+	}
+	short, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	for i := 0; i < 2; i++ {
+		if _, err := policy.Limiter.Acquire(short); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := policy.Limiter.Acquire(short); err == nil {
+		t.Error("expected a third request to wait for a free slot")
+	}
+}
@@ -0,0 +1,42 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type durationValidator struct{}
+
+// Duration returns a validator that checks the value is a positive duration
+// parsed by time.ParseDuration, e.g. "720h".
+func Duration() validator.String {
+	return &durationValidator{}
+}
+
+func (v *durationValidator) Description(_ context.Context) string {
+	return "must be a positive duration such as \"720h\""
+}
+
+func (v *durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("%q is not a positive duration such as \"720h\" or \"168h30m\".", value),
+		)
+	}
+}
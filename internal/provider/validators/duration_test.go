@@ -0,0 +1,59 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value     types.String
+		expectErr bool
+	}{
+		"hours": {
+			value: types.StringValue("720h"), expectErr: false,
+		},
+		"hours and minutes": {
+			value: types.StringValue("168h30m"), expectErr: false,
+		},
+		"zero": {
+			value: types.StringValue("0s"), expectErr: true,
+		},
+		"negative": {
+			value: types.StringValue("-1h"), expectErr: true,
+		},
+		"days": {
+			value: types.StringValue("30d"), expectErr: true,
+		},
+		"null": {
+			value: types.StringNull(), expectErr: false,
+		},
+		"unknown": {
+			value: types.StringUnknown(), expectErr: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: tc.value,
+			}
+			resp := &validator.StringResponse{}
+			Duration().ValidateString(context.Background(), req, resp)
+			if tc.expectErr && !resp.Diagnostics.HasError() {
+				t.Error("expected error, got none")
+			}
+			if !tc.expectErr && resp.Diagnostics.HasError() {
+				t.Errorf("unexpected error: %s", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package auth
 
 import (
-	"bytes"
 	"context"
 	"crypto"
 	"crypto/rand"
@@ -11,25 +10,42 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/credentials"
 	sdkCrypto "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/crypto"
 	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
 )
 
 type Auth struct {
-	RootCAs  *x509.CertPool
-	URL      string
-	APIToken string
+	RootCAs *x509.CertPool
+	URL     string
+	// Token is asked for the API token before every signing request.
+	Token credentials.TokenSource
+	// ReadOnly blocks certificate issuance, mirroring client.WithReadOnly.
+	ReadOnly bool
+	// Audit, when set, records every certificate signing request.
+	Audit *client.AuditLog
+	// Transport times out and retries the signing request.
+	Transport sdkHttp.TransportPolicy
+
+	clients sdkHttp.ClientPool
 }
 
-func NewAuth(rootCAs *x509.CertPool, authUrl string, apiToken string) *Auth {
+func NewAuth(rootCAs *x509.CertPool, authUrl string, token credentials.TokenSource) *Auth {
 	return &Auth{
-		RootCAs:  rootCAs,
-		URL:      authUrl,
-		APIToken: apiToken,
+		RootCAs:   rootCAs,
+		URL:       authUrl,
+		Token:     token,
+		Transport: sdkHttp.DefaultTransportPolicy(),
 	}
 }
 
 func (a *Auth) GenerateCertificate(ctx context.Context, envName string) (string, string, error) {
+	// Checked before generating the key, which is the slow part.
+	if a.ReadOnly {
+		return "", "", &client.ReadOnlyError{Operation: "POST " + sdkHttp.SanitizeRequestURL(fmt.Sprintf("%s/sign", a.URL))}
+	}
 	key, err := rsa.GenerateKey(rand.Reader, 4096)
 	if err != nil {
 		return "", "", err
@@ -38,7 +54,16 @@ func (a *Auth) GenerateCertificate(ctx context.Context, envName string) (string,
 	if err != nil {
 		return "", "", err
 	}
-	certPEM, err := a.signCertificateRequest(ctx, csrPEM)
+	spanCtx, span := tracing.Start(ctx, "SignCertificate", tracing.AttrEnvName.String(envName), tracing.AttrOperation.String("SignCertificate"))
+	certPEM, err := a.signCertificateRequest(spanCtx, csrPEM)
+	tracing.End(span, err)
+	if a.Audit != nil {
+		record := client.AuditRecord{Operation: "SignCertificate", EnvName: envName}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		a.Audit.RecordOrWarn(ctx, record)
+	}
 	if err != nil {
 		return "", "", err
 	}
@@ -51,21 +76,27 @@ func (a *Auth) GenerateCertificate(ctx context.Context, envName string) (string,
 }
 
 func (a *Auth) signCertificateRequest(ctx context.Context, csrPEM []byte) ([]byte, error) {
-	httpClient, err := sdkHttp.NewClient(a.RootCAs)
+	httpClient, err := a.clients.Client(a.Transport, a.RootCAs, nil)
 	if err != nil {
 		return nil, err
 	}
+	token, err := a.Token.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get API token: %w", err)
+	}
 	url := fmt.Sprintf("%s/sign", a.URL)
 	headers := map[string]string{}
-	headers["Authorization"] = fmt.Sprintf("Bearer %s", a.APIToken)
+	headers["Authorization"] = fmt.Sprintf("Bearer %s", token)
 
-	body, err := sdkHttp.Do(
+	// Signing the same CSR twice is harmless, so the request is safe to retry.
+	body, err := sdkHttp.DoWithRetry(
 		ctx,
 		httpClient,
+		a.Transport,
 		http.MethodPost,
 		url,
 		headers,
-		bytes.NewReader(csrPEM),
+		csrPEM,
 	)
 	if err != nil {
 		return nil, err
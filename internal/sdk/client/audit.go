@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AuditRecord is one JSON line of the audit log.
+type AuditRecord struct {
+	Timestamp    time.Time   `json:"timestamp"`
+	Operation    string      `json:"operation"`
+	EnvName      string      `json:"envName,omitempty"`
+	MutationID   string      `json:"mutationId,omitempty"`
+	SpecRevision *int64      `json:"specRevision,omitempty"`
+	Variables    interface{} `json:"variables,omitempty"`
+	Error        string      `json:"error,omitempty"`
+}
+
+// AuditLog appends AuditRecords as JSON lines to a local file.
+type AuditLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewAuditLog opens (creating if needed) the audit log at path, so a bad path
+// fails at configure time rather than after a mutation already ran.
+func NewAuditLog(path string) (*AuditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	return &AuditLog{path: path}, nil
+}
+
+// Record appends record as a single JSON line. A missing timestamp is set to
+// the current time.
+func (l *AuditLog) Record(record AuditRecord) error {
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now().UTC()
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("encode audit record: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	return f.Close()
+}
+
+// RecordOrWarn records and logs a warning on failure. The audited call has
+// already happened by then, so failing it would only leave Terraform state
+// behind the server.
+func (l *AuditLog) RecordOrWarn(ctx context.Context, record AuditRecord) {
+	if err := l.Record(record); err != nil {
+		tflog.Warn(ctx, "unable to write audit record", map[string]interface{}{"operation": record.Operation, "error": err.Error()})
+	}
+}
+
+// WithAudit records every mutation in log, whether it succeeded or not, with
+// the env name, the returned mutationId and spec revision, and redacted
+// variables. Queries are not recorded.
+func WithAudit(log *AuditLog) clientv2.RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
+		if !isMutation(gqlInfo) {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		err := next(ctx, req, gqlInfo, res)
+
+		record := AuditRecord{Operation: strings.TrimPrefix(operationName(gqlInfo), "mutation ")}
+		if gqlInfo != nil && gqlInfo.Request != nil {
+			variables := redactVariables(gqlInfo.Request.Variables)
+			record.Variables = variables
+			record.EnvName = auditEnvName(variables)
+		}
+		if err != nil {
+			record.Error = err.Error()
+		} else {
+			record.MutationID, record.SpecRevision = auditResult(res)
+		}
+		log.RecordOrWarn(ctx, record)
+
+		return err
+	}
+}
+
+// auditEnvName finds the env name mutations take as input.name.
+func auditEnvName(variables interface{}) string {
+	vars, _ := variables.(map[string]interface{})
+	input, _ := vars["input"].(map[string]interface{})
+	name, _ := input["name"].(string)
+	return name
+}
+
+// auditResult extracts mutationId and specRevision from a mutation response,
+// wherever the operation nests them.
+func auditResult(res interface{}) (string, *int64) {
+	raw, err := json.Marshal(res)
+	if err != nil {
+		return "", nil
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return "", nil
+	}
+
+	var mutationID string
+	var specRevision *int64
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		fields, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for k, child := range fields {
+			switch k {
+			case "mutationId":
+				if id, ok := child.(string); ok && mutationID == "" {
+					mutationID = id
+				}
+			case "specRevision":
+				if rev, ok := child.(float64); ok && specRevision == nil {
+					r := int64(rev)
+					specRevision = &r
+				}
+			default:
+				walk(child)
+			}
+		}
+	}
+	walk(generic)
+
+	return mutationID, specRevision
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+)
+
+func readAuditLog(t *testing.T, path string) []map[string]interface{} {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open audit log: %v", err)
+	}
+	defer f.Close()
+
+	var records []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("audit line is not JSON: %q", scanner.Text())
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestWithAudit_RecordsMutation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log, err := NewAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := WithAudit(log)
+
+	gqlInfo := &clientv2.GQLRequestInfo{Request: &clientv2.Request{
+		Query:         "mutation UpdateAWSEnv ($input: UpdateAWSEnvInput!) { updateAWSEnv }",
+		OperationName: "UpdateAWSEnv",
+		Variables: map[string]any{"input": map[string]any{
+			"name": "acme-prod",
+			"spec": map[string]any{"datadog": map[string]any{"enabled": true, "encApiKey": "s3cr3t"}},
+		}},
+	}}
+	res := &UpdateAWSEnv{UpdateAWSEnv: UpdateAWSEnv_UpdateAWSEnv{MutationID: "m-123", SpecRevision: 7}}
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		return nil
+	}
+
+	if err := interceptor(context.Background(), &http.Request{}, gqlInfo, res, next); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records := readAuditLog(t, path)
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	record := records[0]
+	if record["operation"] != "UpdateAWSEnv" || record["envName"] != "acme-prod" || record["mutationId"] != "m-123" || record["specRevision"] != float64(7) {
+		t.Errorf("unexpected record: %v", record)
+	}
+	if record["timestamp"] == nil {
+		t.Error("expected a timestamp")
+	}
+	raw, _ := json.Marshal(record["variables"])
+	if strings.Contains(string(raw), "s3cr3t") || !strings.Contains(string(raw), redacted) {
+		t.Errorf("expected encApiKey to be redacted, got %s", raw)
+	}
+}
+
+func TestWithAudit_RecordsFailedMutation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log, err := NewAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := WithAudit(log)
+
+	gqlInfo := &clientv2.GQLRequestInfo{Request: &clientv2.Request{Query: "mutation DeleteAWSEnv { deleteAWSEnv }", OperationName: "DeleteAWSEnv"}}
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		return errors.New("connection reset by peer")
+	}
+
+	if err := interceptor(context.Background(), &http.Request{}, gqlInfo, nil, next); err == nil {
+		t.Fatal("expected the mutation error to be returned")
+	}
+
+	records := readAuditLog(t, path)
+	if len(records) != 1 || records[0]["error"] != "connection reset by peer" {
+		t.Errorf("expected the failure to be recorded, got %v", records)
+	}
+}
+
+func TestWithAudit_SkipsQueries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	log, err := NewAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := WithAudit(log)
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		return nil
+	}
+
+	if err := interceptor(context.Background(), &http.Request{}, queryInfo(), nil, next); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if records := readAuditLog(t, path); len(records) != 0 {
+		t.Errorf("expected no records for queries, got %v", records)
+	}
+}
+
+func TestNewAuditLog_InvalidPath(t *testing.T) {
+	if _, err := NewAuditLog(filepath.Join(t.TempDir(), "missing", "audit.jsonl")); err == nil {
+		t.Error("expected an error for a path in a missing directory")
+	}
+}
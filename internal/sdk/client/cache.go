@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+)
+
+// DefaultQueryCacheTTL is how long a query response is reused. It only has to
+// cover the burst of reads a single plan or apply makes for the same env.
+const DefaultQueryCacheTTL = 5 * time.Second
+
+// QueryCache is a short-lived, per-provider cache of GraphQL query responses.
+// Identical concurrent queries are sent once (single-flight) and the response
+// is reused for the TTL. Any mutation invalidates the entries of its env, or
+// every entry when the env can't be told from the variables.
+type QueryCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// generation is bumped by every invalidation, so a response fetched while
+	// a mutation ran is handed to its waiters but not stored.
+	generation map[string]uint64
+	global     uint64
+}
+
+type cacheEntry struct {
+	envName string
+	done    chan struct{}
+	// waiters counts the callers waiting for done; the last one to give up
+	// cancels the fetch. Guarded by QueryCache.mu.
+	waiters int
+	cancel  context.CancelFunc
+	// Set once done is closed.
+	value   []byte
+	err     error
+	expires time.Time
+}
+
+// NewQueryCache returns an empty cache whose responses live for ttl.
+func NewQueryCache(ttl time.Duration) *QueryCache {
+	return &QueryCache{
+		ttl:        ttl,
+		now:        time.Now,
+		entries:    map[string]*cacheEntry{},
+		generation: map[string]uint64{},
+	}
+}
+
+// Do returns the value cached under key or, on a miss, runs fetch, sharing the
+// call with concurrent callers of the same key. The value is stored for maxAge
+// (the cache TTL if 0) unless fetch fails or envName is invalidated meanwhile.
+// Values are JSON-encoded so every caller gets its own copy.
+//
+// fetch runs on a context detached from the callers' cancellation, so one
+// caller giving up doesn't fail the others: each caller returns on its own ctx,
+// and fetch is only cancelled once every caller has given up.
+func (c *QueryCache) Do(ctx context.Context, key, envName string, maxAge time.Duration, fetch func(ctx context.Context) (interface{}, error), out interface{}) error {
+	if maxAge == 0 {
+		maxAge = c.ttl
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		select {
+		case <-entry.done:
+			if c.now().Before(entry.expires) {
+				c.mu.Unlock()
+				return json.Unmarshal(entry.value, out)
+			}
+		default:
+			entry.waiters++
+			c.mu.Unlock()
+			return c.wait(ctx, key, entry, out)
+		}
+	}
+	fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	entry := &cacheEntry{envName: envName, done: make(chan struct{}), waiters: 1, cancel: cancel}
+	c.entries[key] = entry
+	generation := c.generationOf(envName)
+	c.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		value, err := fetch(fetchCtx)
+		if err == nil {
+			entry.value, err = json.Marshal(value)
+		}
+		entry.err = err
+
+		c.mu.Lock()
+		if err != nil || c.generationOf(envName) != generation {
+			if c.entries[key] == entry {
+				delete(c.entries, key)
+			}
+		} else {
+			entry.expires = c.now().Add(maxAge)
+		}
+		close(entry.done)
+		c.mu.Unlock()
+	}()
+
+	return c.wait(ctx, key, entry, out)
+}
+
+// wait returns the result of entry's fetch, or ctx's error if ctx is done
+// first.
+func (c *QueryCache) wait(ctx context.Context, key string, entry *cacheEntry, out interface{}) error {
+	select {
+	case <-entry.done:
+		if entry.err != nil {
+			return entry.err
+		}
+		return json.Unmarshal(entry.value, out)
+	case <-ctx.Done():
+		c.mu.Lock()
+		entry.waiters--
+		if entry.waiters == 0 {
+			// Nobody wants the response anymore: a later caller starts afresh
+			// rather than joining a cancelled fetch.
+			entry.cancel()
+			if c.entries[key] == entry {
+				delete(c.entries, key)
+			}
+		}
+		c.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func (c *QueryCache) generationOf(envName string) uint64 {
+	return c.global + c.generation[envName]
+}
+
+// Invalidate drops the entries of envName, or all entries if envName is "".
+func (c *QueryCache) Invalidate(envName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if envName == "" {
+		c.global++
+		c.entries = map[string]*cacheEntry{}
+		return
+	}
+	c.generation[envName]++
+	for key, entry := range c.entries {
+		if entry.envName == envName {
+			delete(c.entries, key)
+		}
+	}
+}
+
+type bypassQueryCacheKey struct{}
+
+// WithoutQueryCache returns a context whose queries are sent to the API even if
+// a cached response exists, e.g. for checks that must see the current server
+// state rather than a response up to a TTL old.
+func WithoutQueryCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassQueryCacheKey{}, true)
+}
+
+func queryCacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassQueryCacheKey{}).(bool)
+	return bypass
+}
+
+// WithQueryCache serves queries from cache and invalidates it after every
+// mutation, whether or not the mutation succeeded. Queries with a context from
+// WithoutQueryCache skip it.
+func WithQueryCache(cache *QueryCache) clientv2.RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
+		if isMutation(gqlInfo) {
+			err := next(ctx, req, gqlInfo, res)
+			var envName string
+			if gqlInfo != nil && gqlInfo.Request != nil {
+				envName = variablesEnvName(gqlInfo.Request.Variables)
+			}
+			cache.Invalidate(envName)
+			return err
+		}
+
+		if queryCacheBypassed(ctx) || reflect.TypeOf(res) == nil || reflect.TypeOf(res).Kind() != reflect.Pointer {
+			return next(ctx, req, gqlInfo, res)
+		}
+		key, err := json.Marshal(gqlInfo.Request)
+		if err != nil {
+			return next(ctx, req, gqlInfo, res)
+		}
+		return cache.Do(ctx, string(key), variablesEnvName(gqlInfo.Request.Variables), 0, func(ctx context.Context) (interface{}, error) {
+			// The shared fetch may outlive this caller, so it decodes into its
+			// own value rather than res.
+			shared := reflect.New(reflect.TypeOf(res).Elem()).Interface()
+			if err := next(ctx, req.WithContext(ctx), gqlInfo, shared); err != nil {
+				return nil, err
+			}
+			return shared, nil
+		}, res)
+	}
+}
+
+// variablesEnvName returns the env an operation is about: the name variable
+// of env queries or input.name of env mutations.
+func variablesEnvName(variables map[string]any) string {
+	raw, err := json.Marshal(variables)
+	if err != nil {
+		return ""
+	}
+	var vars struct {
+		Name  string `json:"name"`
+		Input struct {
+			Name string `json:"name"`
+		} `json:"input"`
+	}
+	if err := json.Unmarshal(raw, &vars); err != nil {
+		return ""
+	}
+	if vars.Name != "" {
+		return vars.Name
+	}
+	return vars.Input.Name
+}
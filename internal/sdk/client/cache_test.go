@@ -0,0 +1,306 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+)
+
+type cachedEnv struct {
+	Name         string `json:"name"`
+	SpecRevision int64  `json:"specRevision"`
+}
+
+func envQueryInfo(name string) *clientv2.GQLRequestInfo {
+	return &clientv2.GQLRequestInfo{Request: &clientv2.Request{
+		Query:         "query GetAWSEnv($name: String!) { awsEnv(name: $name) { name } }",
+		OperationName: "GetAWSEnv",
+		Variables:     map[string]any{"name": name},
+	}}
+}
+
+func envMutationInfo(name string) *clientv2.GQLRequestInfo {
+	return &clientv2.GQLRequestInfo{Request: &clientv2.Request{
+		Query:         "mutation UpdateAWSEnv($input: UpdateAWSEnvInput!) { updateAWSEnv(input: $input) { mutationId } }",
+		OperationName: "UpdateAWSEnv",
+		Variables:     map[string]any{"input": map[string]any{"name": name}},
+	}}
+}
+
+func TestWithQueryCache_ReusesResponses(t *testing.T) {
+	t.Parallel()
+	cache := NewQueryCache(time.Minute)
+	interceptor := WithQueryCache(cache)
+
+	var calls atomic.Int32
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		calls.Add(1)
+		*res.(*cachedEnv) = cachedEnv{Name: gqlInfo.Request.Variables["name"].(string), SpecRevision: int64(calls.Load())}
+		return nil
+	}
+
+	for i := 0; i < 3; i++ {
+		var res cachedEnv
+		if err := interceptor(context.Background(), &http.Request{}, envQueryInfo("acme"), &res, next); err != nil {
+			t.Fatal(err)
+		}
+		if res.Name != "acme" || res.SpecRevision != 1 {
+			t.Fatalf("unexpected response %+v", res)
+		}
+	}
+	var other cachedEnv
+	if err := interceptor(context.Background(), &http.Request{}, envQueryInfo("other"), &other, next); err != nil {
+		t.Fatal(err)
+	}
+	if other.Name != "other" || calls.Load() != 2 {
+		t.Errorf("expected a separate query for another env, got %+v after %d calls", other, calls.Load())
+	}
+}
+
+func TestWithQueryCache_SingleFlight(t *testing.T) {
+	t.Parallel()
+	cache := NewQueryCache(time.Minute)
+	interceptor := WithQueryCache(cache)
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		calls.Add(1)
+		<-release
+		*res.(*cachedEnv) = cachedEnv{Name: "acme", SpecRevision: 7}
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]cachedEnv, 5)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := interceptor(context.Background(), &http.Request{}, envQueryInfo("acme"), &results[i], next); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("expected concurrent identical queries to be sent once, got %d", calls.Load())
+	}
+	for i, res := range results {
+		if res.SpecRevision != 7 {
+			t.Errorf("caller %d got %+v", i, res)
+		}
+	}
+}
+
+func TestWithQueryCache_CancelledCaller(t *testing.T) {
+	t.Parallel()
+	interceptor := WithQueryCache(NewQueryCache(time.Minute))
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		calls.Add(1)
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		*res.(*cachedEnv) = cachedEnv{Name: "acme", SpecRevision: 7}
+		return nil
+	}
+
+	// The first caller starts the shared fetch and gives up while it runs.
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	var first cachedEnv
+	go func() {
+		firstErr <- interceptor(ctx, &http.Request{}, envQueryInfo("acme"), &first, next)
+	}()
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	secondErr := make(chan error)
+	var second cachedEnv
+	go func() {
+		secondErr <- interceptor(context.Background(), &http.Request{}, envQueryInfo("acme"), &second, next)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancelled caller to return its context error, got %v", err)
+	}
+
+	close(release)
+	if err := <-secondErr; err != nil {
+		t.Fatalf("expected the other caller to get the response, got %v", err)
+	}
+	if calls.Load() != 1 || second.SpecRevision != 7 {
+		t.Errorf("expected one shared query, got %+v after %d calls", second, calls.Load())
+	}
+	if first != (cachedEnv{}) {
+		t.Errorf("the cancelled caller's response must not be written to, got %+v", first)
+	}
+}
+
+func TestWithQueryCache_Bypass(t *testing.T) {
+	t.Parallel()
+	interceptor := WithQueryCache(NewQueryCache(time.Minute))
+
+	var calls atomic.Int32
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		calls.Add(1)
+		*res.(*cachedEnv) = cachedEnv{Name: "acme", SpecRevision: int64(calls.Load())}
+		return nil
+	}
+
+	var res cachedEnv
+	if err := interceptor(context.Background(), &http.Request{}, envQueryInfo("acme"), &res, next); err != nil {
+		t.Fatal(err)
+	}
+	if err := interceptor(WithoutQueryCache(context.Background()), &http.Request{}, envQueryInfo("acme"), &res, next); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 2 || res.SpecRevision != 2 {
+		t.Errorf("expected the bypassing query to be sent, got %+v after %d calls", res, calls.Load())
+	}
+}
+
+func TestWithQueryCache_ErrorsAreNotCached(t *testing.T) {
+	t.Parallel()
+	interceptor := WithQueryCache(NewQueryCache(time.Minute))
+
+	calls := 0
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		calls++
+		if calls == 1 {
+			return errors.New("connection reset")
+		}
+		return nil
+	}
+
+	var res cachedEnv
+	if err := interceptor(context.Background(), &http.Request{}, envQueryInfo("acme"), &res, next); err == nil {
+		t.Fatal("expected the first error")
+	}
+	if err := interceptor(context.Background(), &http.Request{}, envQueryInfo("acme"), &res, next); err != nil {
+		t.Fatalf("expected the query to be sent again, got %v", err)
+	}
+}
+
+func TestWithQueryCache_MutationInvalidatesEnv(t *testing.T) {
+	t.Parallel()
+	cache := NewQueryCache(time.Minute)
+	interceptor := WithQueryCache(cache)
+
+	queries := map[string]int{}
+	var mu sync.Mutex
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		if isMutation(gqlInfo) {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		queries[gqlInfo.Request.Variables["name"].(string)]++
+		return nil
+	}
+
+	read := func(name string) {
+		var res cachedEnv
+		if err := interceptor(context.Background(), &http.Request{}, envQueryInfo(name), &res, next); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	read("acme")
+	read("other")
+	if err := interceptor(context.Background(), &http.Request{}, envMutationInfo("acme"), &cachedEnv{}, next); err != nil {
+		t.Fatal(err)
+	}
+	read("acme")
+	read("other")
+
+	if queries["acme"] != 2 {
+		t.Errorf("expected the mutated env to be read again, got %d queries", queries["acme"])
+	}
+	if queries["other"] != 1 {
+		t.Errorf("expected other envs to stay cached, got %d queries", queries["other"])
+	}
+}
+
+func TestQueryCache_Expiry(t *testing.T) {
+	t.Parallel()
+	cache := NewQueryCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	calls := 0
+	fetch := func(ctx context.Context) (interface{}, error) {
+		calls++
+		return cachedEnv{SpecRevision: int64(calls)}, nil
+	}
+
+	var res cachedEnv
+	_ = cache.Do(context.Background(), "k", "acme", time.Second, fetch, &res)
+	_ = cache.Do(context.Background(), "k", "acme", time.Second, fetch, &res)
+	if calls != 1 {
+		t.Fatalf("expected a cache hit, got %d calls", calls)
+	}
+	now = now.Add(2 * time.Second)
+	_ = cache.Do(context.Background(), "k", "acme", time.Second, fetch, &res)
+	if calls != 2 || res.SpecRevision != 2 {
+		t.Errorf("expected an expired entry to be fetched again, got %+v after %d calls", res, calls)
+	}
+}
+
+func TestQueryCache_InvalidationDuringFetch(t *testing.T) {
+	t.Parallel()
+	cache := NewQueryCache(time.Minute)
+
+	calls := 0
+	fetch := func(ctx context.Context) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			// A mutation completes while the first read is in flight.
+			cache.Invalidate("acme")
+		}
+		return cachedEnv{SpecRevision: int64(calls)}, nil
+	}
+
+	var res cachedEnv
+	_ = cache.Do(context.Background(), "k", "acme", 0, fetch, &res)
+	_ = cache.Do(context.Background(), "k", "acme", 0, fetch, &res)
+	if calls != 2 || res.SpecRevision != 2 {
+		t.Errorf("a response fetched across a mutation must not be cached, got %+v after %d calls", res, calls)
+	}
+}
+
+func TestVariablesEnvName(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		variables map[string]any
+		want      string
+	}{
+		"query name":     {variables: map[string]any{"name": "acme"}, want: "acme"},
+		"mutation input": {variables: map[string]any{"input": DeleteAWSEnvInput{Name: "acme"}}, want: "acme"},
+		"no env":         {variables: map[string]any{"limit": 10}},
+		"no variables":   {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := variablesEnvName(tc.variables); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
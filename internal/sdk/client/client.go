@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/credentials"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
 )
 
 func WithBearerAuthorization(ctx context.Context, token string) clientv2.RequestInterceptor {
@@ -20,35 +22,34 @@ func WithBearerAuthorization(ctx context.Context, token string) clientv2.Request
 	}
 }
 
-func WithUserAgent(ctx context.Context, userAgent string) clientv2.RequestInterceptor {
+// WithTokenSource sets the Authorization header to the token of source, asked
+// before every attempt so that rotated or refreshed tokens are picked up.
+func WithTokenSource(source credentials.TokenSource) clientv2.RequestInterceptor {
 	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
-		req.Header.Set("User-Agent", userAgent)
+		token, err := source.Token(ctx)
+		if err != nil {
+			return fmt.Errorf("get API token: %w", err)
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 		return next(ctx, req, gqlInfo, res)
 	}
 }
 
-// retryableNetworkCodes are HTTP status codes that indicate a transient
-// server-side failure worth retrying.
-var retryableNetworkCodes = map[int]bool{
-	429: true,
-	502: true,
-	503: true,
-	504: true,
+func WithUserAgent(ctx context.Context, userAgent string) clientv2.RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
+		req.Header.Set("User-Agent", userAgent)
+		return next(ctx, req, gqlInfo, res)
+	}
 }
 
-// transportPatterns match transport-level errors (no HTTP response received),
-// which clientv2 surfaces as a plain wrapped error rather than an
-// *ErrorResponse, so there is no status code to inspect.
-var transportPatterns = []string{
-	"connection reset",
-	"connection refused",
-	"i/o timeout",
-	"TLS handshake timeout",
-	"EOF",
+// isRetryable classifies err with the default transport policy.
+func isRetryable(err error) bool {
+	return isRetryableWith(sdkHttp.DefaultTransportPolicy(), err)
 }
 
-func isRetryable(err error) bool {
-	if err == nil {
+func isRetryableWith(policy sdkHttp.TransportPolicy, err error) bool {
+	// An open circuit breaker fails fast; retrying would only wait for it.
+	if err == nil || errors.Is(err, sdkHttp.ErrCircuitOpen) {
 		return false
 	}
 
@@ -57,17 +58,30 @@ func isRetryable(err error) bool {
 	// message text happens to contain a code or "EOF".
 	var errResp *clientv2.ErrorResponse
 	if errors.As(err, &errResp) {
-		return errResp.NetworkError != nil && retryableNetworkCodes[errResp.NetworkError.Code]
+		return errResp.NetworkError != nil && policy.RetryableStatus(errResp.NetworkError.Code)
 	}
 
-	// No HTTP response: classify the transport error by its message.
-	msg := err.Error()
-	for _, pattern := range transportPatterns {
-		if strings.Contains(msg, pattern) {
-			return true
-		}
+	// No HTTP response: only retry transient transport errors.
+	return sdkHttp.IsTransientTransportError(err)
+}
+
+// IsTransportError reports whether err happened before an HTTP response was
+// received (connection reset, timeout, ...). For a mutation this means the
+// outcome is unknown: the server may have committed it and the response was
+// lost.
+func IsTransportError(err error) bool {
+	// Refused by the circuit breaker: the request was never sent.
+	if err == nil || errors.Is(err, sdkHttp.ErrCircuitOpen) {
+		return false
 	}
-	return false
+	var errResp *clientv2.ErrorResponse
+	if errors.As(err, &errResp) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || strings.Contains(err.Error(), "Client.Timeout exceeded") {
+		return true
+	}
+	return sdkHttp.IsTransientTransportError(err)
 }
 
 // isMutation reports whether the GraphQL operation mutates server state. Such
@@ -100,7 +114,55 @@ func operationStart(query string) string {
 	}
 }
 
+// ErrReadOnly is matched (via errors.Is) by every ReadOnlyError.
+var ErrReadOnly = errors.New("read-only mode")
+
+// ReadOnlyError is returned instead of running an operation that would mutate
+// server state while the provider is in read-only mode.
+type ReadOnlyError struct {
+	Operation string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("%s: refusing to run %s because the provider is configured with read_only", ErrReadOnly, e.Operation)
+}
+
+func (e *ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
+
+// WithReadOnly rejects every operation isMutation classifies as a mutation,
+// before any request is sent.
+func WithReadOnly() clientv2.RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
+		if isMutation(gqlInfo) {
+			return &ReadOnlyError{Operation: operationName(gqlInfo)}
+		}
+		return next(ctx, req, gqlInfo, res)
+	}
+}
+
+func operationName(gqlInfo *clientv2.GQLRequestInfo) string {
+	if gqlInfo == nil || gqlInfo.Request == nil || gqlInfo.Request.OperationName == "" {
+		return "mutation"
+	}
+	return fmt.Sprintf("mutation %s", gqlInfo.Request.OperationName)
+}
+
+// WithRetry retries queries with the default transport policy, overriding
+// its retry count and initial backoff.
 func WithRetry(maxRetries int, initialBackoff time.Duration) clientv2.RequestInterceptor {
+	policy := sdkHttp.DefaultTransportPolicy()
+	policy.MaxRetries = maxRetries
+	policy.InitialBackoff = initialBackoff
+	return WithRetryPolicy(policy)
+}
+
+// WithRetryPolicy retries queries that fail with a transport error or a
+// retryable status code, waiting for the policy backoff or, on 429/503, for the
+// server's Retry-After. The Retry-After header is only seen when the client
+// uses an *http.Client built by sdkHttp.NewClient.
+func WithRetryPolicy(policy sdkHttp.TransportPolicy) clientv2.RequestInterceptor {
 	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
 		// Only idempotent operations (queries) are safe to retry.
 		if isMutation(gqlInfo) {
@@ -121,10 +183,11 @@ func WithRetry(maxRetries int, initialBackoff time.Duration) clientv2.RequestInt
 			}
 		}
 
-		var err error
-		backoff := initialBackoff
+		reqCtx, retryAfter := sdkHttp.RecordRetryAfter(req.Context())
+		req = req.WithContext(reqCtx)
 
-		for attempt := 0; attempt <= maxRetries; attempt++ {
+		var err error
+		for attempt := 0; attempt <= policy.MaxRetries; attempt++ {
 			if req.GetBody != nil {
 				body, bodyErr := req.GetBody()
 				if bodyErr != nil {
@@ -138,21 +201,17 @@ func WithRetry(maxRetries int, initialBackoff time.Duration) clientv2.RequestInt
 				return nil
 			}
 
-			if !isRetryable(err) {
+			if !isRetryableWith(policy, err) {
 				return err
 			}
 
-			if attempt == maxRetries {
+			if attempt == policy.MaxRetries {
 				break
 			}
 
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(backoff):
+			if sleepErr := sdkHttp.Sleep(ctx, policy.Backoff(attempt, retryAfter())); sleepErr != nil {
+				return sleepErr
 			}
-
-			backoff *= 2
 		}
 
 		return err
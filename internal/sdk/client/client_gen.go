@@ -3064,6 +3064,85 @@ func (t *GetAWSEnvStatus_AWSEnv) GetStatus() *GetAWSEnvStatus_AWSEnv_Status {
 	return &t.Status
 }
 
+type ListAWSEnvs_AWSEnvs_Status_Errors struct {
+	Code    EnvStatusErrorCode "json:\"code\" graphql:\"code\""
+	Message string             "json:\"message\" graphql:\"message\""
+}
+
+func (t *ListAWSEnvs_AWSEnvs_Status_Errors) GetCode() *EnvStatusErrorCode {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs_Status_Errors{}
+	}
+	return &t.Code
+}
+func (t *ListAWSEnvs_AWSEnvs_Status_Errors) GetMessage() string {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs_Status_Errors{}
+	}
+	return t.Message
+}
+
+type ListAWSEnvs_AWSEnvs_Status struct {
+	AppliedSpecRevision int64                                "json:\"appliedSpecRevision\" graphql:\"appliedSpecRevision\""
+	Errors              []*ListAWSEnvs_AWSEnvs_Status_Errors "json:\"errors\" graphql:\"errors\""
+	PendingDelete       bool                                 "json:\"pendingDelete\" graphql:\"pendingDelete\""
+}
+
+func (t *ListAWSEnvs_AWSEnvs_Status) GetAppliedSpecRevision() int64 {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs_Status{}
+	}
+	return t.AppliedSpecRevision
+}
+func (t *ListAWSEnvs_AWSEnvs_Status) GetErrors() []*ListAWSEnvs_AWSEnvs_Status_Errors {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs_Status{}
+	}
+	return t.Errors
+}
+func (t *ListAWSEnvs_AWSEnvs_Status) GetPendingDelete() bool {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs_Status{}
+	}
+	return t.PendingDelete
+}
+
+type ListAWSEnvs_AWSEnvs struct {
+	Name         string                     "json:\"name\" graphql:\"name\""
+	SpecRevision int64                      "json:\"specRevision\" graphql:\"specRevision\""
+	Status       ListAWSEnvs_AWSEnvs_Status "json:\"status\" graphql:\"status\""
+}
+
+func (t *ListAWSEnvs_AWSEnvs) GetName() string {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs{}
+	}
+	return t.Name
+}
+func (t *ListAWSEnvs_AWSEnvs) GetSpecRevision() int64 {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs{}
+	}
+	return t.SpecRevision
+}
+func (t *ListAWSEnvs_AWSEnvs) GetStatus() *ListAWSEnvs_AWSEnvs_Status {
+	if t == nil {
+		t = &ListAWSEnvs_AWSEnvs{}
+	}
+	return &t.Status
+}
+
+type CodeGenAWSEnv_CodeGenAWSEnv struct {
+	Terraform string "json:\"terraform\" graphql:\"terraform\""
+}
+
+func (t *CodeGenAWSEnv_CodeGenAWSEnv) GetTerraform() string {
+	if t == nil {
+		t = &CodeGenAWSEnv_CodeGenAWSEnv{}
+	}
+	return t.Terraform
+}
+
 type CreateAWSEnv_CreateAWSEnv_Spec_AWSEnvSpecFragment_LoadBalancers_Public struct {
 	CrossZone      bool     "json:\"crossZone\" graphql:\"crossZone\""
 	Enabled        bool     "json:\"enabled\" graphql:\"enabled\""
@@ -4465,6 +4544,74 @@ func (t *GetAWSEnvHostedStatus_AWSEnvHosted) GetStatus() *GetAWSEnvHostedStatus_
 	return &t.Status
 }
 
+type ListAWSEnvsHosted_AWSEnvsHosted_Status_Errors struct {
+	Code    EnvStatusErrorCode "json:\"code\" graphql:\"code\""
+	Message string             "json:\"message\" graphql:\"message\""
+}
+
+func (t *ListAWSEnvsHosted_AWSEnvsHosted_Status_Errors) GetCode() *EnvStatusErrorCode {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted_Status_Errors{}
+	}
+	return &t.Code
+}
+func (t *ListAWSEnvsHosted_AWSEnvsHosted_Status_Errors) GetMessage() string {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted_Status_Errors{}
+	}
+	return t.Message
+}
+
+type ListAWSEnvsHosted_AWSEnvsHosted_Status struct {
+	AppliedSpecRevision int64                                            "json:\"appliedSpecRevision\" graphql:\"appliedSpecRevision\""
+	Errors              []*ListAWSEnvsHosted_AWSEnvsHosted_Status_Errors "json:\"errors\" graphql:\"errors\""
+	PendingDelete       bool                                             "json:\"pendingDelete\" graphql:\"pendingDelete\""
+}
+
+func (t *ListAWSEnvsHosted_AWSEnvsHosted_Status) GetAppliedSpecRevision() int64 {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted_Status{}
+	}
+	return t.AppliedSpecRevision
+}
+func (t *ListAWSEnvsHosted_AWSEnvsHosted_Status) GetErrors() []*ListAWSEnvsHosted_AWSEnvsHosted_Status_Errors {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted_Status{}
+	}
+	return t.Errors
+}
+func (t *ListAWSEnvsHosted_AWSEnvsHosted_Status) GetPendingDelete() bool {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted_Status{}
+	}
+	return t.PendingDelete
+}
+
+type ListAWSEnvsHosted_AWSEnvsHosted struct {
+	Name         string                                 "json:\"name\" graphql:\"name\""
+	SpecRevision int64                                  "json:\"specRevision\" graphql:\"specRevision\""
+	Status       ListAWSEnvsHosted_AWSEnvsHosted_Status "json:\"status\" graphql:\"status\""
+}
+
+func (t *ListAWSEnvsHosted_AWSEnvsHosted) GetName() string {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted{}
+	}
+	return t.Name
+}
+func (t *ListAWSEnvsHosted_AWSEnvsHosted) GetSpecRevision() int64 {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted{}
+	}
+	return t.SpecRevision
+}
+func (t *ListAWSEnvsHosted_AWSEnvsHosted) GetStatus() *ListAWSEnvsHosted_AWSEnvsHosted_Status {
+	if t == nil {
+		t = &ListAWSEnvsHosted_AWSEnvsHosted{}
+	}
+	return &t.Status
+}
+
 type CreateAWSEnvHosted_CreateAWSEnvHosted_Spec_AWSEnvHostedSpecFragment_LoadBalancers_Public struct {
 	Enabled        bool     "json:\"enabled\" graphql:\"enabled\""
 	SourceIPRanges []string "json:\"sourceIPRanges\" graphql:\"sourceIPRanges\""
@@ -5616,6 +5763,85 @@ func (t *GetAzureEnvStatus_AzureEnv) GetStatus() *GetAzureEnvStatus_AzureEnv_Sta
 	return &t.Status
 }
 
+type ListAzureEnvs_AzureEnvs_Status_Errors struct {
+	Code    EnvStatusErrorCode "json:\"code\" graphql:\"code\""
+	Message string             "json:\"message\" graphql:\"message\""
+}
+
+func (t *ListAzureEnvs_AzureEnvs_Status_Errors) GetCode() *EnvStatusErrorCode {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs_Status_Errors{}
+	}
+	return &t.Code
+}
+func (t *ListAzureEnvs_AzureEnvs_Status_Errors) GetMessage() string {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs_Status_Errors{}
+	}
+	return t.Message
+}
+
+type ListAzureEnvs_AzureEnvs_Status struct {
+	AppliedSpecRevision int64                                    "json:\"appliedSpecRevision\" graphql:\"appliedSpecRevision\""
+	Errors              []*ListAzureEnvs_AzureEnvs_Status_Errors "json:\"errors\" graphql:\"errors\""
+	PendingDelete       bool                                     "json:\"pendingDelete\" graphql:\"pendingDelete\""
+}
+
+func (t *ListAzureEnvs_AzureEnvs_Status) GetAppliedSpecRevision() int64 {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs_Status{}
+	}
+	return t.AppliedSpecRevision
+}
+func (t *ListAzureEnvs_AzureEnvs_Status) GetErrors() []*ListAzureEnvs_AzureEnvs_Status_Errors {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs_Status{}
+	}
+	return t.Errors
+}
+func (t *ListAzureEnvs_AzureEnvs_Status) GetPendingDelete() bool {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs_Status{}
+	}
+	return t.PendingDelete
+}
+
+type ListAzureEnvs_AzureEnvs struct {
+	Name         string                         "json:\"name\" graphql:\"name\""
+	SpecRevision int64                          "json:\"specRevision\" graphql:\"specRevision\""
+	Status       ListAzureEnvs_AzureEnvs_Status "json:\"status\" graphql:\"status\""
+}
+
+func (t *ListAzureEnvs_AzureEnvs) GetName() string {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs{}
+	}
+	return t.Name
+}
+func (t *ListAzureEnvs_AzureEnvs) GetSpecRevision() int64 {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs{}
+	}
+	return t.SpecRevision
+}
+func (t *ListAzureEnvs_AzureEnvs) GetStatus() *ListAzureEnvs_AzureEnvs_Status {
+	if t == nil {
+		t = &ListAzureEnvs_AzureEnvs{}
+	}
+	return &t.Status
+}
+
+type CodeGenAzureEnv_CodeGenAzureEnv struct {
+	Terraform string "json:\"terraform\" graphql:\"terraform\""
+}
+
+func (t *CodeGenAzureEnv_CodeGenAzureEnv) GetTerraform() string {
+	if t == nil {
+		t = &CodeGenAzureEnv_CodeGenAzureEnv{}
+	}
+	return t.Terraform
+}
+
 type CreateAzureEnv_CreateAzureEnv_Spec_AzureEnvSpecFragment_LoadBalancers_Public struct {
 	Enabled        bool     "json:\"enabled\" graphql:\"enabled\""
 	SourceIPRanges []string "json:\"sourceIPRanges\" graphql:\"sourceIPRanges\""
@@ -6417,6 +6643,85 @@ func (t *GetGCPEnvStatus_GCPEnv) GetStatus() *GetGCPEnvStatus_GCPEnv_Status {
 	return &t.Status
 }
 
+type ListGCPEnvs_GCPEnvs_Status_Errors struct {
+	Code    EnvStatusErrorCode "json:\"code\" graphql:\"code\""
+	Message string             "json:\"message\" graphql:\"message\""
+}
+
+func (t *ListGCPEnvs_GCPEnvs_Status_Errors) GetCode() *EnvStatusErrorCode {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs_Status_Errors{}
+	}
+	return &t.Code
+}
+func (t *ListGCPEnvs_GCPEnvs_Status_Errors) GetMessage() string {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs_Status_Errors{}
+	}
+	return t.Message
+}
+
+type ListGCPEnvs_GCPEnvs_Status struct {
+	AppliedSpecRevision int64                                "json:\"appliedSpecRevision\" graphql:\"appliedSpecRevision\""
+	Errors              []*ListGCPEnvs_GCPEnvs_Status_Errors "json:\"errors\" graphql:\"errors\""
+	PendingDelete       bool                                 "json:\"pendingDelete\" graphql:\"pendingDelete\""
+}
+
+func (t *ListGCPEnvs_GCPEnvs_Status) GetAppliedSpecRevision() int64 {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs_Status{}
+	}
+	return t.AppliedSpecRevision
+}
+func (t *ListGCPEnvs_GCPEnvs_Status) GetErrors() []*ListGCPEnvs_GCPEnvs_Status_Errors {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs_Status{}
+	}
+	return t.Errors
+}
+func (t *ListGCPEnvs_GCPEnvs_Status) GetPendingDelete() bool {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs_Status{}
+	}
+	return t.PendingDelete
+}
+
+type ListGCPEnvs_GCPEnvs struct {
+	Name         string                     "json:\"name\" graphql:\"name\""
+	SpecRevision int64                      "json:\"specRevision\" graphql:\"specRevision\""
+	Status       ListGCPEnvs_GCPEnvs_Status "json:\"status\" graphql:\"status\""
+}
+
+func (t *ListGCPEnvs_GCPEnvs) GetName() string {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs{}
+	}
+	return t.Name
+}
+func (t *ListGCPEnvs_GCPEnvs) GetSpecRevision() int64 {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs{}
+	}
+	return t.SpecRevision
+}
+func (t *ListGCPEnvs_GCPEnvs) GetStatus() *ListGCPEnvs_GCPEnvs_Status {
+	if t == nil {
+		t = &ListGCPEnvs_GCPEnvs{}
+	}
+	return &t.Status
+}
+
+type CodeGenGCPEnv_CodeGenGCPEnv struct {
+	Terraform string "json:\"terraform\" graphql:\"terraform\""
+}
+
+func (t *CodeGenGCPEnv_CodeGenGCPEnv) GetTerraform() string {
+	if t == nil {
+		t = &CodeGenGCPEnv_CodeGenGCPEnv{}
+	}
+	return t.Terraform
+}
+
 type CreateGCPEnv_CreateGCPEnv_Spec_GCPEnvSpecFragment_LoadBalancers_Public struct {
 	Enabled        bool     "json:\"enabled\" graphql:\"enabled\""
 	SourceIPRanges []string "json:\"sourceIPRanges\" graphql:\"sourceIPRanges\""
@@ -7221,6 +7526,85 @@ func (t *GetHCloudEnvStatus_HcloudEnv) GetStatus() *GetHCloudEnvStatus_HcloudEnv
 	return &t.Status
 }
 
+type ListHCloudEnvs_HcloudEnvs_Status_Errors struct {
+	Code    EnvStatusErrorCode "json:\"code\" graphql:\"code\""
+	Message string             "json:\"message\" graphql:\"message\""
+}
+
+func (t *ListHCloudEnvs_HcloudEnvs_Status_Errors) GetCode() *EnvStatusErrorCode {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs_Status_Errors{}
+	}
+	return &t.Code
+}
+func (t *ListHCloudEnvs_HcloudEnvs_Status_Errors) GetMessage() string {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs_Status_Errors{}
+	}
+	return t.Message
+}
+
+type ListHCloudEnvs_HcloudEnvs_Status struct {
+	AppliedSpecRevision int64                                      "json:\"appliedSpecRevision\" graphql:\"appliedSpecRevision\""
+	Errors              []*ListHCloudEnvs_HcloudEnvs_Status_Errors "json:\"errors\" graphql:\"errors\""
+	PendingDelete       bool                                       "json:\"pendingDelete\" graphql:\"pendingDelete\""
+}
+
+func (t *ListHCloudEnvs_HcloudEnvs_Status) GetAppliedSpecRevision() int64 {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs_Status{}
+	}
+	return t.AppliedSpecRevision
+}
+func (t *ListHCloudEnvs_HcloudEnvs_Status) GetErrors() []*ListHCloudEnvs_HcloudEnvs_Status_Errors {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs_Status{}
+	}
+	return t.Errors
+}
+func (t *ListHCloudEnvs_HcloudEnvs_Status) GetPendingDelete() bool {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs_Status{}
+	}
+	return t.PendingDelete
+}
+
+type ListHCloudEnvs_HcloudEnvs struct {
+	Name         string                           "json:\"name\" graphql:\"name\""
+	SpecRevision int64                            "json:\"specRevision\" graphql:\"specRevision\""
+	Status       ListHCloudEnvs_HcloudEnvs_Status "json:\"status\" graphql:\"status\""
+}
+
+func (t *ListHCloudEnvs_HcloudEnvs) GetName() string {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs{}
+	}
+	return t.Name
+}
+func (t *ListHCloudEnvs_HcloudEnvs) GetSpecRevision() int64 {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs{}
+	}
+	return t.SpecRevision
+}
+func (t *ListHCloudEnvs_HcloudEnvs) GetStatus() *ListHCloudEnvs_HcloudEnvs_Status {
+	if t == nil {
+		t = &ListHCloudEnvs_HcloudEnvs{}
+	}
+	return &t.Status
+}
+
+type CodeGenHCloudEnv_CodeGenHCloudEnv struct {
+	Terraform string "json:\"terraform\" graphql:\"terraform\""
+}
+
+func (t *CodeGenHCloudEnv_CodeGenHCloudEnv) GetTerraform() string {
+	if t == nil {
+		t = &CodeGenHCloudEnv_CodeGenHCloudEnv{}
+	}
+	return t.Terraform
+}
+
 type CreateHCloudEnv_CreateHCloudEnv_Spec_HCloudEnvSpecFragment_LoadBalancers_Public struct {
 	Enabled        bool     "json:\"enabled\" graphql:\"enabled\""
 	SourceIPRanges []string "json:\"sourceIPRanges\" graphql:\"sourceIPRanges\""
@@ -8136,28 +8520,107 @@ func (t *GetK8SEnvStatus_K8sEnv) GetStatus() *GetK8SEnvStatus_K8sEnv_Status {
 	return &t.Status
 }
 
-type CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations struct {
-	Key   string "json:\"key\" graphql:\"key\""
-	Value string "json:\"value\" graphql:\"value\""
+type ListK8SEnvs_K8sEnvs_Status_Errors struct {
+	Code    EnvStatusErrorCode "json:\"code\" graphql:\"code\""
+	Message string             "json:\"message\" graphql:\"message\""
 }
 
-func (t *CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations) GetKey() string {
+func (t *ListK8SEnvs_K8sEnvs_Status_Errors) GetCode() *EnvStatusErrorCode {
 	if t == nil {
-		t = &CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations{}
+		t = &ListK8SEnvs_K8sEnvs_Status_Errors{}
 	}
-	return t.Key
+	return &t.Code
 }
-func (t *CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations) GetValue() string {
+func (t *ListK8SEnvs_K8sEnvs_Status_Errors) GetMessage() string {
 	if t == nil {
-		t = &CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations{}
+		t = &ListK8SEnvs_K8sEnvs_Status_Errors{}
 	}
-	return t.Value
+	return t.Message
 }
 
-type CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public struct {
-	Annotations    []*CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations "json:\"annotations\" graphql:\"annotations\""
-	Enabled        bool                                                                                  "json:\"enabled\" graphql:\"enabled\""
-	SourceIPRanges []string                                                                              "json:\"sourceIPRanges\" graphql:\"sourceIPRanges\""
+type ListK8SEnvs_K8sEnvs_Status struct {
+	AppliedSpecRevision int64                                "json:\"appliedSpecRevision\" graphql:\"appliedSpecRevision\""
+	Errors              []*ListK8SEnvs_K8sEnvs_Status_Errors "json:\"errors\" graphql:\"errors\""
+	PendingDelete       bool                                 "json:\"pendingDelete\" graphql:\"pendingDelete\""
+}
+
+func (t *ListK8SEnvs_K8sEnvs_Status) GetAppliedSpecRevision() int64 {
+	if t == nil {
+		t = &ListK8SEnvs_K8sEnvs_Status{}
+	}
+	return t.AppliedSpecRevision
+}
+func (t *ListK8SEnvs_K8sEnvs_Status) GetErrors() []*ListK8SEnvs_K8sEnvs_Status_Errors {
+	if t == nil {
+		t = &ListK8SEnvs_K8sEnvs_Status{}
+	}
+	return t.Errors
+}
+func (t *ListK8SEnvs_K8sEnvs_Status) GetPendingDelete() bool {
+	if t == nil {
+		t = &ListK8SEnvs_K8sEnvs_Status{}
+	}
+	return t.PendingDelete
+}
+
+type ListK8SEnvs_K8sEnvs struct {
+	Name         string                     "json:\"name\" graphql:\"name\""
+	SpecRevision int64                      "json:\"specRevision\" graphql:\"specRevision\""
+	Status       ListK8SEnvs_K8sEnvs_Status "json:\"status\" graphql:\"status\""
+}
+
+func (t *ListK8SEnvs_K8sEnvs) GetName() string {
+	if t == nil {
+		t = &ListK8SEnvs_K8sEnvs{}
+	}
+	return t.Name
+}
+func (t *ListK8SEnvs_K8sEnvs) GetSpecRevision() int64 {
+	if t == nil {
+		t = &ListK8SEnvs_K8sEnvs{}
+	}
+	return t.SpecRevision
+}
+func (t *ListK8SEnvs_K8sEnvs) GetStatus() *ListK8SEnvs_K8sEnvs_Status {
+	if t == nil {
+		t = &ListK8SEnvs_K8sEnvs{}
+	}
+	return &t.Status
+}
+
+type CodeGenK8SEnv_CodeGenK8SEnv struct {
+	Terraform string "json:\"terraform\" graphql:\"terraform\""
+}
+
+func (t *CodeGenK8SEnv_CodeGenK8SEnv) GetTerraform() string {
+	if t == nil {
+		t = &CodeGenK8SEnv_CodeGenK8SEnv{}
+	}
+	return t.Terraform
+}
+
+type CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations struct {
+	Key   string "json:\"key\" graphql:\"key\""
+	Value string "json:\"value\" graphql:\"value\""
+}
+
+func (t *CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations) GetKey() string {
+	if t == nil {
+		t = &CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations{}
+	}
+	return t.Key
+}
+func (t *CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations) GetValue() string {
+	if t == nil {
+		t = &CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations{}
+	}
+	return t.Value
+}
+
+type CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public struct {
+	Annotations    []*CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations "json:\"annotations\" graphql:\"annotations\""
+	Enabled        bool                                                                                  "json:\"enabled\" graphql:\"enabled\""
+	SourceIPRanges []string                                                                              "json:\"sourceIPRanges\" graphql:\"sourceIPRanges\""
 }
 
 func (t *CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public) GetAnnotations() []*CreateK8SEnv_CreateK8SEnv_Spec_K8SEnvSpecFragment_LoadBalancers_Public_Annotations {
@@ -8906,6 +9369,28 @@ func (t *GetAWSEnvStatus) GetAWSEnv() *GetAWSEnvStatus_AWSEnv {
 	return t.AWSEnv
 }
 
+type ListAWSEnvs struct {
+	AWSEnvs []*ListAWSEnvs_AWSEnvs "json:\"awsEnvs\" graphql:\"awsEnvs\""
+}
+
+func (t *ListAWSEnvs) GetAWSEnvs() []*ListAWSEnvs_AWSEnvs {
+	if t == nil {
+		t = &ListAWSEnvs{}
+	}
+	return t.AWSEnvs
+}
+
+type CodeGenAWSEnv struct {
+	CodeGenAWSEnv CodeGenAWSEnv_CodeGenAWSEnv "json:\"codeGenAWSEnv\" graphql:\"codeGenAWSEnv\""
+}
+
+func (t *CodeGenAWSEnv) GetCodeGenAWSEnv() *CodeGenAWSEnv_CodeGenAWSEnv {
+	if t == nil {
+		t = &CodeGenAWSEnv{}
+	}
+	return &t.CodeGenAWSEnv
+}
+
 type CreateAWSEnv struct {
 	CreateAWSEnv CreateAWSEnv_CreateAWSEnv "json:\"createAWSEnv\" graphql:\"createAWSEnv\""
 }
@@ -8961,6 +9446,17 @@ func (t *GetAWSEnvHostedStatus) GetAWSEnvHosted() *GetAWSEnvHostedStatus_AWSEnvH
 	return t.AWSEnvHosted
 }
 
+type ListAWSEnvsHosted struct {
+	AWSEnvsHosted []*ListAWSEnvsHosted_AWSEnvsHosted "json:\"awsEnvsHosted\" graphql:\"awsEnvsHosted\""
+}
+
+func (t *ListAWSEnvsHosted) GetAWSEnvsHosted() []*ListAWSEnvsHosted_AWSEnvsHosted {
+	if t == nil {
+		t = &ListAWSEnvsHosted{}
+	}
+	return t.AWSEnvsHosted
+}
+
 type CreateAWSEnvHosted struct {
 	CreateAWSEnvHosted CreateAWSEnvHosted_CreateAWSEnvHosted "json:\"createAWSEnvHosted\" graphql:\"createAWSEnvHosted\""
 }
@@ -9016,6 +9512,28 @@ func (t *GetAzureEnvStatus) GetAzureEnv() *GetAzureEnvStatus_AzureEnv {
 	return t.AzureEnv
 }
 
+type ListAzureEnvs struct {
+	AzureEnvs []*ListAzureEnvs_AzureEnvs "json:\"azureEnvs\" graphql:\"azureEnvs\""
+}
+
+func (t *ListAzureEnvs) GetAzureEnvs() []*ListAzureEnvs_AzureEnvs {
+	if t == nil {
+		t = &ListAzureEnvs{}
+	}
+	return t.AzureEnvs
+}
+
+type CodeGenAzureEnv struct {
+	CodeGenAzureEnv CodeGenAzureEnv_CodeGenAzureEnv "json:\"codeGenAzureEnv\" graphql:\"codeGenAzureEnv\""
+}
+
+func (t *CodeGenAzureEnv) GetCodeGenAzureEnv() *CodeGenAzureEnv_CodeGenAzureEnv {
+	if t == nil {
+		t = &CodeGenAzureEnv{}
+	}
+	return &t.CodeGenAzureEnv
+}
+
 type CreateAzureEnv struct {
 	CreateAzureEnv CreateAzureEnv_CreateAzureEnv "json:\"createAzureEnv\" graphql:\"createAzureEnv\""
 }
@@ -9071,6 +9589,28 @@ func (t *GetGCPEnvStatus) GetGCPEnv() *GetGCPEnvStatus_GCPEnv {
 	return t.GCPEnv
 }
 
+type ListGCPEnvs struct {
+	GCPEnvs []*ListGCPEnvs_GCPEnvs "json:\"gcpEnvs\" graphql:\"gcpEnvs\""
+}
+
+func (t *ListGCPEnvs) GetGCPEnvs() []*ListGCPEnvs_GCPEnvs {
+	if t == nil {
+		t = &ListGCPEnvs{}
+	}
+	return t.GCPEnvs
+}
+
+type CodeGenGCPEnv struct {
+	CodeGenGCPEnv CodeGenGCPEnv_CodeGenGCPEnv "json:\"codeGenGCPEnv\" graphql:\"codeGenGCPEnv\""
+}
+
+func (t *CodeGenGCPEnv) GetCodeGenGCPEnv() *CodeGenGCPEnv_CodeGenGCPEnv {
+	if t == nil {
+		t = &CodeGenGCPEnv{}
+	}
+	return &t.CodeGenGCPEnv
+}
+
 type CreateGCPEnv struct {
 	CreateGCPEnv CreateGCPEnv_CreateGCPEnv "json:\"createGCPEnv\" graphql:\"createGCPEnv\""
 }
@@ -9126,6 +9666,28 @@ func (t *GetHCloudEnvStatus) GetHcloudEnv() *GetHCloudEnvStatus_HcloudEnv {
 	return t.HcloudEnv
 }
 
+type ListHCloudEnvs struct {
+	HcloudEnvs []*ListHCloudEnvs_HcloudEnvs "json:\"hcloudEnvs\" graphql:\"hcloudEnvs\""
+}
+
+func (t *ListHCloudEnvs) GetHcloudEnvs() []*ListHCloudEnvs_HcloudEnvs {
+	if t == nil {
+		t = &ListHCloudEnvs{}
+	}
+	return t.HcloudEnvs
+}
+
+type CodeGenHCloudEnv struct {
+	CodeGenHCloudEnv CodeGenHCloudEnv_CodeGenHCloudEnv "json:\"codeGenHCloudEnv\" graphql:\"codeGenHCloudEnv\""
+}
+
+func (t *CodeGenHCloudEnv) GetCodeGenHCloudEnv() *CodeGenHCloudEnv_CodeGenHCloudEnv {
+	if t == nil {
+		t = &CodeGenHCloudEnv{}
+	}
+	return &t.CodeGenHCloudEnv
+}
+
 type CreateHCloudEnv struct {
 	CreateHCloudEnv CreateHCloudEnv_CreateHCloudEnv "json:\"createHCloudEnv\" graphql:\"createHCloudEnv\""
 }
@@ -9181,6 +9743,28 @@ func (t *GetK8SEnvStatus) GetK8sEnv() *GetK8SEnvStatus_K8sEnv {
 	return t.K8sEnv
 }
 
+type ListK8SEnvs struct {
+	K8sEnvs []*ListK8SEnvs_K8sEnvs "json:\"k8sEnvs\" graphql:\"k8sEnvs\""
+}
+
+func (t *ListK8SEnvs) GetK8sEnvs() []*ListK8SEnvs_K8sEnvs {
+	if t == nil {
+		t = &ListK8SEnvs{}
+	}
+	return t.K8sEnvs
+}
+
+type CodeGenK8SEnv struct {
+	CodeGenK8SEnv CodeGenK8SEnv_CodeGenK8SEnv "json:\"codeGenK8SEnv\" graphql:\"codeGenK8SEnv\""
+}
+
+func (t *CodeGenK8SEnv) GetCodeGenK8SEnv() *CodeGenK8SEnv_CodeGenK8SEnv {
+	if t == nil {
+		t = &CodeGenK8SEnv{}
+	}
+	return &t.CodeGenK8SEnv
+}
+
 type CreateK8SEnv struct {
 	CreateK8SEnv CreateK8SEnv_CreateK8SEnv "json:\"createK8SEnv\" graphql:\"createK8SEnv\""
 }
@@ -9382,6 +9966,62 @@ func (c *Client) GetAWSEnvStatus(ctx context.Context, name string, interceptors
 	return &res, nil
 }
 
+const ListAWSEnvsDocument = `query ListAWSEnvs {
+	awsEnvs {
+		name
+		specRevision
+		status {
+			appliedSpecRevision
+			pendingDelete
+			errors {
+				code
+				message
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListAWSEnvs(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListAWSEnvs, error) {
+	vars := map[string]any{}
+
+	var res ListAWSEnvs
+	if err := c.Client.Post(ctx, "ListAWSEnvs", ListAWSEnvsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CodeGenAWSEnvDocument = `query CodeGenAWSEnv ($name: String!, $boilerplate: Boolean) {
+	codeGenAWSEnv(name: $name, boilerplate: $boilerplate) {
+		terraform
+	}
+}
+`
+
+func (c *Client) CodeGenAWSEnv(ctx context.Context, name string, boilerplate *bool, interceptors ...clientv2.RequestInterceptor) (*CodeGenAWSEnv, error) {
+	vars := map[string]any{
+		"name":        name,
+		"boilerplate": boilerplate,
+	}
+
+	var res CodeGenAWSEnv
+	if err := c.Client.Post(ctx, "CodeGenAWSEnv", CodeGenAWSEnvDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateAWSEnvDocument = `mutation CreateAWSEnv ($input: CreateAWSEnvInput!) {
 	createAWSEnv(input: $input) {
 		mutationId
@@ -9792,6 +10432,37 @@ func (c *Client) GetAWSEnvHostedStatus(ctx context.Context, name string, interce
 	return &res, nil
 }
 
+const ListAWSEnvsHostedDocument = `query ListAWSEnvsHosted {
+	awsEnvsHosted {
+		name
+		specRevision
+		status {
+			appliedSpecRevision
+			pendingDelete
+			errors {
+				code
+				message
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListAWSEnvsHosted(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListAWSEnvsHosted, error) {
+	vars := map[string]any{}
+
+	var res ListAWSEnvsHosted
+	if err := c.Client.Post(ctx, "ListAWSEnvsHosted", ListAWSEnvsHostedDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateAWSEnvHostedDocument = `mutation CreateAWSEnvHosted ($input: CreateAWSEnvHostedInput!) {
 	createAWSEnvHosted(input: $input) {
 		mutationId
@@ -10145,6 +10816,62 @@ func (c *Client) GetAzureEnvStatus(ctx context.Context, name string, interceptor
 	return &res, nil
 }
 
+const ListAzureEnvsDocument = `query ListAzureEnvs {
+	azureEnvs {
+		name
+		specRevision
+		status {
+			appliedSpecRevision
+			pendingDelete
+			errors {
+				code
+				message
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListAzureEnvs(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListAzureEnvs, error) {
+	vars := map[string]any{}
+
+	var res ListAzureEnvs
+	if err := c.Client.Post(ctx, "ListAzureEnvs", ListAzureEnvsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CodeGenAzureEnvDocument = `query CodeGenAzureEnv ($name: String!, $boilerplate: Boolean) {
+	codeGenAzureEnv(name: $name, boilerplate: $boilerplate) {
+		terraform
+	}
+}
+`
+
+func (c *Client) CodeGenAzureEnv(ctx context.Context, name string, boilerplate *bool, interceptors ...clientv2.RequestInterceptor) (*CodeGenAzureEnv, error) {
+	vars := map[string]any{
+		"name":        name,
+		"boilerplate": boilerplate,
+	}
+
+	var res CodeGenAzureEnv
+	if err := c.Client.Post(ctx, "CodeGenAzureEnv", CodeGenAzureEnvDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateAzureEnvDocument = `mutation CreateAzureEnv ($input: CreateAzureEnvInput!) {
 	createAzureEnv(input: $input) {
 		mutationId
@@ -10444,6 +11171,62 @@ func (c *Client) GetGCPEnvStatus(ctx context.Context, name string, interceptors
 	return &res, nil
 }
 
+const ListGCPEnvsDocument = `query ListGCPEnvs {
+	gcpEnvs {
+		name
+		specRevision
+		status {
+			appliedSpecRevision
+			pendingDelete
+			errors {
+				code
+				message
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListGCPEnvs(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListGCPEnvs, error) {
+	vars := map[string]any{}
+
+	var res ListGCPEnvs
+	if err := c.Client.Post(ctx, "ListGCPEnvs", ListGCPEnvsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CodeGenGCPEnvDocument = `query CodeGenGCPEnv ($name: String!, $boilerplate: Boolean) {
+	codeGenGCPEnv(name: $name, boilerplate: $boilerplate) {
+		terraform
+	}
+}
+`
+
+func (c *Client) CodeGenGCPEnv(ctx context.Context, name string, boilerplate *bool, interceptors ...clientv2.RequestInterceptor) (*CodeGenGCPEnv, error) {
+	vars := map[string]any{
+		"name":        name,
+		"boilerplate": boilerplate,
+	}
+
+	var res CodeGenGCPEnv
+	if err := c.Client.Post(ctx, "CodeGenGCPEnv", CodeGenGCPEnvDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateGCPEnvDocument = `mutation CreateGCPEnv ($input: CreateGCPEnvInput!) {
 	createGCPEnv(input: $input) {
 		mutationId
@@ -10740,6 +11523,62 @@ func (c *Client) GetHCloudEnvStatus(ctx context.Context, name string, intercepto
 	return &res, nil
 }
 
+const ListHCloudEnvsDocument = `query ListHCloudEnvs {
+	hcloudEnvs {
+		name
+		specRevision
+		status {
+			appliedSpecRevision
+			pendingDelete
+			errors {
+				code
+				message
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListHCloudEnvs(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListHCloudEnvs, error) {
+	vars := map[string]any{}
+
+	var res ListHCloudEnvs
+	if err := c.Client.Post(ctx, "ListHCloudEnvs", ListHCloudEnvsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CodeGenHCloudEnvDocument = `query CodeGenHCloudEnv ($name: String!, $boilerplate: Boolean) {
+	codeGenHCloudEnv(name: $name, boilerplate: $boilerplate) {
+		terraform
+	}
+}
+`
+
+func (c *Client) CodeGenHCloudEnv(ctx context.Context, name string, boilerplate *bool, interceptors ...clientv2.RequestInterceptor) (*CodeGenHCloudEnv, error) {
+	vars := map[string]any{
+		"name":        name,
+		"boilerplate": boilerplate,
+	}
+
+	var res CodeGenHCloudEnv
+	if err := c.Client.Post(ctx, "CodeGenHCloudEnv", CodeGenHCloudEnvDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateHCloudEnvDocument = `mutation CreateHCloudEnv ($input: CreateHCloudEnvInput!) {
 	createHCloudEnv(input: $input) {
 		mutationId
@@ -11045,6 +11884,62 @@ func (c *Client) GetK8SEnvStatus(ctx context.Context, name string, interceptors
 	return &res, nil
 }
 
+const ListK8SEnvsDocument = `query ListK8SEnvs {
+	k8sEnvs {
+		name
+		specRevision
+		status {
+			appliedSpecRevision
+			pendingDelete
+			errors {
+				code
+				message
+			}
+		}
+	}
+}
+`
+
+func (c *Client) ListK8SEnvs(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*ListK8SEnvs, error) {
+	vars := map[string]any{}
+
+	var res ListK8SEnvs
+	if err := c.Client.Post(ctx, "ListK8SEnvs", ListK8SEnvsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CodeGenK8SEnvDocument = `query CodeGenK8SEnv ($name: String!, $boilerplate: Boolean) {
+	codeGenK8SEnv(name: $name, boilerplate: $boilerplate) {
+		terraform
+	}
+}
+`
+
+func (c *Client) CodeGenK8SEnv(ctx context.Context, name string, boilerplate *bool, interceptors ...clientv2.RequestInterceptor) (*CodeGenK8SEnv, error) {
+	vars := map[string]any{
+		"name":        name,
+		"boilerplate": boilerplate,
+	}
+
+	var res CodeGenK8SEnv
+	if err := c.Client.Post(ctx, "CodeGenK8SEnv", CodeGenK8SEnvDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateK8SEnvDocument = `mutation CreateK8SEnv ($input: CreateK8SEnvInput!) {
 	createK8SEnv(input: $input) {
 		mutationId
@@ -11263,31 +12158,42 @@ func (c *Client) DeleteK8SEnv(ctx context.Context, input DeleteK8SEnvInput, inte
 var DocumentOperationNames = map[string]string{
 	GetAWSEnvDocument:             "GetAWSEnv",
 	GetAWSEnvStatusDocument:       "GetAWSEnvStatus",
+	ListAWSEnvsDocument:           "ListAWSEnvs",
+	CodeGenAWSEnvDocument:         "CodeGenAWSEnv",
 	CreateAWSEnvDocument:          "CreateAWSEnv",
 	UpdateAWSEnvDocument:          "UpdateAWSEnv",
 	DeleteAWSEnvDocument:          "DeleteAWSEnv",
 	GetAWSEnvHostedDocument:       "GetAWSEnvHosted",
 	GetAWSEnvHostedStatusDocument: "GetAWSEnvHostedStatus",
+	ListAWSEnvsHostedDocument:     "ListAWSEnvsHosted",
 	CreateAWSEnvHostedDocument:    "CreateAWSEnvHosted",
 	UpdateAWSEnvHostedDocument:    "UpdateAWSEnvHosted",
 	DeleteAWSEnvHostedDocument:    "DeleteAWSEnvHosted",
 	GetAzureEnvDocument:           "GetAzureEnv",
 	GetAzureEnvStatusDocument:     "GetAzureEnvStatus",
+	ListAzureEnvsDocument:         "ListAzureEnvs",
+	CodeGenAzureEnvDocument:       "CodeGenAzureEnv",
 	CreateAzureEnvDocument:        "CreateAzureEnv",
 	UpdateAzureEnvDocument:        "UpdateAzureEnv",
 	DeleteAzureEnvDocument:        "DeleteAzureEnv",
 	GetGCPEnvDocument:             "GetGCPEnv",
 	GetGCPEnvStatusDocument:       "GetGCPEnvStatus",
+	ListGCPEnvsDocument:           "ListGCPEnvs",
+	CodeGenGCPEnvDocument:         "CodeGenGCPEnv",
 	CreateGCPEnvDocument:          "CreateGCPEnv",
 	UpdateGCPEnvDocument:          "UpdateGCPEnv",
 	DeleteGCPEnvDocument:          "DeleteGCPEnv",
 	GetHCloudEnvDocument:          "GetHCloudEnv",
 	GetHCloudEnvStatusDocument:    "GetHCloudEnvStatus",
+	ListHCloudEnvsDocument:        "ListHCloudEnvs",
+	CodeGenHCloudEnvDocument:      "CodeGenHCloudEnv",
 	CreateHCloudEnvDocument:       "CreateHCloudEnv",
 	UpdateHCloudEnvDocument:       "UpdateHCloudEnv",
 	DeleteHCloudEnvDocument:       "DeleteHCloudEnv",
 	GetK8SEnvDocument:             "GetK8SEnv",
 	GetK8SEnvStatusDocument:       "GetK8SEnvStatus",
+	ListK8SEnvsDocument:           "ListK8SEnvs",
+	CodeGenK8SEnvDocument:         "CodeGenK8SEnv",
 	CreateK8SEnvDocument:          "CreateK8SEnv",
 	UpdateK8SEnvDocument:          "UpdateK8SEnv",
 	DeleteK8SEnvDocument:          "DeleteK8SEnv",
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/Yamashou/gqlgenc/clientv2"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -231,6 +235,46 @@ func TestWithRetry_RetriesQueries(t *testing.T) {
 	}
 }
 
+func TestWithReadOnly_BlocksMutations(t *testing.T) {
+	interceptor := WithReadOnly()
+	calls := 0
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		calls++
+		return nil
+	}
+
+	gqlInfo := &clientv2.GQLRequestInfo{Request: &clientv2.Request{
+		Query:         "mutation DeleteAWSEnv ($input: DeleteAWSEnvInput!) { deleteAWSEnv }",
+		OperationName: "DeleteAWSEnv",
+	}}
+	err := interceptor(context.Background(), &http.Request{}, gqlInfo, nil, next)
+	if !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "mutation DeleteAWSEnv") {
+		t.Errorf("expected error to name the operation, got %q", err)
+	}
+	if calls != 0 {
+		t.Errorf("expected the mutation not to be sent, got %d calls", calls)
+	}
+}
+
+func TestWithReadOnly_AllowsQueries(t *testing.T) {
+	interceptor := WithReadOnly()
+	calls := 0
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		calls++
+		return nil
+	}
+
+	if err := interceptor(context.Background(), &http.Request{}, queryInfo(), nil, next); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
 func TestIsRetryable(t *testing.T) {
 	tests := []struct {
 		name string
@@ -254,6 +298,9 @@ func TestIsRetryable(t *testing.T) {
 		// text happens to contain a transient code or "EOF".
 		{"gql error mentioning 503", gqlErr("validation failed: value 503 invalid"), false},
 		{"gql error mentioning EOF", gqlErr("unexpected EOF in user input"), false},
+		// The circuit breaker fails fast, even when the failure that opened it
+		// was a transport error.
+		{"circuit open", fmt.Errorf("request failed: %w", &sdkHttp.CircuitOpenError{Failures: 10, LastError: "connection reset by peer"}), false},
 	}
 
 	for _, tt := range tests {
@@ -265,6 +312,34 @@ func TestIsRetryable(t *testing.T) {
 	}
 }
 
+func TestIsTransportError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"connection reset", errors.New("connection reset by peer"), true},
+		{"EOF transport", errors.New("request failed: EOF"), true},
+		{"client timeout", errors.New(`Post "https://example.com/graphql": context deadline exceeded (Client.Timeout exceeded while awaiting headers)`), true},
+		{"deadline exceeded", fmt.Errorf("request failed: %w", context.DeadlineExceeded), true},
+		{"random transport", errors.New("some random error"), false},
+		// An HTTP response was received, so the outcome of the request is known.
+		{"503 network", netErr(503), false},
+		{"gql error", gqlErr("conflict"), false},
+		// Refused by the circuit breaker, so the request was never sent.
+		{"circuit open", fmt.Errorf("request failed: %w", &sdkHttp.CircuitOpenError{Failures: 10, LastError: "i/o timeout"}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransportError(tt.err); got != tt.want {
+				t.Errorf("IsTransportError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsMutation(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -288,3 +363,55 @@ func TestIsMutation(t *testing.T) {
 		})
 	}
 }
+
+func TestWithRetryPolicy_HonoursRetryAfter(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	httpClient, err := sdkHttp.NewClient(sdkHttp.TransportPolicy{RequestTimeout: time.Second}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A backoff far longer than the test: only the Retry-After lets it pass.
+	policy := sdkHttp.TransportPolicy{MaxRetries: 1, InitialBackoff: time.Hour}
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return netErr(resp.StatusCode)
+		}
+		return nil
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := WithRetryPolicy(policy)(ctx, req, queryInfo(), nil, next); err != nil {
+		t.Fatalf("expected nil error, got: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestIsRetryableWith_ExtraStatusCodes(t *testing.T) {
+	policy := sdkHttp.TransportPolicy{ExtraRetryableStatusCodes: []int{500}}
+	if !isRetryableWith(policy, netErr(500)) {
+		t.Error("expected 500 to be retryable with the extra status code")
+	}
+	if isRetryable(netErr(500)) {
+		t.Error("expected 500 not to be retryable by default")
+	}
+}
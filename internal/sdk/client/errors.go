@@ -1,9 +1,26 @@
 package client
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Sentinels matched by *APIError via errors.Is.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrForbidden    = errors.New("forbidden")
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrActiveClusters is a conflict deleting an env that still has
+	// ClickHouse/Zookeeper clusters (retry with forceDestroyClusters=true).
+	ErrActiveClusters = errors.New("env has active clusters")
 )
 
 type GraphQLError struct {
@@ -16,40 +33,169 @@ func (e GraphQLError) Error() string {
 	return fmt.Sprintf("GraphQL Error: %s", e.Message)
 }
 
-type ClientError struct {
-	NetworkErrors interface{}    `json:"networkErrors"`
-	GraphqlErrors []GraphQLError `json:"graphqlErrors"`
+// Code returns the extensions.code of the error, or "" if it has none.
+func (e GraphQLError) Code() string {
+	if code, ok := e.Extensions["code"]; ok {
+		return fmt.Sprintf("%v", code)
+	}
+	return ""
 }
 
-func ParseError(err error) (*ClientError, error) {
-	if err == nil {
-		return nil, nil
+// APIError is an error response from the Altinity.Cloud API: either a non-2xx
+// HTTP response (StatusCode set) or GraphQL errors in a 200 response.
+type APIError struct {
+	// StatusCode is the HTTP status for network errors, 0 otherwise.
+	StatusCode int
+	// NetworkMessage describes a network error, empty otherwise.
+	NetworkMessage string
+	Errors         []GraphQLError
+
+	cause error
+}
+
+// Error returns the GraphQL messages, each prefixed with its category, or the
+// network error.
+func (e *APIError) Error() string {
+	if len(e.Errors) > 0 {
+		messages := make([]string, 0, len(e.Errors))
+		for _, gqlError := range e.Errors {
+			messages = append(messages, fmt.Sprintf("%s: %s", errorPrefix(gqlError), gqlError.Message))
+		}
+		return strings.Join(messages, "\n")
+	}
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("Network error: HTTP %d: %s", e.StatusCode, e.NetworkMessage)
+	}
+	return fmt.Sprintf("Network error: %s", e.NetworkMessage)
+}
+
+// Unwrap returns the original *clientv2.ErrorResponse, if any.
+func (e *APIError) Unwrap() error {
+	return e.cause
+}
+
+// Is matches the package sentinels by extension code, falling back to the
+// HTTP status and the messages the API is known to use without a code.
+// ErrNotFound only matches the code: a 404 from a wrong URL or a proxy must
+// not make resources disappear from the state.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.HasCode("NOT_FOUND")
+	case ErrConflict:
+		return e.HasCode("CONFLICT") || e.hasMessage("conflict") || e.StatusCode == http.StatusConflict
+	case ErrForbidden:
+		return e.HasCode("FORBIDDEN") || e.StatusCode == http.StatusForbidden
+	case ErrUnauthorized:
+		return e.HasCode("UNAUTHORIZED") || e.hasMessage("Invalid API token") || e.StatusCode == http.StatusUnauthorized
+	case ErrActiveClusters:
+		for _, gqlError := range e.Errors {
+			if gqlError.Code() == "CONFLICT" && strings.Contains(gqlError.Message, "forceDestroyClusters=true") {
+				return true
+			}
+		}
 	}
+	return false
+}
 
-	var errResp ClientError
-	if jsonErr := json.Unmarshal([]byte(err.Error()), &errResp); jsonErr != nil {
-		return nil, fmt.Errorf("error parsing: %v", jsonErr)
+// HasCode reports whether any GraphQL error carries extensions.code == code.
+func (e *APIError) HasCode(code string) bool {
+	for _, gqlError := range e.Errors {
+		if gqlError.Code() == code {
+			return true
+		}
 	}
+	return false
+}
 
-	return &errResp, nil
+func (e *APIError) hasMessage(message string) bool {
+	for _, gqlError := range e.Errors {
+		if gqlError.Message == message {
+			return true
+		}
+	}
+	return false
 }
 
-func IsNotFoundError(err error) (bool, error) {
-	parsedError, parseErr := ParseError(err)
-	if parseErr != nil {
-		return false, parseErr
+// AsAPIError returns the *APIError in err's chain, converting a
+// *clientv2.ErrorResponse if that is what the chain holds. Errors flattened to
+// a string (e.g. wrapped with %v) are not API errors.
+func AsAPIError(err error) (*APIError, bool) {
+	if err == nil {
+		return nil, false
 	}
-	if parsedError == nil {
-		return false, nil
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	var errResp *clientv2.ErrorResponse
+	if errors.As(err, &errResp) {
+		return newAPIError(errResp), true
+	}
+	return nil, false
+}
+
+func newAPIError(errResp *clientv2.ErrorResponse) *APIError {
+	apiErr := &APIError{cause: errResp}
+	if errResp.NetworkError != nil {
+		apiErr.StatusCode = errResp.NetworkError.Code
+		apiErr.NetworkMessage = errResp.NetworkError.Message
+	}
+	if errResp.GqlErrors != nil {
+		for _, gqlError := range *errResp.GqlErrors {
+			converted := GraphQLError{Message: gqlError.Message, Extensions: gqlError.Extensions}
+			for _, p := range gqlError.Path {
+				switch v := p.(type) {
+				case ast.PathName:
+					converted.Path = append(converted.Path, string(v))
+				case ast.PathIndex:
+					converted.Path = append(converted.Path, int(v))
+				}
+			}
+			apiErr.Errors = append(apiErr.Errors, converted)
+		}
 	}
+	return apiErr
+}
 
-	for _, gqlError := range parsedError.GraphqlErrors {
-		if code, ok := gqlError.Extensions["code"]; ok && code == "NOT_FOUND" {
-			return true, nil
+// WithAPIErrors converts error responses into *APIError, so callers can use
+// errors.Is/errors.As instead of inspecting the raw response.
+func WithAPIErrors() clientv2.RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
+		err := next(ctx, req, gqlInfo, res)
+		var errResp *clientv2.ErrorResponse
+		if errors.As(err, &errResp) {
+			return newAPIError(errResp)
 		}
+		return err
 	}
+}
 
-	return false, nil
+// IsNotFoundError reports whether err is a NOT_FOUND API error. The error
+// result is set when err is not an API error at all.
+//
+// Deprecated: use errors.Is(err, ErrNotFound).
+func IsNotFoundError(err error) (bool, error) {
+	return isAPIError(err, ErrNotFound)
+}
+
+// IsActiveClustersError reports whether err is a delete conflict caused by
+// active clusters. The error result is set when err is not an API error at all.
+//
+// Deprecated: use errors.Is(err, ErrActiveClusters).
+func IsActiveClustersError(err error) (bool, error) {
+	return isAPIError(err, ErrActiveClusters)
+}
+
+func isAPIError(err error, target error) (bool, error) {
+	if err == nil {
+		return false, nil
+	}
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false, fmt.Errorf("error parsing: not an API error: %v", err)
+	}
+	return apiErr.Is(target), nil
 }
 
 // errorMapping defines a known error pattern and its user-friendly message template.
@@ -67,12 +213,17 @@ var knownErrors = []errorMapping{
 // If the error is not recognized, it falls back to a clean representation
 // of the GraphQL error messages instead of the raw JSON string.
 func FormatError(err error, resourceName string) string {
-	parsedError, parseErr := ParseError(err)
-	if parseErr != nil {
+	var circuitErr *sdkHttp.CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		return circuitErr.Error()
+	}
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
 		return err.Error()
 	}
 
-	for _, gqlError := range parsedError.GraphqlErrors {
+	for _, gqlError := range apiErr.Errors {
 		for _, mapping := range knownErrors {
 			if gqlError.Message == mapping.Message {
 				if strings.Contains(mapping.FriendlyMessage, "%s") {
@@ -83,29 +234,14 @@ func FormatError(err error, resourceName string) string {
 		}
 	}
 
-	// Fallback: extract clean error messages from GraphQL errors
-	// and classify them based on the extension code or path context.
-	var messages []string
-	for _, gqlError := range parsedError.GraphqlErrors {
-		prefix := errorPrefix(gqlError)
-		messages = append(messages, fmt.Sprintf("%s: %s", prefix, gqlError.Message))
-	}
-	if len(messages) > 0 {
-		return strings.Join(messages, "\n")
-	}
-
-	if parsedError.NetworkErrors != nil {
-		return formatNetworkErrors(parsedError.NetworkErrors)
-	}
-
-	return err.Error()
+	return apiErr.Error()
 }
 
 // errorPrefix returns a human-readable error category based on the GraphQL
 // error extensions code. When no code is present, it infers "Validation Error"
 // for mutation paths and defaults to "Error" otherwise.
 func errorPrefix(gqlError GraphQLError) string {
-	if code, ok := gqlError.Extensions["code"]; ok {
+	if code := gqlError.Code(); code != "" {
 		switch code {
 		case "NOT_FOUND":
 			return "Not Found"
@@ -116,7 +252,7 @@ func errorPrefix(gqlError GraphQLError) string {
 		case "UNAUTHORIZED":
 			return "Unauthorized"
 		default:
-			return fmt.Sprintf("%v", code)
+			return code
 		}
 	}
 
@@ -134,37 +270,3 @@ func errorPrefix(gqlError GraphQLError) string {
 
 	return "Error"
 }
-
-func formatNetworkErrors(networkErrors interface{}) string {
-	switch v := networkErrors.(type) {
-	case string:
-		return fmt.Sprintf("Network error: %s", v)
-	case map[string]interface{}:
-		if msg, ok := v["message"]; ok {
-			return fmt.Sprintf("Network error: %v", msg)
-		}
-		raw, _ := json.Marshal(v)
-		return fmt.Sprintf("Network error: %s", raw)
-	default:
-		raw, _ := json.Marshal(v)
-		return fmt.Sprintf("Network error: %s", raw)
-	}
-}
-
-func IsActiveClustersError(err error) (bool, error) {
-	parsedError, parseErr := ParseError(err)
-	if parseErr != nil {
-		return false, parseErr
-	}
-	if parsedError == nil {
-		return false, nil
-	}
-
-	for _, gqlError := range parsedError.GraphqlErrors {
-		if code, ok := gqlError.Extensions["code"]; ok && code == "CONFLICT" {
-			return strings.Contains(gqlError.Message, "forceDestroyClusters=true"), nil
-		}
-	}
-
-	return false, nil
-}
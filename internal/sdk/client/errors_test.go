@@ -1,12 +1,31 @@
 package client
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// gqlErrAt builds the *clientv2.ErrorResponse of a single GraphQL error at
+// operation, with extensions.code set unless code is empty.
+func gqlErrAt(operation, code, message string) error {
+	gqlError := &gqlerror.Error{Message: message, Path: ast.Path{ast.PathName(operation)}}
+	if code != "" {
+		gqlError.Extensions = map[string]interface{}{"code": code}
+	}
+	return &clientv2.ErrorResponse{GqlErrors: &gqlerror.List{gqlError}}
+}
+
 func TestFormatError_ValidationError(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"iceberg: iceberg: catalog \"ianaya89-tf-test\": table \"hola.ok\": invalid path: \"hola/\"","path":["updateAWSEnv"]}]}`)
+	rawErr := gqlErrAt("updateAWSEnv", "", `iceberg: iceberg: catalog "ianaya89-tf-test": table "hola.ok": invalid path: "hola/"`)
 	got := FormatError(rawErr, "ianaya89-tf-test")
 	want := `Validation Error: iceberg: iceberg: catalog "ianaya89-tf-test": table "hola.ok": invalid path: "hola/"`
 	if got != want {
@@ -15,7 +34,7 @@ func TestFormatError_ValidationError(t *testing.T) {
 }
 
 func TestFormatError_NotFoundWithExtension(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"env not found","path":["getAWSEnv"],"extensions":{"code":"NOT_FOUND"}}]}`)
+	rawErr := gqlErrAt("getAWSEnv", "NOT_FOUND", "env not found")
 	got := FormatError(rawErr, "test")
 	want := "Not Found: env not found"
 	if got != want {
@@ -24,7 +43,10 @@ func TestFormatError_NotFoundWithExtension(t *testing.T) {
 }
 
 func TestFormatError_MultipleErrors(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"field X is required","path":["createAWSEnv"]},{"message":"field Y is invalid","path":["createAWSEnv"]}]}`)
+	rawErr := &clientv2.ErrorResponse{GqlErrors: &gqlerror.List{
+		{Message: "field X is required", Path: ast.Path{ast.PathName("createAWSEnv")}},
+		{Message: "field Y is invalid", Path: ast.Path{ast.PathName("createAWSEnv")}},
+	}}
 	got := FormatError(rawErr, "test")
 	want := "Validation Error: field X is required\nValidation Error: field Y is invalid"
 	if got != want {
@@ -33,7 +55,7 @@ func TestFormatError_MultipleErrors(t *testing.T) {
 }
 
 func TestFormatError_KnownError(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"conflict","path":["createAWSEnv"]}]}`)
+	rawErr := gqlErrAt("createAWSEnv", "", "conflict")
 	got := FormatError(rawErr, "my-env")
 	want := "environment 'my-env' already exists"
 	if got != want {
@@ -50,8 +72,16 @@ func TestFormatError_NonGraphQLError(t *testing.T) {
 	}
 }
 
+func TestFormatError_CircuitOpen(t *testing.T) {
+	circuitErr := &sdkHttp.CircuitOpenError{Failures: 10, RetryIn: 20 * time.Second, LastError: "POST /graphql resulted in 502 Bad Gateway"}
+	got := FormatError(fmt.Errorf(`request failed: Post "https://example.com/graphql": %w`, circuitErr), "test")
+	if got != circuitErr.Error() {
+		t.Errorf("got: %s, want: %s", got, circuitErr.Error())
+	}
+}
+
 func TestFormatError_QueryPathFallback(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"something went wrong","path":["getAWSEnv"]}]}`)
+	rawErr := gqlErrAt("getAWSEnv", "", "something went wrong")
 	got := FormatError(rawErr, "test")
 	want := "Error: something went wrong"
 	if got != want {
@@ -60,7 +90,7 @@ func TestFormatError_QueryPathFallback(t *testing.T) {
 }
 
 func TestFormatError_NetworkErrorObject(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":{"message":"connect ECONNREFUSED 127.0.0.1:443"},"graphqlErrors":[]}`)
+	rawErr := &clientv2.ErrorResponse{NetworkError: &clientv2.HTTPError{Message: "connect ECONNREFUSED 127.0.0.1:443"}}
 	got := FormatError(rawErr, "test")
 	want := "Network error: connect ECONNREFUSED 127.0.0.1:443"
 	if got != want {
@@ -68,17 +98,17 @@ func TestFormatError_NetworkErrorObject(t *testing.T) {
 	}
 }
 
-func TestFormatError_NetworkErrorString(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":"connection timeout","graphqlErrors":[]}`)
+func TestFormatError_NetworkErrorStatus(t *testing.T) {
+	rawErr := &clientv2.ErrorResponse{NetworkError: &clientv2.HTTPError{Code: http.StatusGatewayTimeout, Message: "connection timeout"}}
 	got := FormatError(rawErr, "test")
-	want := "Network error: connection timeout"
+	want := "Network error: HTTP 504: connection timeout"
 	if got != want {
 		t.Errorf("got: %s, want: %s", got, want)
 	}
 }
 
 func TestIsNotFoundError_True(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"env not found","path":["getAWSEnv"],"extensions":{"code":"NOT_FOUND"}}]}`)
+	rawErr := gqlErrAt("getAWSEnv", "NOT_FOUND", "env not found")
 	got, err := IsNotFoundError(rawErr)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -89,7 +119,7 @@ func TestIsNotFoundError_True(t *testing.T) {
 }
 
 func TestIsNotFoundError_False(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"something else","path":["getAWSEnv"],"extensions":{"code":"CONFLICT"}}]}`)
+	rawErr := gqlErrAt("getAWSEnv", "CONFLICT", "something else")
 	got, err := IsNotFoundError(rawErr)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -118,7 +148,7 @@ func TestIsNotFoundError_Nil(t *testing.T) {
 }
 
 func TestIsActiveClustersError_True(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"env has active clusters, use forceDestroyClusters=true","path":["deleteAWSEnv"],"extensions":{"code":"CONFLICT"}}]}`)
+	rawErr := gqlErrAt("deleteAWSEnv", "CONFLICT", "env has active clusters, use forceDestroyClusters=true")
 	got, err := IsActiveClustersError(rawErr)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -129,7 +159,7 @@ func TestIsActiveClustersError_True(t *testing.T) {
 }
 
 func TestIsActiveClustersError_ConflictWithoutClusters(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"conflict","path":["createAWSEnv"],"extensions":{"code":"CONFLICT"}}]}`)
+	rawErr := gqlErrAt("createAWSEnv", "CONFLICT", "conflict")
 	got, err := IsActiveClustersError(rawErr)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -140,7 +170,7 @@ func TestIsActiveClustersError_ConflictWithoutClusters(t *testing.T) {
 }
 
 func TestIsActiveClustersError_NotConflict(t *testing.T) {
-	rawErr := errors.New(`{"networkErrors":null,"graphqlErrors":[{"message":"env not found","path":["getAWSEnv"],"extensions":{"code":"NOT_FOUND"}}]}`)
+	rawErr := gqlErrAt("getAWSEnv", "NOT_FOUND", "env not found")
 	got, err := IsActiveClustersError(rawErr)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -167,3 +197,91 @@ func TestIsActiveClustersError_Nil(t *testing.T) {
 		t.Error("expected false for nil error")
 	}
 }
+
+func TestAPIError_Sentinels(t *testing.T) {
+	withCode := func(code, message string) error {
+		return &clientv2.ErrorResponse{GqlErrors: &gqlerror.List{{
+			Message:    message,
+			Path:       ast.Path{ast.PathName("deleteAWSEnv")},
+			Extensions: map[string]interface{}{"code": code},
+		}}}
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{"not found code", withCode("NOT_FOUND", "env not found"), ErrNotFound, true},
+		{"not found status is not a missing resource", netErr(404), ErrNotFound, false},
+		{"conflict code", withCode("CONFLICT", "conflict"), ErrConflict, true},
+		{"conflict message without code", gqlErr("conflict"), ErrConflict, true},
+		{"forbidden code", withCode("FORBIDDEN", "no access"), ErrForbidden, true},
+		{"forbidden status", netErr(403), ErrForbidden, true},
+		{"unauthorized status", netErr(401), ErrUnauthorized, true},
+		{"invalid token message", gqlErr("Invalid API token"), ErrUnauthorized, true},
+		{"active clusters", withCode("CONFLICT", "env has active clusters, use forceDestroyClusters=true"), ErrActiveClusters, true},
+		{"plain conflict is not active clusters", withCode("CONFLICT", "conflict"), ErrActiveClusters, false},
+		{"not found is not conflict", withCode("NOT_FOUND", "env not found"), ErrConflict, false},
+		{"transport error", errors.New("connection refused"), ErrNotFound, false},
+		{"error response flattened to a string", fmt.Errorf("%v", withCode("NOT_FOUND", "env not found")), ErrNotFound, false},
+	}
+
+	interceptor := WithAPIErrors()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+				return tt.err
+			}
+			err := interceptor(context.Background(), &http.Request{}, queryInfo(), nil, next)
+			wrapped := fmt.Errorf("reading env: %w", err)
+			if got := errors.Is(wrapped, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", err, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIError_FromErrorResponse(t *testing.T) {
+	errResp := &clientv2.ErrorResponse{GqlErrors: &gqlerror.List{{
+		Message:    "invalid CIDR",
+		Path:       ast.Path{ast.PathName("updateAWSEnv"), ast.PathName("spec"), ast.PathIndex(1)},
+		Extensions: map[string]interface{}{"code": "BAD_USER_INPUT"},
+	}}}
+
+	apiErr, ok := AsAPIError(fmt.Errorf("update: %w", errResp))
+	if !ok {
+		t.Fatal("expected an APIError")
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Code() != "BAD_USER_INPUT" {
+		t.Fatalf("unexpected errors: %+v", apiErr.Errors)
+	}
+	if got := fmt.Sprint(apiErr.Errors[0].Path); got != "[updateAWSEnv spec 1]" {
+		t.Errorf("unexpected path: %s", got)
+	}
+	if got, want := apiErr.Error(), "BAD_USER_INPUT: invalid CIDR"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	var unwrapped *clientv2.ErrorResponse
+	if !errors.As(apiErr, &unwrapped) {
+		t.Error("expected the APIError to unwrap to the original response")
+	}
+	if IsTransportError(apiErr) {
+		t.Error("an API error is not a transport error")
+	}
+}
+
+func TestAPIError_NetworkError(t *testing.T) {
+	apiErr, ok := AsAPIError(netErr(502))
+	if !ok {
+		t.Fatal("expected an APIError")
+	}
+	if apiErr.StatusCode != 502 {
+		t.Errorf("expected status 502, got %d", apiErr.StatusCode)
+	}
+	if got, want := FormatError(netErr(502), "test"), "Network error: HTTP 502: boom"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem of GraphQL request logs. Its level is
+// set with TF_LOG_PROVIDER_ALTINITYCLOUD_GRAPHQL (e.g. DEBUG or TRACE), on top
+// of TF_LOG / TF_LOG_PROVIDER.
+const LogSubsystem = "graphql"
+
+// WithDebugLogging logs every GraphQL request attempt to the LogSubsystem
+// subsystem: the operation, duration, HTTP status and response errors at
+// DEBUG, plus the variables and request headers at TRACE. Sensitive variables,
+// the Authorization header and PEM blocks are masked.
+func WithDebugLogging() clientv2.RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
+		logCtx := tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_ALTINITYCLOUD_GRAPHQL"))
+		logCtx = tflog.SubsystemMaskFieldValuesWithFieldKeys(logCtx, LogSubsystem, "Authorization")
+		logCtx = tflog.SubsystemMaskAllFieldValuesRegexes(logCtx, LogSubsystem, pemPattern)
+
+		operation := "unknown"
+		if gqlInfo != nil && gqlInfo.Request != nil {
+			operation = gqlInfo.Request.OperationName
+			tflog.SubsystemTrace(logCtx, LogSubsystem, "sending GraphQL request", map[string]interface{}{
+				"operation": operation,
+				"variables": redactVariables(gqlInfo.Request.Variables),
+				"headers":   redactHeaders(req.Header),
+			})
+		}
+
+		start := time.Now()
+		err := next(ctx, req, gqlInfo, res)
+
+		fields := map[string]interface{}{
+			"operation":   operation,
+			"duration_ms": time.Since(start).Milliseconds(),
+			"status":      "ok",
+		}
+		if err != nil {
+			fields["status"] = "error"
+			if apiErr, ok := AsAPIError(err); ok {
+				if apiErr.StatusCode != 0 {
+					fields["http_status"] = apiErr.StatusCode
+				}
+				var messages []string
+				for _, gqlError := range apiErr.Errors {
+					messages = append(messages, RedactPEM(gqlError.Message))
+				}
+				if len(messages) > 0 {
+					fields["errors"] = messages
+				}
+			}
+			if _, ok := fields["errors"]; !ok {
+				fields["error"] = RedactPEM(err.Error())
+			}
+			if errors.Is(err, context.Canceled) {
+				fields["status"] = "canceled"
+			}
+		}
+		tflog.SubsystemDebug(logCtx, LogSubsystem, "GraphQL request", fields)
+
+		return err
+	}
+}
+
+// redactHeaders returns the request headers with credentials masked.
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if len(values) == 0 {
+			continue
+		}
+		if isSensitiveKey(name) {
+			headers[name] = redacted
+			continue
+		}
+		headers[name] = values[0]
+	}
+	return headers
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+const testPEM = "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIU\n-----END CERTIFICATE-----\n"
+
+func TestSensitiveSchemaFields(t *testing.T) {
+	t.Parallel()
+	for _, field := range []string{"encapikey", "hcloudtokenenc"} {
+		if !sensitiveSchemaFields()[field] {
+			t.Errorf("expected %s to be read from the schema as Sensitive", field)
+		}
+	}
+	if sensitiveSchemaFields()["name"] {
+		t.Error("name is not Sensitive")
+	}
+}
+
+func TestRedactVariables(t *testing.T) {
+	t.Parallel()
+	got := redactVariables(map[string]any{"input": map[string]any{
+		"name":           "acme",
+		"encApiKey":      "k3y",
+		"hcloudTokenEnc": "t0ken",
+		"passwordValue":  "p4ss",
+		"caCert":         testPEM,
+		"note":           "see " + testPEM + " above",
+	}})
+	input := got.(map[string]interface{})["input"].(map[string]interface{})
+
+	for _, key := range []string{"encApiKey", "hcloudTokenEnc", "passwordValue", "caCert"} {
+		if input[key] != redacted {
+			t.Errorf("expected %s to be redacted, got %v", key, input[key])
+		}
+	}
+	if input["name"] != "acme" {
+		t.Errorf("name should be kept, got %v", input["name"])
+	}
+	if input["note"] != "see [REDACTED] above" {
+		t.Errorf("expected the PEM block in note to be masked, got %q", input["note"])
+	}
+}
+
+func TestWithDebugLogging(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	t.Setenv("TF_LOG_PROVIDER_ALTINITYCLOUD_GRAPHQL", "TRACE")
+
+	req := &http.Request{Header: http.Header{}}
+	req.Header.Set("Authorization", "Bearer s3cr3t-t0ken")
+	req.Header.Set("User-Agent", "terraform/test")
+	gqlInfo := &clientv2.GQLRequestInfo{Request: &clientv2.Request{
+		OperationName: "CreateAWSEnv",
+		Query:         "mutation CreateAWSEnv($input: CreateAWSEnvInput!) { createAWSEnv(input: $input) { mutationId } }",
+		Variables:     map[string]any{"input": map[string]any{"name": "acme", "encApiKey": "k3y"}},
+	}}
+	next := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		return netErr(503)
+	}
+
+	if err := WithDebugLogging()(ctx, req, gqlInfo, nil, next); err == nil {
+		t.Fatal("expected the error to be passed through")
+	}
+
+	logged := output.String()
+	for _, secret := range []string{"s3cr3t-t0ken", "k3y"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("%q leaked into the log: %s", secret, logged)
+		}
+	}
+	if !strings.Contains(logged, "terraform/test") {
+		t.Errorf("expected non-sensitive headers at TRACE, got %s", logged)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected a TRACE and a DEBUG entry, got %v", entries)
+	}
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+LogSubsystem {
+			t.Errorf("expected the %s subsystem, got %v", LogSubsystem, entry["@module"])
+		}
+	}
+	debug := entries[1]
+	if debug["@level"] != "debug" || debug["operation"] != "CreateAWSEnv" || debug["status"] != "error" || debug["http_status"] != float64(503) {
+		t.Errorf("unexpected debug entry: %v", debug)
+	}
+	if _, ok := debug["duration_ms"]; !ok {
+		t.Error("expected a duration")
+	}
+}
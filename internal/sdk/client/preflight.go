@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// operationFiles are the operations the client is generated from.
+//
+//go:embed schema_*.graphql
+var operationFiles embed.FS
+
+// Ping runs the cheapest authenticated query there is, to check that the API
+// is reachable and accepts the token.
+func (c *Client) Ping(ctx context.Context) error {
+	var res struct {
+		Typename string `json:"__typename" graphql:"__typename"`
+	}
+	return c.Client.Post(ctx, "Ping", "query Ping { __typename }", &res, nil)
+}
+
+// SchemaIssue is an element of the API schema the client uses that the server
+// no longer supports, or has deprecated.
+type SchemaIssue struct {
+	// Element is Type.field, Type.field(argument) or, for the fields of input
+	// types, Input.field.
+	Element string
+	// Operations are the operations using Element.
+	Operations []string
+	// Deprecated is set when the server still supports Element but deprecates it,
+	// for Reason.
+	Deprecated bool
+	Reason     string
+}
+
+func (i SchemaIssue) String() string {
+	if i.Deprecated {
+		if i.Reason != "" {
+			return fmt.Sprintf("%s (used by %s) is deprecated: %s", i.Element, strings.Join(i.Operations, ", "), i.Reason)
+		}
+		return fmt.Sprintf("%s (used by %s) is deprecated", i.Element, strings.Join(i.Operations, ", "))
+	}
+	return fmt.Sprintf("%s (used by %s) is not supported by the API", i.Element, strings.Join(i.Operations, ", "))
+}
+
+const introspectionQuery = `query Introspection {
+  __schema {
+    types {
+      name
+      fields(includeDeprecated: true) {
+        name
+        isDeprecated
+        deprecationReason
+        args {
+          name
+        }
+      }
+      inputFields {
+        name
+      }
+    }
+  }
+}`
+
+// introspection is the part of the introspection result CheckSchema compares.
+type introspection struct {
+	Schema struct {
+		Types []struct {
+			Name   string `json:"name" graphql:"name"`
+			Fields []struct {
+				Name              string  `json:"name" graphql:"name"`
+				IsDeprecated      bool    `json:"isDeprecated" graphql:"isDeprecated"`
+				DeprecationReason *string `json:"deprecationReason" graphql:"deprecationReason"`
+				Args              []struct {
+					Name string `json:"name" graphql:"name"`
+				} `json:"args" graphql:"args"`
+			} `json:"fields" graphql:"fields"`
+			InputFields []struct {
+				Name string `json:"name" graphql:"name"`
+			} `json:"inputFields" graphql:"inputFields"`
+		} `json:"types" graphql:"types"`
+	} `json:"__schema" graphql:"__schema"`
+}
+
+// CheckSchema introspects the API schema and returns the elements used by the
+// client's operations that the server doesn't support or deprecates, in
+// element order. It fails if the server doesn't allow introspection.
+func (c *Client) CheckSchema(ctx context.Context) ([]SchemaIssue, error) {
+	var res introspection
+	if err := c.Client.Post(ctx, "Introspection", introspectionQuery, &res, nil); err != nil {
+		return nil, err
+	}
+	used, err := usedSchema()
+	if err != nil {
+		return nil, err
+	}
+	return compareSchema(used, &res), nil
+}
+
+// schemaElement is a server-side element of the schema, as introspected.
+type schemaElement struct {
+	deprecated bool
+	reason     string
+}
+
+func compareSchema(used map[string][]string, server *introspection) []SchemaIssue {
+	elements := map[string]schemaElement{}
+	for _, t := range server.Schema.Types {
+		for _, f := range t.Fields {
+			element := schemaElement{deprecated: f.IsDeprecated}
+			if f.DeprecationReason != nil {
+				element.reason = *f.DeprecationReason
+			}
+			elements[t.Name+"."+f.Name] = element
+			for _, arg := range f.Args {
+				elements[fmt.Sprintf("%s.%s(%s)", t.Name, f.Name, arg.Name)] = schemaElement{}
+			}
+		}
+		for _, f := range t.InputFields {
+			elements[t.Name+"."+f.Name] = schemaElement{}
+		}
+	}
+
+	var issues []SchemaIssue
+	for name, operations := range used {
+		element, ok := elements[name]
+		switch {
+		case !ok:
+			issues = append(issues, SchemaIssue{Element: name, Operations: operations})
+		case element.deprecated:
+			issues = append(issues, SchemaIssue{Element: name, Operations: operations, Deprecated: true, Reason: element.reason})
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Element < issues[j].Element })
+	return issues
+}
+
+// usedSchema maps the schema elements the client's operations select, pass
+// arguments to or send as input to the names of these operations.
+var usedSchema = sync.OnceValues(func() (map[string][]string, error) {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "graphql.schema", Input: graphqlSchema})
+	if err != nil {
+		return nil, fmt.Errorf("parse the API schema: %w", err)
+	}
+	var operations strings.Builder
+	files, err := fs.Glob(operationFiles, "*.graphql")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		content, err := operationFiles.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		operations.Write(content)
+		operations.WriteString("\n")
+	}
+	doc, errs := gqlparser.LoadQuery(schema, operations.String())
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("parse the client operations: %w", errs)
+	}
+
+	used := map[string][]string{}
+	use := func(element, operation string) {
+		for _, o := range used[element] {
+			if o == operation {
+				return
+			}
+		}
+		used[element] = append(used[element], operation)
+	}
+	for _, op := range doc.Operations {
+		walkSelections(op.SelectionSet, func(field *ast.Field) {
+			if strings.HasPrefix(field.Name, "__") || field.ObjectDefinition == nil {
+				return
+			}
+			use(field.ObjectDefinition.Name+"."+field.Name, op.Name)
+			for _, arg := range field.Arguments {
+				use(fmt.Sprintf("%s.%s(%s)", field.ObjectDefinition.Name, field.Name, arg.Name), op.Name)
+			}
+		})
+		// Every field of an input type is sent, even when null.
+		seen := map[string]bool{}
+		for _, variable := range op.VariableDefinitions {
+			walkInput(schema, variable.Type.Name(), seen, func(input, field string) {
+				use(input+"."+field, op.Name)
+			})
+		}
+	}
+	return used, nil
+})
+
+func walkSelections(selections ast.SelectionSet, fn func(*ast.Field)) {
+	for _, selection := range selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			fn(s)
+			walkSelections(s.SelectionSet, fn)
+		case *ast.InlineFragment:
+			walkSelections(s.SelectionSet, fn)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				walkSelections(s.Definition.SelectionSet, fn)
+			}
+		}
+	}
+}
+
+func walkInput(schema *ast.Schema, name string, seen map[string]bool, fn func(input, field string)) {
+	definition := schema.Types[name]
+	if definition == nil || definition.Kind != ast.InputObject || seen[name] {
+		return
+	}
+	seen[name] = true
+	for _, field := range definition.Fields {
+		fn(name, field.Name)
+		walkInput(schema, field.Type.Name(), seen, fn)
+	}
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// serverSchema introspects the embedded schema, i.e. an API the client is in
+// sync with, for edit to simulate API changes.
+func serverSchema(t *testing.T) map[string]interface{} {
+	t.Helper()
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "graphql.schema", Input: graphqlSchema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []interface{}
+	for name, definition := range schema.Types {
+		var fields, inputFields []interface{}
+		for _, field := range definition.Fields {
+			if definition.Kind == ast.InputObject {
+				inputFields = append(inputFields, map[string]interface{}{"name": field.Name})
+				continue
+			}
+			var args []interface{}
+			for _, arg := range field.Arguments {
+				args = append(args, map[string]interface{}{"name": arg.Name})
+			}
+			fields = append(fields, map[string]interface{}{"name": field.Name, "isDeprecated": false, "deprecationReason": nil, "args": args})
+		}
+		types = append(types, map[string]interface{}{"name": name, "fields": fields, "inputFields": inputFields})
+	}
+	return map[string]interface{}{"__schema": map[string]interface{}{"types": types}}
+}
+
+// editField calls fn with field of typeName in the introspection result, or
+// removes the field if fn is nil.
+func editField(t *testing.T, data map[string]interface{}, typeName, field, list string, fn func(map[string]interface{})) {
+	t.Helper()
+	for _, typ := range data["__schema"].(map[string]interface{})["types"].([]interface{}) {
+		typ := typ.(map[string]interface{})
+		if typ["name"] != typeName {
+			continue
+		}
+		fields := typ[list].([]interface{})
+		for i, f := range fields {
+			if f.(map[string]interface{})["name"] == field {
+				if fn == nil {
+					typ[list] = append(fields[:i:i], fields[i+1:]...)
+				} else {
+					fn(f.(map[string]interface{}))
+				}
+				return
+			}
+		}
+	}
+	t.Fatalf("no field %s.%s", typeName, field)
+}
+
+func graphQLServer(t *testing.T, status int, data interface{}) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Invalid API token"}]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient(srv.Client(), srv.URL, nil, WithAPIErrors())
+}
+
+func TestCheckSchema_InSync(t *testing.T) {
+	c := graphQLServer(t, http.StatusOK, serverSchema(t))
+	issues, err := c.CheckSchema(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+}
+
+func TestCheckSchema_RemovedAndDeprecatedFields(t *testing.T) {
+	data := serverSchema(t)
+	editField(t, data, "AWSEnvStatus", "pendingDelete", "fields", nil)
+	editField(t, data, "AWSEnv", "specRevision", "fields", func(f map[string]interface{}) {
+		f["isDeprecated"] = true
+		f["deprecationReason"] = "Use revision."
+	})
+	editField(t, data, "Query", "awsEnv", "fields", func(f map[string]interface{}) { f["args"] = []interface{}{} })
+	editField(t, data, "CreateAWSEnvInput", "name", "inputFields", nil)
+
+	c := graphQLServer(t, http.StatusOK, data)
+	issues, err := c.CheckSchema(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.String())
+	}
+	want := []string{
+		"AWSEnv.specRevision (used by GetAWSEnv, GetAWSEnvStatus, ListAWSEnvs) is deprecated: Use revision.",
+		"AWSEnvStatus.pendingDelete (used by GetAWSEnvStatus, ListAWSEnvs) is not supported by the API",
+		"CreateAWSEnvInput.name (used by CreateAWSEnv) is not supported by the API",
+		"Query.awsEnv(name) (used by GetAWSEnv, GetAWSEnvStatus) is not supported by the API",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestPing_InvalidToken(t *testing.T) {
+	c := graphQLServer(t, http.StatusUnauthorized, nil)
+	if err := c.Ping(context.Background()); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
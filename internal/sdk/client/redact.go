@@ -0,0 +1,103 @@
+package client
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// redacted replaces the value of sensitive variables in audit records and logs.
+const redacted = "[REDACTED]"
+
+// sensitiveKeyPatterns are variable names (lowercased) always masked, on top
+// of the fields the schema types as Sensitive: anything named like a credential.
+var sensitiveKeyPatterns = []string{"password", "secret", "token", "apikey", "authorization"}
+
+// pemPattern matches PEM blocks (certificates, keys) embedded in any value.
+var pemPattern = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]+-----[\s\S]*?(-----END [A-Z0-9 ]+-----\n?|$)`)
+
+//go:embed graphql.schema
+var graphqlSchema string
+
+// sensitiveSchemaFields returns the names (lowercased) of the fields and
+// input fields of type Sensitive in the API schema, e.g. encApiKey.
+var sensitiveSchemaFields = sync.OnceValue(func() map[string]bool {
+	fields := map[string]bool{}
+	doc, err := parser.ParseSchema(&ast.Source{Name: "graphql.schema", Input: graphqlSchema})
+	if err != nil {
+		return fields
+	}
+	definitions := append(ast.DefinitionList{}, doc.Definitions...)
+	definitions = append(definitions, doc.Extensions...)
+	for _, definition := range definitions {
+		for _, field := range definition.Fields {
+			if field.Type != nil && field.Type.Name() == "Sensitive" {
+				fields[strings.ToLower(field.Name)] = true
+			}
+		}
+	}
+	return fields
+})
+
+// redactVariables returns a JSON-shaped copy of variables with sensitive
+// values masked.
+func redactVariables(variables map[string]any) interface{} {
+	if len(variables) == 0 {
+		return nil
+	}
+	raw, err := json.Marshal(variables)
+	if err != nil {
+		return redacted
+	}
+	var generic interface{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return redacted
+	}
+	return redactValue(generic)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, child := range value {
+			if isSensitiveKey(k) && child != nil {
+				value[k] = redacted
+				continue
+			}
+			value[k] = redactValue(child)
+		}
+	case []interface{}:
+		for i, child := range value {
+			value[i] = redactValue(child)
+		}
+	case string:
+		return RedactPEM(value)
+	}
+	return v
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveSchemaFields()[key] {
+		return true
+	}
+	for _, sensitive := range sensitiveKeyPatterns {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactPEM masks every PEM block in s.
+func RedactPEM(s string) string {
+	if !strings.Contains(s, "-----BEGIN ") {
+		return s
+	}
+	return pemPattern.ReplaceAllString(s, redacted)
+}
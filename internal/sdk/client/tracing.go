@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// WithTracing wraps every GraphQL operation in a span named after it, with the
+// env name and, for mutations, the returned spec revision as attributes. It is
+// a no-op unless tracing is enabled.
+func WithTracing() clientv2.RequestInterceptor {
+	return func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}, next clientv2.RequestInterceptorFunc) error {
+		name := "graphql"
+		attributes := []attribute.KeyValue{attribute.String("graphql.operation.type", "query")}
+		if isMutation(gqlInfo) {
+			attributes[0] = attribute.String("graphql.operation.type", "mutation")
+		}
+		if gqlInfo != nil && gqlInfo.Request != nil {
+			name = "graphql " + gqlInfo.Request.OperationName
+			attributes = append(attributes,
+				attribute.String("graphql.operation.name", gqlInfo.Request.OperationName),
+				tracing.AttrOperation.String(gqlInfo.Request.OperationName),
+			)
+			if envName := variablesEnvName(gqlInfo.Request.Variables); envName != "" {
+				attributes = append(attributes, tracing.AttrEnvName.String(envName))
+			}
+		}
+
+		ctx, span := tracing.Start(ctx, strings.TrimSpace(name), attributes...)
+		err := next(ctx, req, gqlInfo, res)
+		if err == nil && span.IsRecording() && isMutation(gqlInfo) {
+			if _, specRevision := auditResult(res); specRevision != nil {
+				span.SetAttributes(tracing.AttrSpecRevision.Int64(*specRevision))
+			}
+		}
+		if apiErr, ok := AsAPIError(err); ok && apiErr.StatusCode != 0 {
+			span.SetAttributes(attribute.Int("http.response.status_code", apiErr.StatusCode))
+		}
+		tracing.End(span, err)
+		return err
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestWithTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	mutation := &clientv2.GQLRequestInfo{Request: &clientv2.Request{
+		OperationName: "UpdateAWSEnv",
+		Query:         "mutation UpdateAWSEnv($input: UpdateAWSEnvInput!) { updateAWSEnv(input: $input) { mutationId } }",
+		Variables:     map[string]any{"input": map[string]any{"name": "acme"}},
+	}}
+	ok := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		*(res.(*map[string]any)) = map[string]any{"updateAWSEnv": map[string]any{"mutationId": "m-1", "spec": map[string]any{"specRevision": 7}}}
+		return nil
+	}
+	res := map[string]any{}
+	if err := WithTracing()(context.Background(), &http.Request{}, mutation, &res, ok); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	query := &clientv2.GQLRequestInfo{Request: &clientv2.Request{
+		OperationName: "GetAWSEnv",
+		Query:         "query GetAWSEnv($name: String!) { awsEnv(name: $name) { name } }",
+		Variables:     map[string]any{"name": "acme"},
+	}}
+	failed := func(ctx context.Context, req *http.Request, gqlInfo *clientv2.GQLRequestInfo, res interface{}) error {
+		return netErr(503)
+	}
+	if err := WithTracing()(context.Background(), &http.Request{}, query, nil, failed); err == nil {
+		t.Fatal("expected the error to be passed through")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	update := spans[0]
+	if update.Name != "graphql UpdateAWSEnv" {
+		t.Errorf("unexpected span name %q", update.Name)
+	}
+	attributes := spanAttributes(update)
+	if attributes["graphql.operation.type"].AsString() != "mutation" {
+		t.Errorf("expected a mutation, got %q", attributes["graphql.operation.type"].AsString())
+	}
+	if attributes[tracing.AttrEnvName].AsString() != "acme" {
+		t.Errorf("expected env name acme, got %q", attributes[tracing.AttrEnvName].AsString())
+	}
+	if attributes[tracing.AttrSpecRevision].AsInt64() != 7 {
+		t.Errorf("expected spec revision 7, got %d", attributes[tracing.AttrSpecRevision].AsInt64())
+	}
+
+	get := spans[1]
+	attributes = spanAttributes(get)
+	if attributes["graphql.operation.type"].AsString() != "query" {
+		t.Errorf("expected a query, got %q", attributes["graphql.operation.type"].AsString())
+	}
+	if attributes["http.response.status_code"].AsInt64() != 503 {
+		t.Errorf("expected HTTP status 503, got %d", attributes["http.response.status_code"].AsInt64())
+	}
+	if get.Status.Code != codes.Error {
+		t.Errorf("expected an error status, got %v", get.Status.Code)
+	}
+}
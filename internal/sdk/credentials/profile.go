@@ -0,0 +1,146 @@
+package credentials
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile used when none is named.
+const DefaultProfile = "default"
+
+// Profile is a named section of the config file:
+//
+//	[default]
+//	api_url           = https://anywhere.altinity.cloud
+//	api_token_command = vault kv get -field=token secret/altinitycloud
+//
+//	[staging]
+//	api_token_file = ~/.altinitycloud/staging-token
+//	ca_crt_file    = /etc/ssl/staging-ca.pem
+type Profile struct {
+	Name            string
+	APIURL          string
+	APIToken        string
+	APITokenFile    string
+	APITokenCommand string
+	CACrtFile       string
+}
+
+// profileKeys maps the config file keys to the Profile fields.
+var profileKeys = map[string]func(p *Profile) *string{
+	"api_url":           func(p *Profile) *string { return &p.APIURL },
+	"api_token":         func(p *Profile) *string { return &p.APIToken },
+	"api_token_file":    func(p *Profile) *string { return &p.APITokenFile },
+	"api_token_command": func(p *Profile) *string { return &p.APITokenCommand },
+	"ca_crt_file":       func(p *Profile) *string { return &p.CACrtFile },
+}
+
+// DefaultConfigPath returns $XDG_CONFIG_HOME/altinitycloud/config, or
+// ~/.config/altinitycloud/config.
+func DefaultConfigPath() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "altinitycloud", "config"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "altinitycloud", "config"), nil
+}
+
+// ErrProfileNotFound is returned by LoadProfile for a missing profile.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// LoadProfile reads profile name from the config file at path. Paths in the
+// profile starting with ~/ are expanded.
+func LoadProfile(path, name string) (*Profile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var profile *Profile
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") {
+				return nil, fmt.Errorf("%s:%d: invalid section header %q", path, line, text)
+			}
+			if profile != nil {
+				// The profile is complete.
+				break
+			}
+			if strings.TrimSpace(text[1:len(text)-1]) == name {
+				profile = &Profile{Name: name}
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value, got %q", path, line, text)
+		}
+		if profile == nil {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		field, ok := profileKeys[key]
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: unknown key %q", path, line, key)
+		}
+		*field(profile) = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return nil, fmt.Errorf("%w: no [%s] section in %s", ErrProfileNotFound, name, path)
+	}
+
+	profile.APITokenFile = expandHome(profile.APITokenFile)
+	profile.CACrtFile = expandHome(profile.CACrtFile)
+	return profile, nil
+}
+
+// TokenSource returns the token source the profile configures, or nil if it
+// has none. At most one of api_token, api_token_file and api_token_command may
+// be set.
+func (p *Profile) TokenSource() (TokenSource, error) {
+	var sources []TokenSource
+	if p.APIToken != "" {
+		sources = append(sources, StaticToken(p.APIToken))
+	}
+	if p.APITokenFile != "" {
+		sources = append(sources, NewFileToken(p.APITokenFile))
+	}
+	if p.APITokenCommand != "" {
+		sources = append(sources, NewCommandToken(p.APITokenCommand))
+	}
+	switch len(sources) {
+	case 0:
+		return nil, nil
+	case 1:
+		return sources[0], nil
+	default:
+		return nil, fmt.Errorf("profile %s sets more than one of api_token, api_token_file and api_token_command", p.Name)
+	}
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,90 @@
+package credentials
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testConfig = `# Altinity.Cloud profiles
+[default]
+api_url   = https://anywhere.altinity.cloud
+api_token = t0ken
+
+[staging]
+api_url           = https://staging.altinity.cloud
+api_token_command = vault kv get -field=token secret/altinitycloud
+ca_crt_file       = ~/staging-ca.pem
+
+[broken]
+api_token      = t0ken
+api_token_file = /run/secrets/token
+`
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadProfile(t *testing.T) {
+	t.Parallel()
+	path := writeConfig(t, testConfig)
+
+	profile, err := LoadProfile(path, "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	home, _ := os.UserHomeDir()
+	want := Profile{
+		Name:            "staging",
+		APIURL:          "https://staging.altinity.cloud",
+		APITokenCommand: "vault kv get -field=token secret/altinitycloud",
+		CACrtFile:       filepath.Join(home, "staging-ca.pem"),
+	}
+	if *profile != want {
+		t.Errorf("got %+v, want %+v", *profile, want)
+	}
+	source, err := profile.TokenSource()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := source.(*CommandToken); !ok {
+		t.Errorf("expected a command token source, got %T", source)
+	}
+
+	if _, err := LoadProfile(path, "prod"); !errors.Is(err, ErrProfileNotFound) {
+		t.Errorf("expected ErrProfileNotFound, got %v", err)
+	}
+	broken, err := LoadProfile(path, "broken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := broken.TokenSource(); err == nil {
+		t.Error("expected conflicting token sources to be rejected")
+	}
+}
+
+func TestLoadProfileErrors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"unknown key":    "[default]\napi_tokn = t0ken\n",
+		"not key=value":  "[default]\napi_token\n",
+		"invalid header": "[default\napi_token = t0ken\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := LoadProfile(writeConfig(t, content), "default"); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+	if _, err := LoadProfile(filepath.Join(t.TempDir(), "missing"), "default"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got %v", err)
+	}
+}
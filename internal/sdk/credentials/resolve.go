@@ -0,0 +1,139 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Env vars read by Resolve.
+const (
+	EnvAPIURL     = "ALTINITYCLOUD_API_URL"
+	EnvAPIToken   = "ALTINITYCLOUD_API_TOKEN"
+	EnvProfile    = "ALTINITYCLOUD_PROFILE"
+	EnvConfigFile = "ALTINITYCLOUD_CONFIG_FILE"
+)
+
+// Settings are the explicitly configured credentials, e.g. the provider
+// attributes or the CLI flags. Empty fields are unset.
+type Settings struct {
+	APIURL          string
+	APIToken        string
+	APITokenFile    string
+	APITokenCommand string
+	Profile         string
+}
+
+// Resolved are the credentials to connect with.
+type Resolved struct {
+	// APIURL is empty when nothing sets it, to use the default.
+	APIURL string
+	Token  TokenSource
+	// CACrtFile is the CA file of the profile, if any.
+	CACrtFile string
+}
+
+// ErrMissingToken is returned by Resolve when nothing sets a token.
+var ErrMissingToken = errors.New("no API token is configured")
+
+// SettingError is an invalid setting, named after its provider attribute
+// (api_token, profile, ...).
+type SettingError struct {
+	Setting string
+	Summary string
+	Err     error
+}
+
+func (e *SettingError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Setting, e.Err)
+}
+
+func (e *SettingError) Unwrap() error {
+	return e.Err
+}
+
+// Resolve returns the API URL and token source from, in order of precedence,
+// settings, the env vars and the selected profile of the config file. Errors
+// are *SettingError.
+func Resolve(settings Settings) (*Resolved, error) {
+	profile, err := selectedProfile(settings.Profile)
+	if err != nil {
+		return nil, &SettingError{Setting: "profile", Summary: "Failed to load profile", Err: err}
+	}
+
+	var token TokenSource
+	var tokenSettings []string
+	if settings.APIToken != "" {
+		token = StaticToken(settings.APIToken)
+		tokenSettings = append(tokenSettings, "api_token")
+	}
+	if settings.APITokenFile != "" {
+		token = NewFileToken(settings.APITokenFile)
+		tokenSettings = append(tokenSettings, "api_token_file")
+	}
+	if settings.APITokenCommand != "" {
+		token = NewCommandToken(settings.APITokenCommand)
+		tokenSettings = append(tokenSettings, "api_token_command")
+	}
+	if len(tokenSettings) > 1 {
+		return nil, &SettingError{Setting: tokenSettings[1], Summary: "Conflicting API Token Settings",
+			Err: fmt.Errorf("only one of api_token, api_token_file and api_token_command can be set, got %v", tokenSettings)}
+	}
+	if token == nil {
+		if apiToken := os.Getenv(EnvAPIToken); apiToken != "" {
+			token = StaticToken(apiToken)
+		}
+	}
+	if token == nil && profile != nil {
+		token, err = profile.TokenSource()
+		if err != nil {
+			return nil, &SettingError{Setting: "profile", Summary: "Invalid Profile", Err: err}
+		}
+	}
+	if token == nil {
+		return nil, &SettingError{Setting: "api_token", Summary: "Missing Altinity.Cloud API Token", Err: ErrMissingToken}
+	}
+
+	resolved := &Resolved{APIURL: settings.APIURL, Token: token}
+	if resolved.APIURL == "" {
+		resolved.APIURL = os.Getenv(EnvAPIURL)
+	}
+	if profile != nil {
+		if resolved.APIURL == "" {
+			resolved.APIURL = profile.APIURL
+		}
+		resolved.CACrtFile = profile.CACrtFile
+	}
+	return resolved, nil
+}
+
+// selectedProfile returns profile name, else the one named by the
+// ALTINITYCLOUD_PROFILE env var, or the default profile if it exists. It
+// returns nil when no profile is named and there is no default one.
+func selectedProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	named := name != ""
+	if !named {
+		name = DefaultProfile
+	}
+
+	configPath := os.Getenv(EnvConfigFile)
+	if configPath == "" {
+		var err error
+		configPath, err = DefaultConfigPath()
+		if err != nil {
+			if named {
+				return nil, err
+			}
+			return nil, nil
+		}
+	}
+
+	profile, err := LoadProfile(configPath, name)
+	if !named && (errors.Is(err, os.ErrNotExist) || errors.Is(err, ErrProfileNotFound)) {
+		return nil, nil
+	}
+	return profile, err
+}
@@ -0,0 +1,48 @@
+package credentials
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveErrors(t *testing.T) {
+	t.Setenv(EnvConfigFile, filepath.Join(t.TempDir(), "missing"))
+	t.Setenv(EnvAPIToken, "")
+	t.Setenv(EnvProfile, "")
+
+	tests := map[string]struct {
+		settings    Settings
+		wantSetting string
+		wantErr     error
+	}{
+		"missing token": {
+			wantSetting: "api_token", wantErr: ErrMissingToken,
+		},
+		"conflicting tokens": {
+			settings:    Settings{APIToken: "t0ken", APITokenCommand: "echo t0ken"},
+			wantSetting: "api_token_command",
+		},
+		"missing named profile": {
+			settings:    Settings{APIToken: "t0ken", Profile: "prod"},
+			wantSetting: "profile",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Resolve(tc.settings)
+			var settingErr *SettingError
+			if !errors.As(err, &settingErr) || settingErr.Setting != tc.wantSetting {
+				t.Fatalf("expected an error on %s, got %v", tc.wantSetting, err)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	resolved, err := Resolve(Settings{APIToken: "t0ken"})
+	if err != nil || resolved.APIURL != "" || resolved.Token != StaticToken("t0ken") {
+		t.Errorf("unexpected %+v, %v", resolved, err)
+	}
+}
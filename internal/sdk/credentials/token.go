@@ -0,0 +1,164 @@
+// Package credentials resolves the Altinity.Cloud API token: inline, from a
+// file, or from an external helper command, optionally configured in a named
+// profile of the config file.
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RefreshMargin is how long before its reported expiry a helper token is
+// replaced, so requests in flight don't carry an expired token.
+const RefreshMargin = time.Minute
+
+// DefaultCommandTimeout bounds a run of the token helper command.
+const DefaultCommandTimeout = 30 * time.Second
+
+// TokenSource returns the API token to send with each request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a token that never changes, e.g. from api_token.
+type StaticToken string
+
+func (t StaticToken) Token(context.Context) (string, error) {
+	if t == "" {
+		return "", errors.New("empty API token")
+	}
+	return string(t), nil
+}
+
+// FileToken reads the token from a file, e.g. one kept up to date by a
+// secrets manager agent. The file is read again whenever it changes.
+type FileToken struct {
+	Path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileToken returns a source reading the token from path.
+func NewFileToken(path string) *FileToken {
+	return &FileToken{Path: path}
+}
+
+func (f *FileToken) Token(context.Context) (string, error) {
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return "", fmt.Errorf("read API token file: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.token != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.token, nil
+	}
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return "", fmt.Errorf("read API token file: %w", err)
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("API token file %s is empty", f.Path)
+	}
+	f.token, f.modTime, f.size = token, info.ModTime(), info.Size()
+	return token, nil
+}
+
+// CommandToken runs an external helper command and reads the token from its
+// standard output, like git credential helpers or AWS credential_process. The
+// output is either the bare token or a JSON object:
+//
+//	{"token": "...", "expires_at": "2026-01-02T15:04:05Z"}
+//
+// The token is reused until RefreshMargin before expires_at, then the command
+// is run again; without expires_at it is reused for the provider's lifetime.
+type CommandToken struct {
+	Command string
+	Timeout time.Duration
+
+	now func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewCommandToken returns a source running command with the system shell.
+func NewCommandToken(command string) *CommandToken {
+	return &CommandToken{Command: command, Timeout: DefaultCommandTimeout, now: time.Now}
+}
+
+// commandOutput is the JSON output of a token helper.
+type commandOutput struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (c *CommandToken) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (c.expires.IsZero() || c.now().Before(c.expires.Add(-RefreshMargin))) {
+		return c.token, nil
+	}
+
+	output, err := c.run(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.token, c.expires = output.Token, output.ExpiresAt
+	return c.token, nil
+}
+
+func (c *CommandToken) run(ctx context.Context) (*commandOutput, error) {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", c.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", c.Command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			return nil, fmt.Errorf("API token command failed: %w", err)
+		}
+		return nil, fmt.Errorf("API token command failed: %w: %s", err, message)
+	}
+
+	out := strings.TrimSpace(stdout.String())
+	var output commandOutput
+	if strings.HasPrefix(out, "{") {
+		if err := json.Unmarshal([]byte(out), &output); err != nil {
+			return nil, fmt.Errorf("API token command output is not valid JSON: %w", err)
+		}
+	} else {
+		output.Token = out
+	}
+	if output.Token == "" {
+		return nil, errors.New("API token command returned no token")
+	}
+	return &output, nil
+}
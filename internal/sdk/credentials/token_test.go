@@ -0,0 +1,108 @@
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStaticToken(t *testing.T) {
+	t.Parallel()
+	if token, err := StaticToken("t0ken").Token(context.Background()); err != nil || token != "t0ken" {
+		t.Errorf("got %q, %v", token, err)
+	}
+	if _, err := StaticToken("").Token(context.Background()); err == nil {
+		t.Error("expected an empty token to be rejected")
+	}
+}
+
+func TestFileTokenPicksUpRotation(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	source := NewFileToken(path)
+
+	if token, err := source.Token(context.Background()); err != nil || token != "first" {
+		t.Fatalf("got %q, %v", token, err)
+	}
+	if err := os.WriteFile(path, []byte("second-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if token, err := source.Token(context.Background()); err != nil || token != "second-token" {
+		t.Errorf("expected the rotated token, got %q, %v", token, err)
+	}
+
+	if err := os.WriteFile(path, []byte("  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Error("expected an empty file to be rejected")
+	}
+	if _, err := NewFileToken(filepath.Join(t.TempDir(), "missing")).Token(context.Background()); err == nil {
+		t.Error("expected a missing file to be rejected")
+	}
+}
+
+func TestCommandToken(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	t.Parallel()
+
+	t.Run("bare token", func(t *testing.T) {
+		t.Parallel()
+		token, err := NewCommandToken("echo '  t0ken  '").Token(context.Background())
+		if err != nil || token != "t0ken" {
+			t.Errorf("got %q, %v", token, err)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewCommandToken("echo 'vault is sealed' >&2; exit 2").Token(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "vault is sealed") {
+			t.Errorf("expected the helper stderr in the error, got %v", err)
+		}
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		t.Parallel()
+		if _, err := NewCommandToken(`echo '{"expires_at": "2026-01-02T15:04:05Z"}'`).Token(context.Background()); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("refresh on expiry", func(t *testing.T) {
+		t.Parallel()
+		counter := filepath.Join(t.TempDir(), "runs")
+		// Prints a token numbered after the number of runs, expiring at noon.
+		source := NewCommandToken(`echo x >> ` + counter + `; printf '{"token": "t%s", "expires_at": "2026-01-02T12:00:00Z"}' $(wc -l < ` + counter + ` | tr -d ' ')`)
+		now := time.Date(2026, 1, 2, 11, 0, 0, 0, time.UTC)
+		source.now = func() time.Time { return now }
+
+		for _, step := range []struct {
+			at   time.Time
+			want string
+		}{
+			{now, "t1"},
+			{now.Add(58 * time.Minute), "t1"},
+			// Within RefreshMargin of the expiry.
+			{now.Add(59*time.Minute + time.Second), "t2"},
+		} {
+			now = step.at
+			token, err := source.Token(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token != step.want {
+				t.Errorf("at %s: got %q, want %q", step.at.Format(time.Kitchen), token, step.want)
+			}
+		}
+	})
+}
@@ -13,48 +13,101 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
 )
 
+// DefaultPublicKeyTTL is how long an env public key is cached: a rotated key
+// is picked up at most this long after the rotation.
+const DefaultPublicKeyTTL = time.Minute
+
 type Crypto struct {
 	RootCAs *x509.CertPool
 	URL     string
+	// Transport times out and retries the public key request.
+	Transport sdkHttp.TransportPolicy
+
+	// KeyTTL is how long a fetched public key is cached; 0 disables caching.
+	KeyTTL time.Duration
+
+	clients sdkHttp.ClientPool
+	// keys caches the encryption public key per client certificate
+	// fingerprint, for KeyTTL.
+	keys sync.Map // string -> *publicKeyEntry
+	now  func() time.Time
+}
+
+type publicKeyEntry struct {
+	mu      sync.Mutex
+	key     *rsa.PublicKey
+	expires time.Time
 }
 
 func NewCrypto(rootCAs *x509.CertPool, cryptoUrl string) *Crypto {
 	return &Crypto{
-		RootCAs: rootCAs,
-		URL:     cryptoUrl,
+		RootCAs:   rootCAs,
+		URL:       cryptoUrl,
+		Transport: sdkHttp.DefaultTransportPolicy(),
+		KeyTTL:    DefaultPublicKeyTTL,
+		now:       time.Now,
 	}
 }
 
+// Encrypt encrypts value with the env public key, fetched with the client
+// certificate in pem (certificate + key). The key is fetched once per
+// certificate and KeyTTL, however many secrets Terraform creates in parallel.
 func (c *Crypto) Encrypt(ctx context.Context, pem string, value string) (string, error) {
-	split, err := split([]byte(pem))
+	key, err := c.envPublicKey(ctx, pem)
 	if err != nil {
 		return "", err
 	}
-	if len(split) != 2 {
-		return "", fmt.Errorf("malformed PEM: expected 2 blocks (certificate + key), instead got %d", len(split))
-	}
-	tlsCert, err := x509KeyPairWithLeaf(split[0], split[1])
+	v, err := encryptWithRSAPublicKey(value, key)
 	if err != nil {
 		return "", err
 	}
-	res, err := c.fetchPublicKey(ctx, tlsCert)
+
+	return v, nil
+}
+
+// KeyFingerprint returns the fingerprint of the env public key, fetched with
+// the client certificate in pem as Encrypt does, and cached alike, so it is at
+// most KeyTTL old. Encrypted values start with it, see EncryptedKeyFingerprint.
+func (c *Crypto) KeyFingerprint(ctx context.Context, pem string) (string, error) {
+	key, err := c.envPublicKey(ctx, pem)
 	if err != nil {
 		return "", err
 	}
-	key, err := ParseRSAPublicKey(res)
+	return fingerprint(key), nil
+}
+
+// EncryptedKeyFingerprint returns the fingerprint of the public key value was
+// encrypted with, or "" if value is not an encrypted value.
+func EncryptedKeyFingerprint(value string) string {
+	fingerprint, _, ok := strings.Cut(value, ".")
+	if !ok {
+		return ""
+	}
+	return fingerprint
+}
+
+// envPublicKey returns the env public key for the client certificate in pem
+// (certificate + key).
+func (c *Crypto) envPublicKey(ctx context.Context, pem string) (*rsa.PublicKey, error) {
+	split, err := split([]byte(pem))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	v, err := encryptWithRSAPublicKey(value, key)
+	if len(split) != 2 {
+		return nil, fmt.Errorf("malformed PEM: expected 2 blocks (certificate + key), instead got %d", len(split))
+	}
+	tlsCert, err := x509KeyPairWithLeaf(split[0], split[1])
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
-	return v, nil
+	return c.publicKey(ctx, tlsCert)
 }
 
 func (c *Crypto) Decrypt(pkPem string, value string) (string, error) {
@@ -85,13 +138,47 @@ func (c *Crypto) Decrypt(pkPem string, value string) (string, error) {
 	return string(cleartext), nil
 }
 
+// publicKey returns the cached public key for tlsCert, fetching it on first
+// use and once it is KeyTTL old. Concurrent callers with the same certificate
+// wait for a single fetch; failures are not cached.
+func (c *Crypto) publicKey(ctx context.Context, tlsCert tls.Certificate) (*rsa.PublicKey, error) {
+	value, _ := c.keys.LoadOrStore(sdkHttp.CertificateFingerprint(&tlsCert), &publicKeyEntry{})
+	entry := value.(*publicKeyEntry)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	if entry.key != nil && now().Before(entry.expires) {
+		return entry.key, nil
+	}
+	spanCtx, span := tracing.Start(ctx, "FetchPublicKey", tracing.AttrOperation.String("FetchPublicKey"))
+	if tlsCert.Leaf != nil {
+		span.SetAttributes(tracing.AttrEnvName.String(tlsCert.Leaf.Subject.CommonName))
+	}
+	res, err := c.fetchPublicKey(spanCtx, tlsCert)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParseRSAPublicKey(res)
+	if err != nil {
+		return nil, err
+	}
+	entry.key = key
+	entry.expires = now().Add(c.KeyTTL)
+	return key, nil
+}
+
 func (c *Crypto) fetchPublicKey(ctx context.Context, tlsCert tls.Certificate) (pem []byte, err error) {
-	httpClient, err := sdkHttp.NewClient(c.RootCAs, tlsCert)
+	httpClient, err := c.clients.Client(c.Transport, c.RootCAs, &tlsCert)
 	if err != nil {
 		return nil, err
 	}
 	url := fmt.Sprintf("%s/key", c.URL)
-	body, err := sdkHttp.Do(ctx, httpClient, http.MethodGet, url, nil, nil)
+	body, err := sdkHttp.DoWithRetry(ctx, httpClient, c.Transport, http.MethodGet, url, nil, nil)
 	if err != nil {
 		return nil, err
 	}
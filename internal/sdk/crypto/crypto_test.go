@@ -0,0 +1,214 @@
+package crypto
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// clientPEM returns a self-signed client certificate and its key, concatenated
+// as the env certificate resource stores them.
+func clientPEM(t *testing.T, commonName string) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, err := encode(der, "CERTIFICATE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := EncodeRSAPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(certPEM) + string(keyPEM)
+}
+
+func TestEncryptCachesPublicKeyPerCertificate(t *testing.T) {
+	t.Parallel()
+
+	envKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyPEM, err := encode(x509.MarshalPKCS1PublicKey(&envKey.PublicKey), "RSA PUBLIC KEY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetches, handshakes atomic.Int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write(publicKeyPEM)
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			handshakes.Add(1)
+		}
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(srv.Certificate())
+	c := NewCrypto(rootCAs, srv.URL)
+
+	first, second := clientPEM(t, "acme"), clientPEM(t, "other")
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pem := first
+			if i%2 == 1 {
+				pem = second
+			}
+			encrypted, err := c.Encrypt(context.Background(), pem, "s3cr3t")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if decrypted, err := c.Decrypt(string(mustEncodeKey(t, envKey)), encrypted); err != nil || decrypted != "s3cr3t" {
+				t.Errorf("round trip failed: %q, %v", decrypted, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if fetches.Load() != 2 {
+		t.Errorf("expected one key fetch per client certificate, got %d", fetches.Load())
+	}
+	if handshakes.Load() != 2 {
+		t.Errorf("expected one connection per client certificate, got %d", handshakes.Load())
+	}
+}
+
+func TestKeyFingerprint(t *testing.T) {
+	t.Parallel()
+
+	envKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyPEM, err := encode(x509.MarshalPKCS1PublicKey(&envKey.PublicKey), "RSA PUBLIC KEY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fetches atomic.Int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write(publicKeyPEM)
+	}))
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(srv.Certificate())
+	c := NewCrypto(rootCAs, srv.URL)
+	pem := clientPEM(t, "acme")
+
+	encrypted, err := c.Encrypt(context.Background(), pem, "s3cr3t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := c.KeyFingerprint(context.Background(), pem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current != fingerprint(&envKey.PublicKey) || EncryptedKeyFingerprint(encrypted) != current {
+		t.Errorf("expected fingerprint %s, got %s and %s from %s", fingerprint(&envKey.PublicKey), current, EncryptedKeyFingerprint(encrypted), encrypted)
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("expected the key fetched once, got %d", fetches.Load())
+	}
+
+	if got := EncryptedKeyFingerprint("not encrypted"); got != "" {
+		t.Errorf("expected no fingerprint, got %q", got)
+	}
+}
+
+func TestPublicKeyExpires(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	envKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fetches atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		mu.Lock()
+		defer mu.Unlock()
+		publicKeyPEM, _ := encode(x509.MarshalPKCS1PublicKey(&envKey.PublicKey), "RSA PUBLIC KEY")
+		_, _ = w.Write(publicKeyPEM)
+	}))
+	t.Cleanup(srv.Close)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(srv.Certificate())
+	c := NewCrypto(rootCAs, srv.URL)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	pem := clientPEM(t, "acme")
+
+	first, err := c.KeyFingerprint(context.Background(), pem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The env key is rotated: the cached key is used until it expires.
+	rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	envKey = rotated
+	mu.Unlock()
+	if cached, err := c.KeyFingerprint(context.Background(), pem); err != nil || cached != first {
+		t.Errorf("expected the cached fingerprint %s, got %s (%v)", first, cached, err)
+	}
+
+	now = now.Add(DefaultPublicKeyTTL)
+	current, err := c.KeyFingerprint(context.Background(), pem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current != fingerprint(&rotated.PublicKey) {
+		t.Errorf("expected the rotated key fingerprint %s, got %s", fingerprint(&rotated.PublicKey), current)
+	}
+	if fetches.Load() != 2 {
+		t.Errorf("expected two fetches, got %d", fetches.Load())
+	}
+}
+
+func mustEncodeKey(t *testing.T, key *rsa.PrivateKey) []byte {
+	t.Helper()
+	encoded, err := EncodeRSAPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoded
+}
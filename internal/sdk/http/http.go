@@ -4,39 +4,72 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
+
+	"golang.org/x/net/http/httpproxy"
 )
 
 // NewClient creates an *http.Client that clones http.DefaultTransport settings
 // and applies the given TLS configuration. This avoids duplicating transport
-// setup across provider, auth, and crypto packages.
-func NewClient(rootCAs *x509.CertPool, certs ...tls.Certificate) (*http.Client, error) {
+// setup across provider, auth, and crypto packages. Each request is bounded by
+// policy.RequestTimeout (0 means none), goes through the policy Limiter,
+// Breaker and Proxy, and carries the policy Headers; certs come before the
+// policy ClientCertificates.
+func NewClient(policy TransportPolicy, rootCAs *x509.CertPool, certs ...tls.Certificate) (*http.Client, error) {
 	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
 	if !ok {
 		return nil, fmt.Errorf("failed to get default HTTP transport")
 	}
 
-	return &http.Client{
-		Transport: &http.Transport{
-			Proxy:                 defaultTransport.Proxy,
-			DialContext:           defaultTransport.DialContext,
-			ForceAttemptHTTP2:     defaultTransport.ForceAttemptHTTP2,
-			MaxIdleConns:          defaultTransport.MaxIdleConns,
-			IdleConnTimeout:       defaultTransport.IdleConnTimeout,
-			TLSHandshakeTimeout:   defaultTransport.TLSHandshakeTimeout,
-			ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
-			TLSClientConfig: &tls.Config{
-				RootCAs:      rootCAs,
-				Certificates: certs,
-			},
+	proxy := policy.Proxy
+	if proxy == nil {
+		proxy = defaultTransport.Proxy
+	}
+	var transport http.RoundTripper = &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           defaultTransport.DialContext,
+		ForceAttemptHTTP2:     defaultTransport.ForceAttemptHTTP2,
+		MaxIdleConns:          defaultTransport.MaxIdleConns,
+		IdleConnTimeout:       defaultTransport.IdleConnTimeout,
+		TLSHandshakeTimeout:   defaultTransport.TLSHandshakeTimeout,
+		ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
+		TLSClientConfig: &tls.Config{
+			RootCAs:      rootCAs,
+			Certificates: append(append([]tls.Certificate{}, certs...), policy.ClientCertificates...),
 		},
-		Timeout: time.Minute,
+	}
+	if len(policy.Headers) > 0 {
+		transport = &headerTransport{next: transport, headers: policy.Headers}
+	}
+
+	return &http.Client{
+		Transport: &retryAfterTransport{next: &guardTransport{limiter: policy.Limiter, breaker: policy.Breaker, next: transport}},
+		Timeout:   policy.RequestTimeout,
 	}, nil
 }
 
+// headerTransport adds static headers to each request.
+type headerTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+	return t.next.RoundTrip(req)
+}
+
 func Do(
 	ctx context.Context,
 	httpClient *http.Client,
@@ -63,12 +96,64 @@ func Do(
 		return nil, err
 	}
 	if res.StatusCode != http.StatusOK {
-		safeURL := SanitizeRequestURL(url)
-		preview := PreviewBodyForError(body)
-		if preview == "" {
-			return nil, fmt.Errorf("%s %s resulted in %s", method, safeURL, res.Status)
+		statusErr := &StatusError{
+			Method:     method,
+			URL:        SanitizeRequestURL(url),
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			Preview:    PreviewBodyForError(body),
+		}
+		if retryAfterStatus(res.StatusCode) {
+			statusErr.RetryAfter, _ = ParseRetryAfter(res.Header.Get("Retry-After"), time.Now())
 		}
-		return nil, fmt.Errorf("%s %s resulted in %s: %s", method, safeURL, res.Status, preview)
+		return nil, statusErr
 	}
 	return body, nil
 }
+
+// StatusError is returned by Do for a non-200 response.
+type StatusError struct {
+	Method string
+	// URL is sanitized, see SanitizeRequestURL.
+	URL        string
+	StatusCode int
+	Status     string
+	// Preview is a bounded excerpt of the response body, see PreviewBodyForError.
+	Preview string
+	// RetryAfter is the delay requested by a 429/503 Retry-After header, if any.
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	if e.Preview == "" {
+		return fmt.Sprintf("%s %s resulted in %s", e.Method, e.URL, e.Status)
+	}
+	return fmt.Sprintf("%s %s resulted in %s: %s", e.Method, e.URL, e.Status, e.Preview)
+}
+
+// ProxyFunc returns a TransportPolicy.Proxy sending every request through
+// proxyURL except those to the hosts matched by noProxy, a comma-separated list
+// in the NO_PROXY format (e.g. "localhost,.internal,10.0.0.0/8"). An empty
+// noProxy falls back to the NO_PROXY env var.
+func ProxyFunc(proxyURL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		// Not %w: the *url.Error would print the URL, credentials included.
+		return nil, fmt.Errorf("invalid proxy URL: %v", errors.Unwrap(err))
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: the scheme must be http, https or socks5", parsed.Redacted())
+	}
+	if parsed.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", parsed.Redacted())
+	}
+	if noProxy == "" {
+		noProxy = httpproxy.FromEnvironment().NoProxy
+	}
+	proxy := (&httpproxy.Config{HTTPProxy: proxyURL, HTTPSProxy: proxyURL, NoProxy: noProxy}).ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
@@ -0,0 +1,234 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Defaults of the limits the provider applies when its transport block
+// doesn't set them. The concurrency limit and the circuit breaker are opt-in:
+// 0 disables them.
+const (
+	DefaultMaxConcurrentRequests   = 0
+	DefaultCircuitBreakerThreshold = 0
+	DefaultCircuitBreakerCooldown  = 30 * time.Second
+)
+
+// Limiter bounds the requests to Altinity.Cloud in flight at once and,
+// optionally, their rate. One Limiter is shared by every client of a provider
+// instance, so Terraform's parallel resource operations queue up instead of
+// bursting into 429s.
+type Limiter struct {
+	slots  chan struct{}
+	bucket *rate.Limiter
+}
+
+// NewLimiter returns a limiter allowing maxInFlight concurrent requests (0 for
+// no limit) and, if requestsPerSecond > 0, at most requestsPerSecond requests
+// per second on average with bursts of up to burst requests.
+func NewLimiter(maxInFlight int, requestsPerSecond float64, burst int) *Limiter {
+	l := &Limiter{}
+	if maxInFlight > 0 {
+		l.slots = make(chan struct{}, maxInFlight)
+	}
+	if requestsPerSecond > 0 {
+		if burst < 1 {
+			burst = 1
+		}
+		l.bucket = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	return l
+}
+
+// Acquire waits for a request slot and token, or until ctx is done, and
+// returns the function releasing the slot.
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	if l.bucket != nil {
+		if err := l.bucket.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	var once sync.Once
+	return func() { once.Do(func() { <-l.slots }) }, nil
+}
+
+// ErrCircuitOpen is matched (errors.Is) by the error of a request refused by an
+// open CircuitBreaker.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// CircuitOpenError is returned instead of sending a request while the
+// CircuitBreaker is open.
+type CircuitOpenError struct {
+	// Failures is the number of consecutive failures that opened the circuit.
+	Failures int
+	// RetryIn is the time left until a request is let through again.
+	RetryIn time.Duration
+	// LastError describes the failure that opened the circuit.
+	LastError string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("Altinity.Cloud API is unavailable: not sending the request after %d consecutive failed requests (last: %s); "+
+		"requests are let through again in %s. Check your network and the Altinity.Cloud status, then run Terraform again",
+		e.Failures, e.LastError, e.RetryIn.Round(time.Second))
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreaker stops sending requests after threshold consecutive failures
+// (5xx responses or transport errors) and fails them fast for cooldown. Then a
+// single trial request is let through: its success closes the circuit, its
+// failure opens it again.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	lastError string
+	openedAt  time.Time
+	// trial is set while the trial request after a cooldown is in flight.
+	trial bool
+}
+
+// NewCircuitBreaker returns a closed circuit breaker, or nil (never trips) if
+// threshold is 0.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// Allow returns a *CircuitOpenError if a request must not be sent now.
+func (b *CircuitBreaker) Allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+	if elapsed := b.now().Sub(b.openedAt); elapsed < b.cooldown || b.trial {
+		retryIn := b.cooldown - elapsed
+		if retryIn < 0 {
+			retryIn = 0
+		}
+		return &CircuitOpenError{Failures: b.failures, RetryIn: retryIn, LastError: b.lastError}
+	}
+	b.trial = true
+	return nil
+}
+
+// Record reports the outcome of a request let through by Allow: err is the
+// failure, nil for a success.
+func (b *CircuitBreaker) Record(err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+	if err == nil {
+		b.failures = 0
+		b.lastError = ""
+		return
+	}
+	b.failures++
+	b.lastError = err.Error()
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
+
+// skip reports that a request let through by Allow was not completed (e.g.
+// canceled), so it counts neither as a success nor as a failure.
+func (b *CircuitBreaker) skip() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
+
+// guardTransport applies the policy Limiter and CircuitBreaker to each request.
+type guardTransport struct {
+	next    http.RoundTripper
+	limiter *Limiter
+	breaker *CircuitBreaker
+}
+
+func (t *guardTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.Allow(); err != nil {
+		return nil, err
+	}
+	release, err := t.limiter.Acquire(req.Context())
+	if err != nil {
+		t.breaker.skip()
+		return nil, err
+	}
+
+	res, err := t.next.RoundTrip(req)
+	switch {
+	case err != nil:
+		release()
+		// A request canceled by its caller says nothing about the API; one that
+		// timed out does.
+		if errors.Is(req.Context().Err(), context.Canceled) {
+			t.breaker.skip()
+		} else {
+			t.breaker.Record(err)
+		}
+		return nil, err
+	case res.StatusCode >= http.StatusInternalServerError:
+		t.breaker.Record(fmt.Errorf("%s %s resulted in %s", req.Method, SanitizeRequestURL(req.URL.String()), res.Status))
+	default:
+		t.breaker.Record(nil)
+	}
+	// The slot is held until the response body is read.
+	res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF {
+		r.release()
+	}
+	return n, err
+}
+
+func (r *releaseOnClose) Close() error {
+	r.release()
+	return r.ReadCloser.Close()
+}
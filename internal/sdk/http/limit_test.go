@@ -0,0 +1,176 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiterBoundsRequestsInFlight(t *testing.T) {
+	t.Parallel()
+	var inFlight, peak int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(TransportPolicy{RequestTimeout: 5 * time.Second, Limiter: NewLimiter(2, 0, 0)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := Do(context.Background(), client, http.MethodGet, srv.URL, nil, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	t.Parallel()
+	limiter := NewLimiter(0, 20, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		release, err := limiter.Acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+	// The first token is free, the next 4 come every 50ms.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("expected the rate to be limited to 20/s, 5 requests took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewLimiter(1, 0.001, 1).Acquire(ctx); err == nil {
+		t.Error("expected a canceled context to stop the wait")
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	breaker := NewCircuitBreaker(3, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	boom := errors.New("HTTP 502")
+	for i := 0; i < 2; i++ {
+		if err := breaker.Allow(); err != nil {
+			t.Fatalf("expected the circuit to be closed, got %s", err)
+		}
+		breaker.Record(boom)
+	}
+	breaker.Record(nil)
+	for i := 0; i < 3; i++ {
+		if err := breaker.Allow(); err != nil {
+			t.Fatalf("a success should reset the failure count, got %s", err)
+		}
+		breaker.Record(boom)
+	}
+
+	err := breaker.Allow()
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) || !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the circuit to be open after 3 failures, got %v", err)
+	}
+	if openErr.Failures != 3 || openErr.RetryIn != time.Minute || openErr.LastError != "HTTP 502" {
+		t.Errorf("unexpected error details: %+v", openErr)
+	}
+
+	now = now.Add(time.Minute)
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected a trial request after the cooldown, got %s", err)
+	}
+	if err := breaker.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatal("expected a single trial request")
+	}
+	breaker.Record(boom)
+	if err := breaker.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatal("expected a failed trial to open the circuit again")
+	}
+
+	now = now.Add(time.Minute)
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected a trial request after the cooldown, got %s", err)
+	}
+	breaker.Record(nil)
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("expected a successful trial to close the circuit, got %s", err)
+	}
+
+	if NewCircuitBreaker(0, time.Minute).Allow() != nil {
+		t.Error("a zero threshold should disable the circuit breaker")
+	}
+}
+
+func TestCircuitBreakerFailsFast(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/notfound" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(srv.Close)
+
+	policy := TransportPolicy{MaxRetries: 5, Breaker: NewCircuitBreaker(2, time.Hour)}
+	client, err := NewClient(policy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 4xx responses are the caller's problem, not an outage.
+	for i := 0; i < 3; i++ {
+		_, _ = Do(context.Background(), client, http.MethodGet, srv.URL+"/notfound", nil, nil)
+	}
+	if err := policy.Breaker.Allow(); err != nil {
+		t.Fatalf("4xx responses should not trip the circuit, got %s", err)
+	}
+
+	_, err = DoWithRetry(context.Background(), client, policy, http.MethodGet, srv.URL, nil, nil)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the retries to stop at the open circuit, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 5 {
+		t.Errorf("expected 3 + 2 requests to reach the server, got %d", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	breaker := NewCircuitBreaker(1, time.Hour)
+	canceled, _ := NewClient(TransportPolicy{Breaker: breaker}, nil)
+	_, _ = Do(ctx, canceled, http.MethodGet, srv.URL, nil, nil)
+	if err := breaker.Allow(); err != nil {
+		t.Errorf("a canceled request should not trip the circuit, got %s", err)
+	}
+}
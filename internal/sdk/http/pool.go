@@ -0,0 +1,54 @@
+package http
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net/http"
+	"sync"
+)
+
+// ClientPool hands out one *http.Client per client certificate, so repeated
+// requests reuse connections instead of doing a TLS handshake each. The zero
+// value is ready to use.
+type ClientPool struct {
+	mu      sync.Mutex
+	clients map[string]*http.Client
+}
+
+// Client returns the pooled client for cert (nil for none), creating it with
+// NewClient(policy, rootCAs, cert) on first use.
+func (p *ClientPool) Client(policy TransportPolicy, rootCAs *x509.CertPool, cert *tls.Certificate) (*http.Client, error) {
+	key := CertificateFingerprint(cert)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if client, ok := p.clients[key]; ok {
+		return client, nil
+	}
+
+	var certs []tls.Certificate
+	if cert != nil {
+		certs = append(certs, *cert)
+	}
+	client, err := NewClient(policy, rootCAs, certs...)
+	if err != nil {
+		return nil, err
+	}
+	if p.clients == nil {
+		p.clients = map[string]*http.Client{}
+	}
+	p.clients[key] = client
+	return client, nil
+}
+
+// CertificateFingerprint returns the hex SHA-256 of the leaf certificate of
+// cert, or "" for nil or an empty certificate.
+func CertificateFingerprint(cert *tls.Certificate) string {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(cert.Certificate[0])
+	return hex.EncodeToString(sum[:])
+}
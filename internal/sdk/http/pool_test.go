@@ -0,0 +1,30 @@
+package http
+
+import (
+	"crypto/tls"
+	"testing"
+)
+
+func TestClientPool(t *testing.T) {
+	t.Parallel()
+	var pool ClientPool
+
+	plain, err := pool.Client(DefaultTransportPolicy(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := pool.Client(DefaultTransportPolicy(), nil, nil)
+	if plain != again {
+		t.Error("expected the client without certificate to be reused")
+	}
+
+	acme := &tls.Certificate{Certificate: [][]byte{[]byte("acme")}}
+	withCert, _ := pool.Client(DefaultTransportPolicy(), nil, acme)
+	if withCert == plain {
+		t.Error("expected a separate client per certificate")
+	}
+	sameCert, _ := pool.Client(DefaultTransportPolicy(), nil, &tls.Certificate{Certificate: [][]byte{[]byte("acme")}})
+	if sameCert != withCert {
+		t.Error("expected clients to be pooled by certificate, not by pointer")
+	}
+}
@@ -0,0 +1,262 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TransportPolicy controls how requests to Altinity.Cloud are sent, timed out
+// and retried. It is shared by the GraphQL client (client.WithRetryPolicy) and
+// the raw HTTP calls made by auth and crypto (DoWithRetry), whose clients are
+// all built by NewClient.
+type TransportPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry; it doubles on every
+	// retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter randomizes each backoff by up to this fraction (0.2 = ±20%), so
+	// concurrent resources don't retry in lockstep.
+	Jitter float64
+	// RequestTimeout bounds a single attempt, see http.Client.Timeout.
+	RequestTimeout time.Duration
+	// ExtraRetryableStatusCodes are retried on top of DefaultRetryableStatusCodes.
+	ExtraRetryableStatusCodes []int
+	// Limiter and Breaker, if set, are applied to every request of the clients
+	// built from the policy (NewClient); copies of the policy share them.
+	Limiter *Limiter
+	Breaker *CircuitBreaker
+	// Proxy returns the proxy of each request, see http.Transport.Proxy. Nil
+	// means http.ProxyFromEnvironment.
+	Proxy func(*http.Request) (*url.URL, error)
+	// ClientCertificates are offered to servers asking for a client
+	// certificate, e.g. an mTLS gateway, after the certificates passed to
+	// NewClient.
+	ClientCertificates []tls.Certificate
+	// Headers are set on every request that doesn't already have them.
+	Headers map[string]string
+}
+
+// DefaultRetryableStatusCodes are HTTP status codes that indicate a transient
+// server-side failure worth retrying.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DefaultTransportPolicy returns the policy used when the provider has no
+// transport block.
+func DefaultTransportPolicy() TransportPolicy {
+	return TransportPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Jitter:         0.2,
+		RequestTimeout: time.Minute,
+	}
+}
+
+// RetryableStatus reports whether a response with status code should be retried.
+func (p TransportPolicy) RetryableStatus(code int) bool {
+	for _, c := range DefaultRetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	for _, c := range p.ExtraRetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the wait before retry number attempt (0-based). A
+// Retry-After sent by the server (retryAfter > 0) takes precedence over the
+// computed backoff, but is capped by MaxBackoff too: a server asking for an
+// hour must not stall a plan for that long.
+func (p TransportPolicy) Backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if p.MaxBackoff > 0 && retryAfter > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return retryAfter
+	}
+	backoff := p.InitialBackoff
+	for i := 0; i < attempt && (p.MaxBackoff <= 0 || backoff < p.MaxBackoff); i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		backoff += time.Duration((rand.Float64()*2 - 1) * p.Jitter * float64(backoff))
+	}
+	return backoff
+}
+
+// Sleep waits for d or until ctx is done, whichever comes first.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// ParseRetryAfter parses a Retry-After header value, either delay-seconds or
+// an HTTP date, relative to now.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if d := date.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// retryAfterStatus reports whether a Retry-After header on a response with
+// status code is a throttling hint to honour.
+func retryAfterStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable
+}
+
+type retryAfterKey struct{}
+
+type retryAfterHolder struct {
+	mu    sync.Mutex
+	delay time.Duration
+}
+
+// RecordRetryAfter returns a copy of ctx in which clients built by NewClient
+// record the Retry-After header of 429/503 responses, and a function returning
+// the delay of the latest one (0 if none). It lets GraphQL interceptors, which
+// only see the parsed error, honour the header.
+func RecordRetryAfter(ctx context.Context) (context.Context, func() time.Duration) {
+	holder := &retryAfterHolder{}
+	return context.WithValue(ctx, retryAfterKey{}, holder), func() time.Duration {
+		holder.mu.Lock()
+		defer holder.mu.Unlock()
+		delay := holder.delay
+		holder.delay = 0
+		return delay
+	}
+}
+
+// retryAfterTransport feeds RecordRetryAfter.
+type retryAfterTransport struct {
+	next http.RoundTripper
+}
+
+func (t *retryAfterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil || !retryAfterStatus(res.StatusCode) {
+		return res, err
+	}
+	if holder, ok := req.Context().Value(retryAfterKey{}).(*retryAfterHolder); ok {
+		if delay, ok := ParseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+			holder.mu.Lock()
+			holder.delay = delay
+			holder.mu.Unlock()
+		}
+	}
+	return res, nil
+}
+
+// transientPatterns match transport errors (no HTTP response received) that
+// may go away on their own. Errors surfaced by the GraphQL client are plain
+// wrapped strings, so the message is all there is to inspect.
+var transientPatterns = []string{
+	"connection reset",
+	"connection refused",
+	"i/o timeout",
+	"TLS handshake timeout",
+	"EOF",
+}
+
+// IsTransientTransportError reports whether err is a transport error worth
+// retrying: a network timeout, a reset or refused connection or a connection
+// closed mid-response. Anything else, such as an untrusted certificate, an
+// unknown host or a malformed URL, fails the same way every time.
+func IsTransientTransportError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	msg := err.Error()
+	for _, pattern := range transientPatterns {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// DoWithRetry is Do retried according to policy: retryable status codes and
+// transient transport errors (see IsTransientTransportError) are retried,
+// honouring Retry-After. Other errors are returned at once. Only use it for
+// requests that are safe to repeat.
+func DoWithRetry(
+	ctx context.Context,
+	httpClient *http.Client,
+	policy TransportPolicy,
+	method, url string,
+	headers map[string]string,
+	requestBody []byte) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		var reader io.Reader
+		if requestBody != nil {
+			reader = bytes.NewReader(requestBody)
+		}
+		body, err := Do(ctx, httpClient, method, url, headers, reader)
+		if err == nil || attempt >= policy.MaxRetries || ctx.Err() != nil || errors.Is(err, ErrCircuitOpen) {
+			return body, err
+		}
+
+		var retryAfter time.Duration
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) {
+			if !policy.RetryableStatus(statusErr.StatusCode) {
+				return nil, err
+			}
+			retryAfter = statusErr.RetryAfter
+		} else if !IsTransientTransportError(err) {
+			return nil, err
+		}
+
+		if sleepErr := Sleep(ctx, policy.Backoff(attempt, retryAfter)); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := map[string]struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		"empty":         {value: ""},
+		"seconds":       {value: "7", want: 7 * time.Second, wantOK: true},
+		"negative":      {value: "-1"},
+		"http date":     {value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, wantOK: true},
+		"date in past":  {value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, wantOK: true},
+		"not a seconds": {value: "soon"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := ParseRetryAfter(tc.value, now)
+			if ok != tc.wantOK || got != tc.want {
+				t.Errorf("ParseRetryAfter(%q) = %s, %v; want %s, %v", tc.value, got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestTransportPolicyBackoff(t *testing.T) {
+	t.Parallel()
+	policy := TransportPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := policy.Backoff(attempt, 0); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+	if got := policy.Backoff(3, 2*time.Second); got != 2*time.Second {
+		t.Errorf("Retry-After should take precedence over the computed backoff, got %s", got)
+	}
+	if got := policy.Backoff(0, time.Hour); got != 5*time.Second {
+		t.Errorf("Retry-After should be capped by max_backoff, got %s", got)
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := policy.Backoff(1, 0); got < time.Second || got > 3*time.Second {
+			t.Fatalf("Backoff with jitter out of range: %s", got)
+		}
+	}
+}
+
+func TestTransportPolicyRetryableStatus(t *testing.T) {
+	t.Parallel()
+	policy := TransportPolicy{ExtraRetryableStatusCodes: []int{500}}
+	for code, want := range map[int]bool{429: true, 502: true, 503: true, 504: true, 500: true, 400: false, 404: false} {
+		if got := policy.RetryableStatus(code); got != want {
+			t.Errorf("RetryableStatus(%d) = %v, want %v", code, got, want)
+		}
+	}
+	if DefaultTransportPolicy().RetryableStatus(500) {
+		t.Error("500 should not be retried by default")
+	}
+}
+
+func TestIsTransientTransportError(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":                {nil, false},
+		"connection reset":   {errors.New("read tcp: connection reset by peer"), true},
+		"connection refused": {errors.New("dial tcp: connect: connection refused"), true},
+		"timeout":            {&url.Error{Op: "Get", URL: "https://x", Err: timeoutError{}}, true},
+		"EOF":                {fmt.Errorf("reading body: %w", io.ErrUnexpectedEOF), true},
+		"unknown authority":  {errors.New("tls: failed to verify certificate: x509: certificate signed by unknown authority"), false},
+		"no such host":       {errors.New("dial tcp: lookup api.invalid: no such host"), false},
+		"unsupported scheme": {errors.New(`unsupported protocol scheme "htps"`), false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsTransientTransportError(tt.err); got != tt.want {
+				t.Errorf("IsTransientTransportError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "deadline reached" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return false }
+
+func TestDoWithRetry(t *testing.T) {
+	t.Parallel()
+	policy := TransportPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond}
+
+	t.Run("retries retryable status and replays body", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write(body)
+		}))
+		t.Cleanup(srv.Close)
+
+		got, err := DoWithRetry(context.Background(), srv.Client(), policy, http.MethodPost, srv.URL, nil, []byte("csr"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(got) != "csr" || calls.Load() != 3 {
+			t.Errorf("got %q after %d calls, want %q after 3", got, calls.Load(), "csr")
+		}
+	})
+
+	t.Run("does not retry other status codes", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		t.Cleanup(srv.Close)
+
+		_, err := DoWithRetry(context.Background(), srv.Client(), policy, http.MethodGet, srv.URL, nil, nil)
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden {
+			t.Fatalf("expected a 403 StatusError, got %v", err)
+		}
+		if calls.Load() != 1 {
+			t.Errorf("expected 1 call, got %d", calls.Load())
+		}
+	})
+
+	t.Run("does not retry an untrusted certificate", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+		}))
+		t.Cleanup(srv.Close)
+
+		slow := policy
+		slow.InitialBackoff = time.Hour
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err := DoWithRetry(ctx, &http.Client{}, slow, http.MethodGet, srv.URL, nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "certificate") {
+			t.Fatalf("expected a certificate error, got %v", err)
+		}
+		if ctx.Err() != nil {
+			t.Errorf("expected to fail fast, waited for a retry")
+		}
+		if calls.Load() != 0 {
+			t.Errorf("expected no request to reach the handler, got %d", calls.Load())
+		}
+	})
+
+	t.Run("honours Retry-After", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}))
+		t.Cleanup(srv.Close)
+
+		start := time.Now()
+		if _, err := DoWithRetry(context.Background(), srv.Client(), policy, http.MethodGet, srv.URL, nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < time.Second {
+			t.Errorf("expected to wait for Retry-After, retried after %s", elapsed)
+		}
+	})
+
+	t.Run("caps a large Retry-After at max_backoff", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.Header().Set("Retry-After", "3600")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("ok"))
+		}))
+		t.Cleanup(srv.Close)
+
+		capped := policy
+		capped.MaxBackoff = 50 * time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := DoWithRetry(ctx, srv.Client(), capped, http.MethodGet, srv.URL, nil, nil); err != nil {
+			t.Fatalf("expected a retry after max_backoff, got %v", err)
+		}
+		if calls.Load() != 2 {
+			t.Errorf("expected 2 calls, got %d", calls.Load())
+		}
+	})
+}
+
+func TestRecordRetryAfter(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(TransportPolicy{RequestTimeout: time.Second}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, retryAfter := RecordRetryAfter(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+
+	if got := retryAfter(); got != 3*time.Second {
+		t.Errorf("expected a recorded Retry-After of 3s, got %s", got)
+	}
+	if got := retryAfter(); got != 0 {
+		t.Errorf("the recorded delay should be consumed, got %s", got)
+	}
+}
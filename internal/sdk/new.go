@@ -0,0 +1,92 @@
+package sdk
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/auth"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/credentials"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/crypto"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+)
+
+// GraphQLPath is the path of the GraphQL endpoint under the API URL.
+const GraphQLPath = "/api/v1/graphql"
+
+// ErrMissingToken is returned by New without a token source.
+var ErrMissingToken = errors.New("altinitycloud: an API token is required")
+
+// Config configures the clients built by New. Package altinitycloud builds its
+// clients with it, and the provider builds its clients with package
+// altinitycloud, so they behave the same.
+type Config struct {
+	APIURL    string
+	Token     credentials.TokenSource
+	RootCAs   *x509.CertPool
+	Transport sdkHttp.TransportPolicy
+	ReadOnly  bool
+	AuditLog  *client.AuditLog
+	UserAgent string
+	// QueryCacheTTL is how long identical queries share a response, 0 to not
+	// share them beyond concurrent calls.
+	QueryCacheTTL time.Duration
+}
+
+// ClientOf returns the clients of an *altinitycloud.Client, which keeps them
+// unexported. Package altinitycloud, which imports this one, sets it.
+var ClientOf func(client any) *AltinityCloudSDK
+
+// New returns the clients of the API configured by cfg. Defaults and Policy
+// are left for the caller to set.
+func New(cfg Config) (*AltinityCloudSDK, error) {
+	if cfg.Token == nil {
+		return nil, ErrMissingToken
+	}
+
+	httpClient, err := sdkHttp.NewClient(cfg.Transport, cfg.RootCAs)
+	if err != nil {
+		return nil, fmt.Errorf("altinitycloud: configure HTTP client: %w", err)
+	}
+
+	interceptors := []clientv2.RequestInterceptor{
+		client.WithRetryPolicy(cfg.Transport),
+		client.WithTokenSource(cfg.Token),
+		client.WithUserAgent(context.Background(), cfg.UserAgent),
+		// After the retries and headers, so each attempt is logged as sent.
+		client.WithDebugLogging(),
+		// Innermost, so the other interceptors and all callers see *client.APIError.
+		client.WithAPIErrors(),
+	}
+	// Outside the retries, so a query shared by concurrent readers is retried once.
+	queryCache := client.NewQueryCache(cfg.QueryCacheTTL)
+	interceptors = append([]clientv2.RequestInterceptor{client.WithQueryCache(queryCache)}, interceptors...)
+	// Outside the cache, so operations served from it still show in traces.
+	interceptors = append([]clientv2.RequestInterceptor{client.WithTracing()}, interceptors...)
+	if cfg.AuditLog != nil {
+		interceptors = append([]clientv2.RequestInterceptor{client.WithAudit(cfg.AuditLog)}, interceptors...)
+	}
+	if cfg.ReadOnly {
+		interceptors = append([]clientv2.RequestInterceptor{client.WithReadOnly()}, interceptors...)
+	}
+
+	gqlClient := client.NewClient(httpClient, cfg.APIURL+GraphQLPath, nil, interceptors...)
+
+	authClient := auth.NewAuth(cfg.RootCAs, cfg.APIURL, cfg.Token)
+	authClient.ReadOnly = cfg.ReadOnly
+	authClient.Audit = cfg.AuditLog
+	authClient.Transport = cfg.Transport
+	cryptoClient := crypto.NewCrypto(cfg.RootCAs, cfg.APIURL)
+	cryptoClient.Transport = cfg.Transport
+
+	return &AltinityCloudSDK{
+		Client: gqlClient,
+		Auth:   authClient,
+		Crypto: cryptoClient,
+		Cache:  queryCache,
+	}, nil
+}
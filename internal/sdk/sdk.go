@@ -10,4 +10,39 @@ type AltinityCloudSDK struct {
 	Client *client.Client
 	Auth   *auth.Auth
 	Crypto *crypto.Crypto
+	// Cache is shared by every resource and data source of the provider instance.
+	Cache *client.QueryCache
+	// Defaults are merged into the spec of every env resource.
+	Defaults Defaults
+	// Policy is checked by every env resource at plan time.
+	Policy Policy
+}
+
+// Defaults are the tags, labels and maintenance windows of the provider
+// defaults block. The env resources add them to their own, which win on
+// conflicts.
+type Defaults struct {
+	Tags               []*client.KeyValueInput
+	Labels             []*client.KeyValueInput
+	MaintenanceWindows []*client.MaintenanceWindowSpecInput
+}
+
+// Policy are the rules of the provider policy block. Zero values don't
+// restrict anything.
+type Policy struct {
+	// AllowedRegions are the allowed regions, or HCloud network zones, by cloud
+	// (aws, aws_hosted, azure, gcp, hcloud). Clouds without an entry are not
+	// restricted.
+	AllowedRegions     map[string][]string
+	MaxCapacityPerZone int64
+	MaxTotalNodes      int64
+	// RequiredTagKeys must be among the tags of AWS and Azure envs and the
+	// labels of GCP envs, provider defaults included.
+	RequiredTagKeys []string
+	// ForbiddenPublicSourceRanges are the CIDRs a public load balancer must not
+	// allow traffic from, i.e. no source range may contain one of them.
+	ForbiddenPublicSourceRanges []string
+	AllowedNodeTypes            []string
+	// RequireMonitoring requires Datadog or the metrics endpoint enabled.
+	RequireMonitoring bool
 }
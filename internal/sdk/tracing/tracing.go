@@ -0,0 +1,150 @@
+// Package tracing sets up optional OpenTelemetry tracing for the provider.
+//
+// Tracing is off unless an OTLP endpoint is configured with the standard
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT env vars;
+// the exporter then reads the rest of its settings (headers, TLS, timeouts)
+// from the other OTEL_* env vars. Only the http/protobuf protocol is
+// supported, which keeps gRPC out of the provider binary. Until Setup installs
+// a tracer provider, every span is a no-op.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName identifies the spans of this provider.
+const TracerName = "github.com/altinity/terraform-provider-altinitycloud"
+
+// Span attributes set by the provider.
+const (
+	AttrEnvName      = attribute.Key("altinitycloud.env.name")
+	AttrOperation    = attribute.Key("altinitycloud.operation")
+	AttrSpecRevision = attribute.Key("altinitycloud.spec_revision")
+	AttrResourceType = attribute.Key("altinitycloud.resource_type")
+)
+
+// BatchTimeout is how long ended spans wait to be exported, unless set with
+// OTEL_BSP_SCHEDULE_DELAY. It is shorter than the SDK default of 5s because
+// Terraform kills the provider 2s after asking it to stop: spans exported as
+// they go don't depend on the final flush.
+const BatchTimeout = time.Second
+
+// Tracer returns the provider tracer from the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// parent is the trace context read from the environment by Setup. Terraform
+// doesn't pass one to providers, so root spans are attached to it instead.
+var parent trace.SpanContext
+
+// Start starts a span, see trace.Tracer.Start. Spans without a parent in ctx
+// become children of the TRACEPARENT trace, if any.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if parent.IsValid() && !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, parent)
+	}
+	return Tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End records err, if any, on span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Enabled reports whether the OTEL_* env vars ask for traces to be exported.
+func Enabled() bool {
+	if disabled, _ := strconv.ParseBool(os.Getenv("OTEL_SDK_DISABLED")); disabled {
+		return false
+	}
+	if exporter := os.Getenv("OTEL_TRACES_EXPORTER"); exporter != "" && exporter != "otlp" {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Setup installs a global tracer provider exporting to the configured OTLP
+// endpoint, if Enabled, and returns a function flushing and stopping it. A
+// TRACEPARENT env var (as set by CI systems that trace their pipelines) makes
+// the provider spans children of that trace.
+func Setup(ctx context.Context, version string) (func(context.Context) error, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create OTLP trace exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName("terraform-provider-altinitycloud"),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("create trace resource: %w", err)
+	}
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES win over the defaults.
+	if fromEnv, err := resource.New(ctx, resource.WithFromEnv()); err == nil {
+		if merged, err := resource.Merge(res, fromEnv); err == nil {
+			res = merged
+		}
+	}
+
+	var batchOptions []sdktrace.BatchSpanProcessorOption
+	if os.Getenv("OTEL_BSP_SCHEDULE_DELAY") == "" {
+		batchOptions = append(batchOptions, sdktrace.WithBatchTimeout(BatchTimeout))
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter, batchOptions...), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	otel.SetTextMapPropagator(propagator)
+
+	parent = trace.SpanContextFromContext(propagator.Extract(ctx, envCarrier{}))
+	return provider.Shutdown, nil
+}
+
+// newExporter returns the OTLP/HTTP exporter, after checking that
+// OTEL_EXPORTER_OTLP_(TRACES_)PROTOCOL doesn't ask for another transport.
+func newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	if protocol != "" && protocol != "http/protobuf" {
+		return nil, fmt.Errorf("unsupported OTLP protocol %q (supported: http/protobuf)", protocol)
+	}
+	return otlptracehttp.New(ctx)
+}
+
+// envCarrier reads trace context from the TRACEPARENT, TRACESTATE and BAGGAGE
+// env vars.
+type envCarrier struct{}
+
+func (envCarrier) Get(key string) string {
+	return os.Getenv(strings.ToUpper(key))
+}
+
+func (envCarrier) Set(string, string) {}
+
+func (envCarrier) Keys() []string {
+	return []string{"traceparent", "tracestate", "baggage"}
+}
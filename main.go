@@ -4,8 +4,14 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/altinity/terraform-provider-altinitycloud/internal/provider"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/tracing"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -24,7 +30,36 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	ctx := context.Background()
+	shutdownTracing, err := tracing.Setup(ctx, version)
+	if err != nil {
+		log.Printf("[WARN] OpenTelemetry tracing disabled: %s", err)
+		shutdownTracing = func(context.Context) error { return nil }
+	}
+
+	// Flush the spans still batched before exiting, also when stopped with
+	// SIGTERM. Terraform kills the provider 2s after asking it to stop, so the
+	// flush must not take longer.
+	var flushOnce sync.Once
+	flushTracing := func() {
+		flushOnce.Do(func() {
+			shutdownCtx, cancel := context.WithTimeout(ctx, 1500*time.Millisecond)
+			defer cancel()
+			if err := shutdownTracing(shutdownCtx); err != nil {
+				log.Printf("[WARN] failed to flush OpenTelemetry spans: %s", err)
+			}
+		})
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM)
+	go func() {
+		<-signals
+		flushTracing()
+		os.Exit(1)
+	}()
+
+	err = providerserver.Serve(ctx, provider.New(version), opts)
+	flushTracing()
 
 	if err != nil {
 		log.Fatal(err.Error())
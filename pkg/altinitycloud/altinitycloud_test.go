@@ -0,0 +1,204 @@
+package altinitycloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// graphQLServer answers every query with the response of respond for the
+// operation name.
+func graphQLServer(t *testing.T, respond func(r *http.Request, operation string) string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != GraphQLPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var body struct {
+			OperationName string `json:"operationName"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(respond(r, body.OperationName)))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewRequiresToken(t *testing.T) {
+	t.Parallel()
+	if _, err := New(); !errors.Is(err, ErrMissingToken) {
+		t.Errorf("expected ErrMissingToken, got %v", err)
+	}
+}
+
+func TestGetEnv(t *testing.T) {
+	t.Parallel()
+	srv := graphQLServer(t, func(r *http.Request, operation string) string {
+		if got := r.Header.Get("Authorization"); got != "Bearer t0ken" {
+			t.Errorf("unexpected Authorization header %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "ops-tool/1.0" {
+			t.Errorf("unexpected User-Agent header %q", got)
+		}
+		switch operation {
+		case "GetAWSEnv":
+			return `{"data":{"awsEnv":{"name":"acme","specRevision":3}}}`
+		case "GetGCPEnv":
+			return `{"data":{"gcpEnv":null}}`
+		default:
+			return `{"errors":[{"message":"env not found","extensions":{"code":"NOT_FOUND"}}],"data":null}`
+		}
+	})
+
+	c, err := New(WithAPIURL(srv.URL), WithToken("t0ken"), WithUserAgent("ops-tool/1.0"), WithQueryCacheTTL(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	env, err := c.AWS().Get(context.Background(), "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Name != "acme" || env.SpecRevision != 3 {
+		t.Errorf("unexpected env %+v", env)
+	}
+
+	if _, err := c.GCP().Get(context.Background(), "acme"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a missing env to match ErrNotFound, got %v", err)
+	}
+	_, err = c.Azure().Get(context.Background(), "acme")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a NOT_FOUND error to match ErrNotFound, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Errors[0].Code() != "NOT_FOUND" {
+		t.Errorf("expected an *APIError, got %v", err)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	srv := graphQLServer(t, func(*http.Request, string) string {
+		atomic.AddInt32(&calls, 1)
+		return `{"data":{}}`
+	})
+
+	c, err := New(WithAPIURL(srv.URL), WithToken("t0ken"), WithReadOnly(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.K8S().Delete(context.Background(), DeleteK8SEnvInput{Name: "acme"})
+	var readOnlyErr *ReadOnlyError
+	if !errors.As(err, &readOnlyErr) || !errors.Is(err, ErrReadOnly) {
+		t.Errorf("expected a *ReadOnlyError, got %v", err)
+	}
+	if _, err := c.GenerateCertificate(context.Background(), "acme"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("expected certificates to be refused in read-only mode, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("expected no request to be sent, got %d", calls)
+	}
+}
+
+// statuses is a StatusGetter returning one status, or error, per call and
+// repeating the last one.
+type statuses struct {
+	results []interface{}
+	calls   int
+}
+
+func (s *statuses) EnvStatus(context.Context, string) (*EnvStatus, error) {
+	result := s.results[min(s.calls, len(s.results)-1)]
+	s.calls++
+	if err, ok := result.(error); ok {
+		return nil, err
+	}
+	return result.(*EnvStatus), nil
+}
+
+func TestWaitForSpecRevision(t *testing.T) {
+	t.Parallel()
+	disconnected := []EnvError{{Code: "DISCONNECTED", Message: "not connected"}}
+	tests := []struct {
+		name      string
+		results   []interface{}
+		wantErr   bool
+		wantCalls int
+	}{
+		{
+			name: "applied",
+			results: []interface{}{
+				&EnvStatus{AppliedSpecRevision: 0, Errors: disconnected},
+				&EnvStatus{AppliedSpecRevision: 1},
+				&EnvStatus{AppliedSpecRevision: 2},
+			},
+			wantCalls: 3,
+		},
+		{
+			name: "disconnected after provisioning",
+			results: []interface{}{
+				&EnvStatus{AppliedSpecRevision: 2, Errors: disconnected},
+			},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+		{
+			name:      "not found",
+			results:   []interface{}{ErrNotFound},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			envs := &statuses{results: tt.results}
+			_, err := WaitForSpecRevision(context.Background(), envs, "acme", 2, WithPollInterval(time.Millisecond))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error %v", err)
+			}
+			if envs.calls != tt.wantCalls {
+				t.Errorf("expected %d polls, got %d", tt.wantCalls, envs.calls)
+			}
+		})
+	}
+
+	var provisioningErr *ProvisioningError
+	_, err := WaitForSpecRevision(context.Background(), &statuses{results: []interface{}{
+		&EnvStatus{AppliedSpecRevision: 1, Errors: []EnvError{{Code: "PERMISSION_DENIED", Message: "denied"}}},
+	}}, "acme", 2)
+	if !errors.As(err, &provisioningErr) || provisioningErr.Errors[0].Code != "PERMISSION_DENIED" {
+		t.Errorf("expected a *ProvisioningError, got %v", err)
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	t.Parallel()
+	envs := &statuses{results: []interface{}{
+		&EnvStatus{PendingDelete: true},
+		errors.New("HTTP 502"),
+		ErrNotFound,
+	}}
+	if err := WaitForDeletion(context.Background(), envs, "acme", WithPollInterval(time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	if envs.calls != 3 {
+		t.Errorf("expected transient errors to be polled through, got %d polls", envs.calls)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := WaitForDeletion(ctx, &statuses{results: []interface{}{&EnvStatus{PendingDelete: true}}}, "acme", WithPollInterval(time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the wait to stop with ctx, got %v", err)
+	}
+}
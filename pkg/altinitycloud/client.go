@@ -0,0 +1,318 @@
+// Package altinitycloud is a Go client for the Altinity.Cloud API, the same
+// one the altinitycloud Terraform provider is built on: retries, rate limits,
+// the circuit breaker, read-only mode, the audit log and OpenTelemetry tracing
+// (with the global tracer provider) behave exactly as they do in Terraform.
+//
+//	c, err := altinitycloud.New(altinitycloud.WithToken(os.Getenv("ALTINITYCLOUD_API_TOKEN")))
+//	if err != nil {
+//		return err
+//	}
+//	env, err := c.AWS().Get(ctx, "acme-staging")
+//	if errors.Is(err, altinitycloud.ErrNotFound) {
+//		// ...
+//	}
+package altinitycloud
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"runtime"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/credentials"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+)
+
+// DefaultAPIURL is the Altinity.Cloud API used unless WithAPIURL is given.
+const DefaultAPIURL = "https://anywhere.altinity.cloud"
+
+// GraphQLPath is the path of the GraphQL endpoint under the API URL.
+const GraphQLPath = sdk.GraphQLPath
+
+// DefaultQueryCacheTTL is how long identical queries share a response.
+const DefaultQueryCacheTTL = client.DefaultQueryCacheTTL
+
+// TokenSource returns the API token to send with each request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a token that never changes.
+type StaticToken string
+
+func (t StaticToken) Token(ctx context.Context) (string, error) {
+	return credentials.StaticToken(t).Token(ctx)
+}
+
+// TokenFromFile returns a source reading the token from path, read again
+// whenever the file changes.
+func TokenFromFile(path string) TokenSource {
+	return credentials.NewFileToken(path)
+}
+
+// TokenFromCommand returns a source running command with the system shell and
+// reading the token, or a JSON {"token", "expires_at"} object, from its output.
+func TokenFromCommand(command string) TokenSource {
+	return credentials.NewCommandToken(command)
+}
+
+// TransportPolicy controls timeouts, retries, rate limits and the network
+// settings (proxy, client certificates, extra headers) of every request.
+type TransportPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry; it doubles on every
+	// retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter randomizes each backoff by up to this fraction (0.2 = ±20%).
+	Jitter float64
+	// RequestTimeout bounds a single attempt.
+	RequestTimeout time.Duration
+	// ExtraRetryableStatusCodes are retried on top of 429, 502, 503 and 504.
+	ExtraRetryableStatusCodes []int
+	// Limiter and Breaker, if set, are applied to every request; copies of the
+	// policy share them.
+	Limiter *Limiter
+	Breaker *CircuitBreaker
+	// Proxy returns the proxy of each request, see ProxyFunc. Nil means
+	// http.ProxyFromEnvironment.
+	Proxy func(*http.Request) (*url.URL, error)
+	// ClientCertificates are offered to servers asking for a client
+	// certificate, e.g. an mTLS gateway.
+	ClientCertificates []tls.Certificate
+	// Headers are set on every request that doesn't already have them.
+	Headers map[string]string
+}
+
+// DefaultTransportPolicy returns the policy used unless WithTransportPolicy is
+// given.
+func DefaultTransportPolicy() TransportPolicy {
+	p := sdkHttp.DefaultTransportPolicy()
+	return TransportPolicy{
+		MaxRetries:                p.MaxRetries,
+		InitialBackoff:            p.InitialBackoff,
+		MaxBackoff:                p.MaxBackoff,
+		Jitter:                    p.Jitter,
+		RequestTimeout:            p.RequestTimeout,
+		ExtraRetryableStatusCodes: p.ExtraRetryableStatusCodes,
+		Proxy:                     p.Proxy,
+		ClientCertificates:        p.ClientCertificates,
+		Headers:                   p.Headers,
+	}
+}
+
+func (p TransportPolicy) internal() sdkHttp.TransportPolicy {
+	policy := sdkHttp.TransportPolicy{
+		MaxRetries:                p.MaxRetries,
+		InitialBackoff:            p.InitialBackoff,
+		MaxBackoff:                p.MaxBackoff,
+		Jitter:                    p.Jitter,
+		RequestTimeout:            p.RequestTimeout,
+		ExtraRetryableStatusCodes: p.ExtraRetryableStatusCodes,
+		Proxy:                     p.Proxy,
+		ClientCertificates:        p.ClientCertificates,
+		Headers:                   p.Headers,
+	}
+	if p.Limiter != nil {
+		policy.Limiter = p.Limiter.limiter
+	}
+	if p.Breaker != nil {
+		policy.Breaker = p.Breaker.breaker
+	}
+	return policy
+}
+
+// Limiter bounds the requests in flight and their rate, see NewLimiter.
+type Limiter struct {
+	limiter *sdkHttp.Limiter
+}
+
+// NewLimiter returns a limiter allowing maxInFlight requests at once (0 for no
+// limit) and rps requests per second with bursts of burst (rps 0 for no limit).
+func NewLimiter(maxInFlight int, rps float64, burst int) *Limiter {
+	return &Limiter{limiter: sdkHttp.NewLimiter(maxInFlight, rps, burst)}
+}
+
+// Acquire waits for the limiter to let a request through, e.g. one sent by
+// the caller alongside the client's, and returns the function releasing its
+// slot once it is done.
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	return l.limiter.Acquire(ctx)
+}
+
+// CircuitBreaker fails requests fast after consecutive failures, see
+// NewCircuitBreaker.
+type CircuitBreaker struct {
+	breaker *sdkHttp.CircuitBreaker
+}
+
+// NewCircuitBreaker returns a breaker that opens after threshold consecutive
+// failures and lets a trial request through after cooldown. It returns nil, no
+// breaker, for a zero threshold.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	breaker := sdkHttp.NewCircuitBreaker(threshold, cooldown)
+	if breaker == nil {
+		return nil
+	}
+	return &CircuitBreaker{breaker: breaker}
+}
+
+// ProxyFunc returns a proxy function for TransportPolicy.Proxy sending
+// requests through proxyURL, except for the hosts in the comma-separated
+// noProxy list.
+func ProxyFunc(proxyURL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	return sdkHttp.ProxyFunc(proxyURL, noProxy)
+}
+
+// AuditLog records every mutation and certificate request, see OpenAuditLog.
+type AuditLog struct {
+	log *client.AuditLog
+}
+
+// OpenAuditLog opens, or creates, the JSON lines audit log at path.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	log, err := client.NewAuditLog(path)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLog{log: log}, nil
+}
+
+// Client is an Altinity.Cloud API client. It is safe for concurrent use.
+type Client struct {
+	sdk *sdk.AltinityCloudSDK
+}
+
+// The provider configures its clients with New, and reaches them through
+// sdk.ClientOf.
+func init() {
+	sdk.ClientOf = func(c any) *sdk.AltinityCloudSDK {
+		return c.(*Client).sdk
+	}
+}
+
+type config struct {
+	sdk sdk.Config
+}
+
+// Option configures a Client.
+type Option func(*config)
+
+// WithAPIURL sets the API URL, DefaultAPIURL by default.
+func WithAPIURL(url string) Option {
+	return func(c *config) { c.sdk.APIURL = url }
+}
+
+// WithToken authenticates with a fixed API token.
+func WithToken(token string) Option {
+	return func(c *config) { c.sdk.Token = credentials.StaticToken(token) }
+}
+
+// WithTokenSource authenticates with the token of source, asked before every
+// request so rotated tokens are picked up.
+func WithTokenSource(source TokenSource) Option {
+	return func(c *config) { c.sdk.Token = source }
+}
+
+// WithRootCAs verifies the API with pool instead of the system CA pool.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(c *config) { c.sdk.RootCAs = pool }
+}
+
+// WithTransportPolicy replaces DefaultTransportPolicy.
+func WithTransportPolicy(policy TransportPolicy) Option {
+	return func(c *config) { c.sdk.Transport = policy.internal() }
+}
+
+// WithReadOnly rejects every mutation and certificate request with a
+// *ReadOnlyError before it is sent.
+func WithReadOnly(readOnly bool) Option {
+	return func(c *config) { c.sdk.ReadOnly = readOnly }
+}
+
+// WithAuditLog records every mutation and certificate request to log.
+func WithAuditLog(log *AuditLog) Option {
+	return func(c *config) {
+		if log != nil {
+			c.sdk.AuditLog = log.log
+		}
+	}
+}
+
+// WithUserAgent sets the User-Agent header.
+func WithUserAgent(userAgent string) Option {
+	return func(c *config) { c.sdk.UserAgent = userAgent }
+}
+
+// WithQueryCacheTTL sets how long identical queries share a response,
+// DefaultQueryCacheTTL by default.
+func WithQueryCacheTTL(ttl time.Duration) Option {
+	return func(c *config) { c.sdk.QueryCacheTTL = ttl }
+}
+
+// ErrMissingToken is returned by New without WithToken or WithTokenSource.
+var ErrMissingToken = sdk.ErrMissingToken
+
+// New returns a client for the API, configured by opts.
+func New(opts ...Option) (*Client, error) {
+	cfg := config{sdk: sdk.Config{
+		APIURL:        DefaultAPIURL,
+		Transport:     sdkHttp.DefaultTransportPolicy(),
+		UserAgent:     fmt.Sprintf("altinitycloud-go (%s; %s) go/%s", runtime.GOOS, runtime.GOARCH, runtime.Version()),
+		QueryCacheTTL: DefaultQueryCacheTTL,
+	}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	clients, err := sdk.New(cfg.sdk)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{sdk: clients}, nil
+}
+
+// Ping checks that the API is reachable and accepts the token, with the
+// cheapest authenticated query. An invalid token fails with ErrUnauthorized.
+func (c *Client) Ping(ctx context.Context) error {
+	return publicError(c.sdk.Client.Ping(ctx))
+}
+
+// SchemaIssue is an element of the API schema the client relies on that the
+// API no longer supports, or deprecates.
+type SchemaIssue struct {
+	// Element is Type.field, Type.field(argument) or, for the fields of input
+	// types, Input.field.
+	Element string
+	// Operations are the operations using Element.
+	Operations []string
+	// Deprecated is set when the server still supports Element but deprecates
+	// it, for Reason.
+	Deprecated bool
+	Reason     string
+}
+
+func (i SchemaIssue) String() string {
+	return client.SchemaIssue(i).String()
+}
+
+// CheckSchema compares the API schema, as introspected, with the operations of
+// the client, which are generated from the schema at build time. Issues mean
+// that the API has changed since and that some calls may fail.
+func (c *Client) CheckSchema(ctx context.Context) ([]SchemaIssue, error) {
+	issues, err := c.sdk.Client.CheckSchema(ctx)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	public := make([]SchemaIssue, len(issues))
+	for i, issue := range issues {
+		public[i] = SchemaIssue(issue)
+	}
+	return public, nil
+}
@@ -0,0 +1,777 @@
+package altinitycloud
+
+//go:generate go run ./internal/gen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+)
+
+// notFound is returned for a query answered without the env: the API reports
+// a missing env either way.
+func notFound(name string) error {
+	return fmt.Errorf("env %s: %w", name, ErrNotFound)
+}
+
+// convert returns in as Out, between a client type and its public copy in
+// models_gen.go: both encode to the same JSON.
+func convert[Out any](in any) (*Out, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("altinitycloud: convert %T: %w", in, err)
+	}
+	out := new(Out)
+	if err := json.Unmarshal(b, out); err != nil {
+		return nil, fmt.Errorf("altinitycloud: convert %T: %w", in, err)
+	}
+	return out, nil
+}
+
+// AWSEnvs are the operations on AWS envs, see Client.AWS.
+type AWSEnvs struct {
+	c *client.Client
+}
+
+// AWS returns the operations on AWS envs.
+func (c *Client) AWS() AWSEnvs {
+	return AWSEnvs{c: c.sdk.Client}
+}
+
+// Get returns the env name, or an error matching ErrNotFound.
+func (e AWSEnvs) Get(ctx context.Context, name string) (*GetAWSEnvResponse, error) {
+	res, err := e.c.GetAWSEnv(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.AWSEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetAWSEnvResponse](res.AWSEnv)
+}
+
+// Status returns the status of env name, or an error matching ErrNotFound.
+func (e AWSEnvs) Status(ctx context.Context, name string) (*GetAWSEnvStatusResponse, error) {
+	res, err := e.c.GetAWSEnvStatus(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.AWSEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetAWSEnvStatusResponse](res.AWSEnv)
+}
+
+// EnvStatus implements StatusGetter.
+func (e AWSEnvs) EnvStatus(ctx context.Context, name string) (*EnvStatus, error) {
+	env, err := e.Status(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	status := &EnvStatus{
+		Name:                env.Name,
+		SpecRevision:        env.SpecRevision,
+		AppliedSpecRevision: env.Status.AppliedSpecRevision,
+		PendingDelete:       env.Status.PendingDelete,
+	}
+	for _, envErr := range env.Status.Errors {
+		status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+	}
+	return status, nil
+}
+
+// List returns the status of every env.
+func (e AWSEnvs) List(ctx context.Context) ([]*EnvStatus, error) {
+	res, err := e.c.ListAWSEnvs(ctx)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	statuses := make([]*EnvStatus, 0, len(res.AWSEnvs))
+	for _, env := range res.AWSEnvs {
+		status := &EnvStatus{
+			Name:                env.Name,
+			SpecRevision:        env.SpecRevision,
+			AppliedSpecRevision: env.Status.AppliedSpecRevision,
+			PendingDelete:       env.Status.PendingDelete,
+		}
+		for _, envErr := range env.Status.Errors {
+			status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CodeGen returns the Terraform configuration the API generates for env name,
+// with its boilerplate if boilerplate is set.
+func (e AWSEnvs) CodeGen(ctx context.Context, name string, boilerplate bool) (string, error) {
+	res, err := e.c.CodeGenAWSEnv(ctx, name, &boilerplate)
+	if err != nil {
+		return "", publicError(err)
+	}
+	return res.CodeGenAWSEnv.Terraform, nil
+}
+
+// Create creates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e AWSEnvs) Create(ctx context.Context, input CreateAWSEnvInput) (*CreateAWSEnvResponse, error) {
+	in, err := convert[client.CreateAWSEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.CreateAWSEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[CreateAWSEnvResponse](&res.CreateAWSEnv)
+}
+
+// Update updates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e AWSEnvs) Update(ctx context.Context, input UpdateAWSEnvInput) (*UpdateAWSEnvResponse, error) {
+	in, err := convert[client.UpdateAWSEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.UpdateAWSEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[UpdateAWSEnvResponse](&res.UpdateAWSEnv)
+}
+
+// Delete marks an env for deletion. Wait for it to be gone with
+// WaitForDeletion.
+func (e AWSEnvs) Delete(ctx context.Context, input DeleteAWSEnvInput) (*DeleteAWSEnvResponse, error) {
+	in, err := convert[client.DeleteAWSEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.DeleteAWSEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[DeleteAWSEnvResponse](&res.DeleteAWSEnv)
+}
+
+// AWSEnvsHosted are the operations on Altinity-hosted AWS envs, see Client.AWSHosted.
+type AWSEnvsHosted struct {
+	c *client.Client
+}
+
+// AWSHosted returns the operations on Altinity-hosted AWS envs.
+func (c *Client) AWSHosted() AWSEnvsHosted {
+	return AWSEnvsHosted{c: c.sdk.Client}
+}
+
+// Get returns the env name, or an error matching ErrNotFound.
+func (e AWSEnvsHosted) Get(ctx context.Context, name string) (*GetAWSEnvHostedResponse, error) {
+	res, err := e.c.GetAWSEnvHosted(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.AWSEnvHosted == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetAWSEnvHostedResponse](res.AWSEnvHosted)
+}
+
+// Status returns the status of env name, or an error matching ErrNotFound.
+func (e AWSEnvsHosted) Status(ctx context.Context, name string) (*GetAWSEnvHostedStatusResponse, error) {
+	res, err := e.c.GetAWSEnvHostedStatus(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.AWSEnvHosted == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetAWSEnvHostedStatusResponse](res.AWSEnvHosted)
+}
+
+// EnvStatus implements StatusGetter.
+func (e AWSEnvsHosted) EnvStatus(ctx context.Context, name string) (*EnvStatus, error) {
+	env, err := e.Status(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	status := &EnvStatus{
+		Name:                env.Name,
+		SpecRevision:        env.SpecRevision,
+		AppliedSpecRevision: env.Status.AppliedSpecRevision,
+		PendingDelete:       env.Status.PendingDelete,
+	}
+	for _, envErr := range env.Status.Errors {
+		status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+	}
+	return status, nil
+}
+
+// List returns the status of every env.
+func (e AWSEnvsHosted) List(ctx context.Context) ([]*EnvStatus, error) {
+	res, err := e.c.ListAWSEnvsHosted(ctx)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	statuses := make([]*EnvStatus, 0, len(res.AWSEnvsHosted))
+	for _, env := range res.AWSEnvsHosted {
+		status := &EnvStatus{
+			Name:                env.Name,
+			SpecRevision:        env.SpecRevision,
+			AppliedSpecRevision: env.Status.AppliedSpecRevision,
+			PendingDelete:       env.Status.PendingDelete,
+		}
+		for _, envErr := range env.Status.Errors {
+			status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Create creates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e AWSEnvsHosted) Create(ctx context.Context, input CreateAWSEnvHostedInput) (*CreateAWSEnvHostedResponse, error) {
+	in, err := convert[client.CreateAWSEnvHostedInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.CreateAWSEnvHosted(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[CreateAWSEnvHostedResponse](&res.CreateAWSEnvHosted)
+}
+
+// Update updates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e AWSEnvsHosted) Update(ctx context.Context, input UpdateAWSEnvHostedInput) (*UpdateAWSEnvHostedResponse, error) {
+	in, err := convert[client.UpdateAWSEnvHostedInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.UpdateAWSEnvHosted(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[UpdateAWSEnvHostedResponse](&res.UpdateAWSEnvHosted)
+}
+
+// Delete marks an env for deletion. Wait for it to be gone with
+// WaitForDeletion.
+func (e AWSEnvsHosted) Delete(ctx context.Context, input DeleteAWSEnvHostedInput) (*DeleteAWSEnvHostedResponse, error) {
+	in, err := convert[client.DeleteAWSEnvHostedInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.DeleteAWSEnvHosted(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[DeleteAWSEnvHostedResponse](&res.DeleteAWSEnvHosted)
+}
+
+// AzureEnvs are the operations on Azure envs, see Client.Azure.
+type AzureEnvs struct {
+	c *client.Client
+}
+
+// Azure returns the operations on Azure envs.
+func (c *Client) Azure() AzureEnvs {
+	return AzureEnvs{c: c.sdk.Client}
+}
+
+// Get returns the env name, or an error matching ErrNotFound.
+func (e AzureEnvs) Get(ctx context.Context, name string) (*GetAzureEnvResponse, error) {
+	res, err := e.c.GetAzureEnv(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.AzureEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetAzureEnvResponse](res.AzureEnv)
+}
+
+// Status returns the status of env name, or an error matching ErrNotFound.
+func (e AzureEnvs) Status(ctx context.Context, name string) (*GetAzureEnvStatusResponse, error) {
+	res, err := e.c.GetAzureEnvStatus(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.AzureEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetAzureEnvStatusResponse](res.AzureEnv)
+}
+
+// EnvStatus implements StatusGetter.
+func (e AzureEnvs) EnvStatus(ctx context.Context, name string) (*EnvStatus, error) {
+	env, err := e.Status(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	status := &EnvStatus{
+		Name:                env.Name,
+		SpecRevision:        env.SpecRevision,
+		AppliedSpecRevision: env.Status.AppliedSpecRevision,
+		PendingDelete:       env.Status.PendingDelete,
+	}
+	for _, envErr := range env.Status.Errors {
+		status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+	}
+	return status, nil
+}
+
+// List returns the status of every env.
+func (e AzureEnvs) List(ctx context.Context) ([]*EnvStatus, error) {
+	res, err := e.c.ListAzureEnvs(ctx)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	statuses := make([]*EnvStatus, 0, len(res.AzureEnvs))
+	for _, env := range res.AzureEnvs {
+		status := &EnvStatus{
+			Name:                env.Name,
+			SpecRevision:        env.SpecRevision,
+			AppliedSpecRevision: env.Status.AppliedSpecRevision,
+			PendingDelete:       env.Status.PendingDelete,
+		}
+		for _, envErr := range env.Status.Errors {
+			status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CodeGen returns the Terraform configuration the API generates for env name,
+// with its boilerplate if boilerplate is set.
+func (e AzureEnvs) CodeGen(ctx context.Context, name string, boilerplate bool) (string, error) {
+	res, err := e.c.CodeGenAzureEnv(ctx, name, &boilerplate)
+	if err != nil {
+		return "", publicError(err)
+	}
+	return res.CodeGenAzureEnv.Terraform, nil
+}
+
+// Create creates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e AzureEnvs) Create(ctx context.Context, input CreateAzureEnvInput) (*CreateAzureEnvResponse, error) {
+	in, err := convert[client.CreateAzureEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.CreateAzureEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[CreateAzureEnvResponse](&res.CreateAzureEnv)
+}
+
+// Update updates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e AzureEnvs) Update(ctx context.Context, input UpdateAzureEnvInput) (*UpdateAzureEnvResponse, error) {
+	in, err := convert[client.UpdateAzureEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.UpdateAzureEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[UpdateAzureEnvResponse](&res.UpdateAzureEnv)
+}
+
+// Delete marks an env for deletion. Wait for it to be gone with
+// WaitForDeletion.
+func (e AzureEnvs) Delete(ctx context.Context, input DeleteAzureEnvInput) (*DeleteAzureEnvResponse, error) {
+	in, err := convert[client.DeleteAzureEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.DeleteAzureEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[DeleteAzureEnvResponse](&res.DeleteAzureEnv)
+}
+
+// GCPEnvs are the operations on GCP envs, see Client.GCP.
+type GCPEnvs struct {
+	c *client.Client
+}
+
+// GCP returns the operations on GCP envs.
+func (c *Client) GCP() GCPEnvs {
+	return GCPEnvs{c: c.sdk.Client}
+}
+
+// Get returns the env name, or an error matching ErrNotFound.
+func (e GCPEnvs) Get(ctx context.Context, name string) (*GetGCPEnvResponse, error) {
+	res, err := e.c.GetGCPEnv(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.GCPEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetGCPEnvResponse](res.GCPEnv)
+}
+
+// Status returns the status of env name, or an error matching ErrNotFound.
+func (e GCPEnvs) Status(ctx context.Context, name string) (*GetGCPEnvStatusResponse, error) {
+	res, err := e.c.GetGCPEnvStatus(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.GCPEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetGCPEnvStatusResponse](res.GCPEnv)
+}
+
+// EnvStatus implements StatusGetter.
+func (e GCPEnvs) EnvStatus(ctx context.Context, name string) (*EnvStatus, error) {
+	env, err := e.Status(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	status := &EnvStatus{
+		Name:                env.Name,
+		SpecRevision:        env.SpecRevision,
+		AppliedSpecRevision: env.Status.AppliedSpecRevision,
+		PendingDelete:       env.Status.PendingDelete,
+	}
+	for _, envErr := range env.Status.Errors {
+		status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+	}
+	return status, nil
+}
+
+// List returns the status of every env.
+func (e GCPEnvs) List(ctx context.Context) ([]*EnvStatus, error) {
+	res, err := e.c.ListGCPEnvs(ctx)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	statuses := make([]*EnvStatus, 0, len(res.GCPEnvs))
+	for _, env := range res.GCPEnvs {
+		status := &EnvStatus{
+			Name:                env.Name,
+			SpecRevision:        env.SpecRevision,
+			AppliedSpecRevision: env.Status.AppliedSpecRevision,
+			PendingDelete:       env.Status.PendingDelete,
+		}
+		for _, envErr := range env.Status.Errors {
+			status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CodeGen returns the Terraform configuration the API generates for env name,
+// with its boilerplate if boilerplate is set.
+func (e GCPEnvs) CodeGen(ctx context.Context, name string, boilerplate bool) (string, error) {
+	res, err := e.c.CodeGenGCPEnv(ctx, name, &boilerplate)
+	if err != nil {
+		return "", publicError(err)
+	}
+	return res.CodeGenGCPEnv.Terraform, nil
+}
+
+// Create creates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e GCPEnvs) Create(ctx context.Context, input CreateGCPEnvInput) (*CreateGCPEnvResponse, error) {
+	in, err := convert[client.CreateGCPEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.CreateGCPEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[CreateGCPEnvResponse](&res.CreateGCPEnv)
+}
+
+// Update updates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e GCPEnvs) Update(ctx context.Context, input UpdateGCPEnvInput) (*UpdateGCPEnvResponse, error) {
+	in, err := convert[client.UpdateGCPEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.UpdateGCPEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[UpdateGCPEnvResponse](&res.UpdateGCPEnv)
+}
+
+// Delete marks an env for deletion. Wait for it to be gone with
+// WaitForDeletion.
+func (e GCPEnvs) Delete(ctx context.Context, input DeleteGCPEnvInput) (*DeleteGCPEnvResponse, error) {
+	in, err := convert[client.DeleteGCPEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.DeleteGCPEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[DeleteGCPEnvResponse](&res.DeleteGCPEnv)
+}
+
+// HCloudEnvs are the operations on HCloud envs, see Client.HCloud.
+type HCloudEnvs struct {
+	c *client.Client
+}
+
+// HCloud returns the operations on HCloud envs.
+func (c *Client) HCloud() HCloudEnvs {
+	return HCloudEnvs{c: c.sdk.Client}
+}
+
+// Get returns the env name, or an error matching ErrNotFound.
+func (e HCloudEnvs) Get(ctx context.Context, name string) (*GetHCloudEnvResponse, error) {
+	res, err := e.c.GetHCloudEnv(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.HcloudEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetHCloudEnvResponse](res.HcloudEnv)
+}
+
+// Status returns the status of env name, or an error matching ErrNotFound.
+func (e HCloudEnvs) Status(ctx context.Context, name string) (*GetHCloudEnvStatusResponse, error) {
+	res, err := e.c.GetHCloudEnvStatus(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.HcloudEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetHCloudEnvStatusResponse](res.HcloudEnv)
+}
+
+// EnvStatus implements StatusGetter.
+func (e HCloudEnvs) EnvStatus(ctx context.Context, name string) (*EnvStatus, error) {
+	env, err := e.Status(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	status := &EnvStatus{
+		Name:                env.Name,
+		SpecRevision:        env.SpecRevision,
+		AppliedSpecRevision: env.Status.AppliedSpecRevision,
+		PendingDelete:       env.Status.PendingDelete,
+	}
+	for _, envErr := range env.Status.Errors {
+		status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+	}
+	return status, nil
+}
+
+// List returns the status of every env.
+func (e HCloudEnvs) List(ctx context.Context) ([]*EnvStatus, error) {
+	res, err := e.c.ListHCloudEnvs(ctx)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	statuses := make([]*EnvStatus, 0, len(res.HcloudEnvs))
+	for _, env := range res.HcloudEnvs {
+		status := &EnvStatus{
+			Name:                env.Name,
+			SpecRevision:        env.SpecRevision,
+			AppliedSpecRevision: env.Status.AppliedSpecRevision,
+			PendingDelete:       env.Status.PendingDelete,
+		}
+		for _, envErr := range env.Status.Errors {
+			status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CodeGen returns the Terraform configuration the API generates for env name,
+// with its boilerplate if boilerplate is set.
+func (e HCloudEnvs) CodeGen(ctx context.Context, name string, boilerplate bool) (string, error) {
+	res, err := e.c.CodeGenHCloudEnv(ctx, name, &boilerplate)
+	if err != nil {
+		return "", publicError(err)
+	}
+	return res.CodeGenHCloudEnv.Terraform, nil
+}
+
+// Create creates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e HCloudEnvs) Create(ctx context.Context, input CreateHCloudEnvInput) (*CreateHCloudEnvResponse, error) {
+	in, err := convert[client.CreateHCloudEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.CreateHCloudEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[CreateHCloudEnvResponse](&res.CreateHCloudEnv)
+}
+
+// Update updates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e HCloudEnvs) Update(ctx context.Context, input UpdateHCloudEnvInput) (*UpdateHCloudEnvResponse, error) {
+	in, err := convert[client.UpdateHCloudEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.UpdateHCloudEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[UpdateHCloudEnvResponse](&res.UpdateHCloudEnv)
+}
+
+// Delete marks an env for deletion. Wait for it to be gone with
+// WaitForDeletion.
+func (e HCloudEnvs) Delete(ctx context.Context, input DeleteHCloudEnvInput) (*DeleteHCloudEnvResponse, error) {
+	in, err := convert[client.DeleteHCloudEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.DeleteHCloudEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[DeleteHCloudEnvResponse](&res.DeleteHCloudEnv)
+}
+
+// K8SEnvs are the operations on K8S envs, see Client.K8S.
+type K8SEnvs struct {
+	c *client.Client
+}
+
+// K8S returns the operations on K8S envs.
+func (c *Client) K8S() K8SEnvs {
+	return K8SEnvs{c: c.sdk.Client}
+}
+
+// Get returns the env name, or an error matching ErrNotFound.
+func (e K8SEnvs) Get(ctx context.Context, name string) (*GetK8SEnvResponse, error) {
+	res, err := e.c.GetK8SEnv(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.K8sEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetK8SEnvResponse](res.K8sEnv)
+}
+
+// Status returns the status of env name, or an error matching ErrNotFound.
+func (e K8SEnvs) Status(ctx context.Context, name string) (*GetK8SEnvStatusResponse, error) {
+	res, err := e.c.GetK8SEnvStatus(ctx, name)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	if res.K8sEnv == nil {
+		return nil, notFound(name)
+	}
+	return convert[GetK8SEnvStatusResponse](res.K8sEnv)
+}
+
+// EnvStatus implements StatusGetter.
+func (e K8SEnvs) EnvStatus(ctx context.Context, name string) (*EnvStatus, error) {
+	env, err := e.Status(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	status := &EnvStatus{
+		Name:                env.Name,
+		SpecRevision:        env.SpecRevision,
+		AppliedSpecRevision: env.Status.AppliedSpecRevision,
+		PendingDelete:       env.Status.PendingDelete,
+	}
+	for _, envErr := range env.Status.Errors {
+		status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+	}
+	return status, nil
+}
+
+// List returns the status of every env.
+func (e K8SEnvs) List(ctx context.Context) ([]*EnvStatus, error) {
+	res, err := e.c.ListK8SEnvs(ctx)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	statuses := make([]*EnvStatus, 0, len(res.K8sEnvs))
+	for _, env := range res.K8sEnvs {
+		status := &EnvStatus{
+			Name:                env.Name,
+			SpecRevision:        env.SpecRevision,
+			AppliedSpecRevision: env.Status.AppliedSpecRevision,
+			PendingDelete:       env.Status.PendingDelete,
+		}
+		for _, envErr := range env.Status.Errors {
+			status.Errors = append(status.Errors, EnvError{Code: string(envErr.Code), Message: envErr.Message})
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CodeGen returns the Terraform configuration the API generates for env name,
+// with its boilerplate if boilerplate is set.
+func (e K8SEnvs) CodeGen(ctx context.Context, name string, boilerplate bool) (string, error) {
+	res, err := e.c.CodeGenK8SEnv(ctx, name, &boilerplate)
+	if err != nil {
+		return "", publicError(err)
+	}
+	return res.CodeGenK8SEnv.Terraform, nil
+}
+
+// Create creates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e K8SEnvs) Create(ctx context.Context, input CreateK8SEnvInput) (*CreateK8SEnvResponse, error) {
+	in, err := convert[client.CreateK8SEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.CreateK8SEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[CreateK8SEnvResponse](&res.CreateK8SEnv)
+}
+
+// Update updates an env. Wait for its SpecRevision to be applied with
+// WaitForSpecRevision.
+func (e K8SEnvs) Update(ctx context.Context, input UpdateK8SEnvInput) (*UpdateK8SEnvResponse, error) {
+	in, err := convert[client.UpdateK8SEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.UpdateK8SEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[UpdateK8SEnvResponse](&res.UpdateK8SEnv)
+}
+
+// Delete marks an env for deletion. Wait for it to be gone with
+// WaitForDeletion.
+func (e K8SEnvs) Delete(ctx context.Context, input DeleteK8SEnvInput) (*DeleteK8SEnvResponse, error) {
+	in, err := convert[client.DeleteK8SEnvInput](input)
+	if err != nil {
+		return nil, err
+	}
+	res, err := e.c.DeleteK8SEnv(ctx, *in)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return convert[DeleteK8SEnvResponse](&res.DeleteK8SEnv)
+}
@@ -0,0 +1,187 @@
+package altinitycloud
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+)
+
+// Sentinels matched via errors.Is by the errors of the client.
+var (
+	ErrNotFound     = client.ErrNotFound
+	ErrConflict     = client.ErrConflict
+	ErrForbidden    = client.ErrForbidden
+	ErrUnauthorized = client.ErrUnauthorized
+	// ErrActiveClusters is a conflict deleting an env that still has
+	// ClickHouse/Zookeeper clusters (retry with ForceDestroyClusters set).
+	ErrActiveClusters = client.ErrActiveClusters
+	// ErrReadOnly is matched by every *ReadOnlyError.
+	ErrReadOnly = client.ErrReadOnly
+	// ErrCircuitOpen is matched by every *CircuitOpenError.
+	ErrCircuitOpen = sdkHttp.ErrCircuitOpen
+)
+
+// APIError is an error response from the API: a non-2xx HTTP response or
+// GraphQL errors in a 200 response.
+type APIError struct {
+	// StatusCode is the HTTP status for network errors, 0 otherwise.
+	StatusCode int
+	// NetworkMessage describes a network error, empty otherwise.
+	NetworkMessage string
+	Errors         []GraphQLError
+
+	err *client.APIError
+}
+
+func newAPIError(err *client.APIError) *APIError {
+	apiErr := &APIError{StatusCode: err.StatusCode, NetworkMessage: err.NetworkMessage, err: err}
+	for _, gqlError := range err.Errors {
+		apiErr.Errors = append(apiErr.Errors, GraphQLError(gqlError))
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error of the client, which matches the sentinels.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// HasCode reports whether any GraphQL error has the extension code code.
+func (e *APIError) HasCode(code string) bool {
+	return e.err.HasCode(code)
+}
+
+// GraphQLError is a single error of a GraphQL response.
+type GraphQLError struct {
+	Message string `json:"message"`
+	// Path elements are field names (strings) or list indices (ints).
+	Path       []interface{}          `json:"path"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+func (e GraphQLError) Error() string {
+	return client.GraphQLError(e).Error()
+}
+
+// Code returns the extensions.code of the error, or "" if it has none.
+func (e GraphQLError) Code() string {
+	return client.GraphQLError(e).Code()
+}
+
+// ReadOnlyError is returned instead of running a mutation with WithReadOnly.
+type ReadOnlyError struct {
+	Operation string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return (*client.ReadOnlyError)(e).Error()
+}
+
+func (e *ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
+
+// CircuitOpenError is returned instead of sending a request while the circuit
+// breaker of the TransportPolicy is open.
+type CircuitOpenError struct {
+	// Failures is the number of consecutive failures that opened the circuit.
+	Failures int
+	// RetryIn is the time left until a request is let through again.
+	RetryIn time.Duration
+	// LastError describes the failure that opened the circuit.
+	LastError string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return (*sdkHttp.CircuitOpenError)(e).Error()
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// publicError returns err with the first error of the clients in its chain
+// converted to its type in this package, keeping the message of err.
+func publicError(err error) error {
+	if err == nil || errors.As(err, new(*APIError)) || errors.As(err, new(*ReadOnlyError)) || errors.As(err, new(*CircuitOpenError)) {
+		return err
+	}
+	var public, internal error
+	var readOnlyErr *client.ReadOnlyError
+	var circuitErr *sdkHttp.CircuitOpenError
+	if apiErr, ok := client.AsAPIError(err); ok {
+		public, internal = newAPIError(apiErr), apiErr
+	} else if errors.As(err, &readOnlyErr) {
+		public, internal = (*ReadOnlyError)(readOnlyErr), readOnlyErr
+	} else if errors.As(err, &circuitErr) {
+		public, internal = (*CircuitOpenError)(circuitErr), circuitErr
+	} else {
+		return err
+	}
+	if err == internal {
+		return public
+	}
+	return &wrappedError{err: err, public: public}
+}
+
+// wrappedError is an error of the clients wrapped by another error, with its
+// public counterpart added to the chain.
+type wrappedError struct {
+	err    error
+	public error
+}
+
+func (e *wrappedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *wrappedError) Unwrap() []error {
+	return []error{e.public, e.err}
+}
+
+// AsAPIError returns the *APIError in the chain of err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	if internal, ok := client.AsAPIError(err); ok {
+		return newAPIError(internal), true
+	}
+	return nil, false
+}
+
+// IsTransportError reports whether err means no response was received, e.g. a
+// refused connection or a timeout.
+func IsTransportError(err error) bool {
+	return client.IsTransportError(err)
+}
+
+// EnvError is a provisioning error reported in the status of an env.
+type EnvError struct {
+	Code    string
+	Message string
+}
+
+// ProvisioningError is returned by WaitForSpecRevision when the env reports
+// errors that block provisioning.
+type ProvisioningError struct {
+	Env    string
+	Errors []EnvError
+}
+
+func (e *ProvisioningError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, envErr := range e.Errors {
+		messages[i] = fmt.Sprintf("%s: %s", envErr.Code, envErr.Message)
+	}
+	return fmt.Sprintf("env %s has provisioning errors: %s", e.Env, strings.Join(messages, "; "))
+}
@@ -0,0 +1,277 @@
+// Command gen writes models_gen.go, the public copies of the GraphQL models,
+// spec fragments and operation results of the internal client, and
+// models_gen_test.go, pairing each copy with its original so the tests catch
+// copies falling out of date.
+//
+// The copies are types of their own, not aliases: regenerating the internal
+// client never changes the API of the package. Run go generate when a test
+// reports a difference, and review the diff of models_gen.go as an API change.
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const clientDir = "../../internal/sdk/client/"
+
+// skipped are schema root types that have no use outside the client.
+var skipped = map[string]bool{
+	"Mutation":         true,
+	"Query":            true,
+	"CodeGenEnvOutput": true,
+}
+
+// responses are the operation results the package returns, by public name.
+// Their nested types are renamed after them, e.g. GetAWSEnvStatus_AWSEnv_Status
+// becomes GetAWSEnvStatusResponse_Status.
+var responses = map[string]response{
+	"GetAWSEnv_AWSEnv":                      {"GetAWSEnvResponse", "is an AWS env as returned by AWSEnvs.Get."},
+	"GetAWSEnvStatus_AWSEnv":                {"GetAWSEnvStatusResponse", "is the status of an AWS env as returned by AWSEnvs.Status."},
+	"CreateAWSEnv_CreateAWSEnv":             {"CreateAWSEnvResponse", "is returned by AWSEnvs.Create."},
+	"UpdateAWSEnv_UpdateAWSEnv":             {"UpdateAWSEnvResponse", "is returned by AWSEnvs.Update."},
+	"DeleteAWSEnv_DeleteAWSEnv":             {"DeleteAWSEnvResponse", "is returned by AWSEnvs.Delete."},
+	"GetAWSEnvHosted_AWSEnvHosted":          {"GetAWSEnvHostedResponse", "is an Altinity-hosted AWS env as returned by AWSEnvsHosted.Get."},
+	"GetAWSEnvHostedStatus_AWSEnvHosted":    {"GetAWSEnvHostedStatusResponse", "is the status of an Altinity-hosted AWS env as returned by AWSEnvsHosted.Status."},
+	"CreateAWSEnvHosted_CreateAWSEnvHosted": {"CreateAWSEnvHostedResponse", "is returned by AWSEnvsHosted.Create."},
+	"UpdateAWSEnvHosted_UpdateAWSEnvHosted": {"UpdateAWSEnvHostedResponse", "is returned by AWSEnvsHosted.Update."},
+	"DeleteAWSEnvHosted_DeleteAWSEnvHosted": {"DeleteAWSEnvHostedResponse", "is returned by AWSEnvsHosted.Delete."},
+	"GetAzureEnv_AzureEnv":                  {"GetAzureEnvResponse", "is an Azure env as returned by AzureEnvs.Get."},
+	"GetAzureEnvStatus_AzureEnv":            {"GetAzureEnvStatusResponse", "is the status of an Azure env as returned by AzureEnvs.Status."},
+	"CreateAzureEnv_CreateAzureEnv":         {"CreateAzureEnvResponse", "is returned by AzureEnvs.Create."},
+	"UpdateAzureEnv_UpdateAzureEnv":         {"UpdateAzureEnvResponse", "is returned by AzureEnvs.Update."},
+	"DeleteAzureEnv_DeleteAzureEnv":         {"DeleteAzureEnvResponse", "is returned by AzureEnvs.Delete."},
+	"GetGCPEnv_GCPEnv":                      {"GetGCPEnvResponse", "is a GCP env as returned by GCPEnvs.Get."},
+	"GetGCPEnvStatus_GCPEnv":                {"GetGCPEnvStatusResponse", "is the status of a GCP env as returned by GCPEnvs.Status."},
+	"CreateGCPEnv_CreateGCPEnv":             {"CreateGCPEnvResponse", "is returned by GCPEnvs.Create."},
+	"UpdateGCPEnv_UpdateGCPEnv":             {"UpdateGCPEnvResponse", "is returned by GCPEnvs.Update."},
+	"DeleteGCPEnv_DeleteGCPEnv":             {"DeleteGCPEnvResponse", "is returned by GCPEnvs.Delete."},
+	"GetHCloudEnv_HcloudEnv":                {"GetHCloudEnvResponse", "is an HCloud env as returned by HCloudEnvs.Get."},
+	"GetHCloudEnvStatus_HcloudEnv":          {"GetHCloudEnvStatusResponse", "is the status of an HCloud env as returned by HCloudEnvs.Status."},
+	"CreateHCloudEnv_CreateHCloudEnv":       {"CreateHCloudEnvResponse", "is returned by HCloudEnvs.Create."},
+	"UpdateHCloudEnv_UpdateHCloudEnv":       {"UpdateHCloudEnvResponse", "is returned by HCloudEnvs.Update."},
+	"DeleteHCloudEnv_DeleteHCloudEnv":       {"DeleteHCloudEnvResponse", "is returned by HCloudEnvs.Delete."},
+	"GetK8SEnv_K8sEnv":                      {"GetK8SEnvResponse", "is a K8S env as returned by K8SEnvs.Get."},
+	"GetK8SEnvStatus_K8sEnv":                {"GetK8SEnvStatusResponse", "is the status of a K8S env as returned by K8SEnvs.Status."},
+	"CreateK8SEnv_CreateK8SEnv":             {"CreateK8SEnvResponse", "is returned by K8SEnvs.Create."},
+	"UpdateK8SEnv_UpdateK8SEnv":             {"UpdateK8SEnvResponse", "is returned by K8SEnvs.Update."},
+	"DeleteK8SEnv_DeleteK8SEnv":             {"DeleteK8SEnvResponse", "is returned by K8SEnvs.Delete."},
+}
+
+// response is the public name and doc comment of an operation result.
+type response struct {
+	name, doc string
+}
+
+// copiedMethods are the enum methods kept on the copies; the others are about
+// GraphQL and JSON encoding, which the copies leave to the client.
+var copiedMethods = map[string]bool{"IsValid": true, "String": true}
+
+type source struct {
+	fset  *token.FileSet
+	file  *ast.File
+	types map[string]*ast.GenDecl
+}
+
+func main() {
+	fset := token.NewFileSet()
+	var sources []*source
+	decls := map[string]*source{}
+	for _, name := range []string{"model_gen.go", "client_gen.go"} {
+		f, err := parser.ParseFile(fset, clientDir+name, nil, parser.ParseComments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		src := &source{fset: fset, file: f, types: map[string]*ast.GenDecl{}}
+		for _, decl := range f.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+				name := gen.Specs[0].(*ast.TypeSpec).Name.Name
+				src.types[name] = gen
+				decls[name] = src
+			}
+		}
+		sources = append(sources, src)
+	}
+	model, operations := sources[0], sources[1]
+
+	var roots []string
+	for name := range model.types {
+		if ast.IsExported(name) && !skipped[name] {
+			roots = append(roots, name)
+		}
+	}
+	for name := range operations.types {
+		// Of the client, only the spec fragments are shared by several operations.
+		if strings.HasSuffix(name, "Fragment") && !strings.Contains(name, "_") {
+			roots = append(roots, name)
+		}
+	}
+	for name := range responses {
+		if decls[name] == nil {
+			log.Fatalf("response type %s is not generated by the client anymore", name)
+		}
+		roots = append(roots, name)
+	}
+
+	// The copies and every type they refer to.
+	copied := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		if copied[name] {
+			return
+		}
+		copied[name] = true
+		typeNames(decls[name].types[name], func(ident *ast.Ident) {
+			if decls[ident.Name] != nil {
+				visit(ident.Name)
+			}
+		})
+	}
+	for _, name := range roots {
+		visit(name)
+	}
+
+	renames := map[string]string{}
+	for name := range copied {
+		renames[name] = publicName(name)
+	}
+
+	var names []string
+	for name := range copied {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return renames[names[i]] < renames[names[j]] })
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/gen, DO NOT EDIT.\n\n")
+	buf.WriteString("package altinitycloud\n\n")
+	for _, name := range names {
+		gen := decls[name].types[name]
+		rewrite(gen, renames)
+		if res, ok := responses[name]; ok {
+			buf.WriteString("// " + res.name + " " + res.doc + "\n")
+		}
+		print(&buf, fset, gen, decls[name].file)
+	}
+	// Enum values and methods.
+	for _, decl := range model.file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.CONST || decl.Tok == token.VAR {
+				rewrite(decl, renames)
+				print(&buf, fset, decl, model.file)
+			}
+		case *ast.FuncDecl:
+			if decl.Recv != nil && copiedMethods[decl.Name.Name] {
+				rewrite(decl, renames)
+				print(&buf, fset, decl, model.file)
+			}
+		}
+	}
+	write("models_gen.go", buf.Bytes())
+
+	buf.Reset()
+	buf.WriteString("// Code generated by internal/gen, DO NOT EDIT.\n\n")
+	buf.WriteString("package altinitycloud\n\n")
+	buf.WriteString("import \"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client\"\n\n")
+	buf.WriteString("// generatedModels pairs the public models with the client types they copy.\n")
+	buf.WriteString("var generatedModels = []struct{ public, internal any }{\n")
+	var enums []string
+	for _, name := range names {
+		if _, ok := decls[name].types[name].Specs[0].(*ast.TypeSpec).Type.(*ast.StructType); !ok {
+			enums = append(enums, name)
+			buf.WriteString("\t{" + renames[name] + "(\"\"), client." + name + "(\"\")},\n")
+			continue
+		}
+		buf.WriteString("\t{" + renames[name] + "{}, client." + name + "{}},\n")
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// generatedEnums pairs the values of the public enums with those of the client.\n")
+	buf.WriteString("var generatedEnums = []struct{ public, internal any }{\n")
+	for _, name := range enums {
+		buf.WriteString("\t{All" + name + ", client.All" + name + "},\n")
+	}
+	buf.WriteString("}\n")
+	write("models_gen_test.go", buf.Bytes())
+}
+
+// publicName is the name of the copy of name: response types and their nested
+// types are named after the response.
+func publicName(name string) string {
+	for original, public := range responses {
+		if name == original {
+			return public.name
+		}
+		if strings.HasPrefix(name, original+"_") {
+			return public.name + strings.TrimPrefix(name, original)
+		}
+	}
+	return name
+}
+
+// rewrite renames the copied types referred to in node and keeps only the
+// json key of struct tags.
+func rewrite(node ast.Node, renames map[string]string) {
+	typeNames(node, func(ident *ast.Ident) {
+		if public, ok := renames[ident.Name]; ok {
+			ident.Name = public
+		}
+	})
+	ast.Inspect(node, func(n ast.Node) bool {
+		if field, ok := n.(*ast.Field); ok && field.Tag != nil {
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				log.Fatal(err)
+			}
+			field.Tag.Value = "`json:" + strconv.Quote(reflect.StructTag(tag).Get("json")) + "`"
+		}
+		return true
+	})
+}
+
+// typeNames calls fn with the identifiers of node that may name a type,
+// leaving out field names and selected names, which may be spelled the same.
+func typeNames(node ast.Node, fn func(*ast.Ident)) {
+	var inspect func(ast.Node) bool
+	inspect = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Field:
+			ast.Inspect(n.Type, inspect)
+			return false
+		case *ast.SelectorExpr:
+			ast.Inspect(n.X, inspect)
+			return false
+		case *ast.Ident:
+			fn(n)
+		}
+		return true
+	}
+	ast.Inspect(node, inspect)
+}
+
+func print(buf *bytes.Buffer, fset *token.FileSet, node ast.Node, file *ast.File) {
+	if err := printer.Fprint(buf, fset, &printer.CommentedNode{Node: node, Comments: file.Comments}); err != nil {
+		log.Fatal(err)
+	}
+	buf.WriteString("\n\n")
+}
+
+func write(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
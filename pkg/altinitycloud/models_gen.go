@@ -0,0 +1,5071 @@
+// Code generated by internal/gen, DO NOT EDIT.
+
+package altinitycloud
+
+// Azure AKS SKU tier.
+type AKSSKUTier string
+
+// AKS provider support policy.
+type AKSSupportPolicy string
+
+// AWS environment.
+type AWSEnv struct {
+	// A globally-unique environment identifier.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *AWSEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+	// Environment status.
+	Status *AWSEnvStatus `json:"status"`
+}
+
+// AWS environment custom S3 bucket configuration.
+type AWSEnvBackupsCustomBucketSpec struct {
+	// S3 bucket name.
+	Name string `json:"name"`
+	// S3 bucket region.
+	Region string `json:"region"`
+	// IAM role to assume for bucket access.
+	RoleArn string `json:"roleARN"`
+}
+
+// AWS environment custom S3 bucket configuration input.
+type AWSEnvBackupsCustomBucketSpecInput struct {
+	// S3 bucket name.
+	Name string `json:"name"`
+	// S3 bucket region.
+	Region string `json:"region"`
+	// IAM role to assume for bucket access.
+	RoleArn string `json:"roleARN"`
+}
+
+// Backups configuration.
+type AWSEnvBackupsSpec struct {
+	// Custom S3 bucket.
+	CustomBucket *AWSEnvBackupsCustomBucketSpec `json:"customBucket,omitempty"`
+}
+
+// Backups configuration.
+type AWSEnvBackupsSpecInput struct {
+	// Custom S3 bucket.
+	CustomBucket *AWSEnvBackupsCustomBucketSpecInput `json:"customBucket,omitempty"`
+}
+
+// AWS environment VPC endpoint configuration.
+type AWSEnvEndpointSpec struct {
+	// VPC endpoint service name in $endpoint_service_id.$region.vpce.amazonaws.com format.
+	ServiceName string `json:"serviceName"`
+	// By default, VPC endpoints get assigned $endpoint_service_id.$env_name.altinity.cloud DNS record.
+	// Alias allows to override DNS record name to $alias.$env_name.altinity.cloud.
+	Alias *string `json:"alias,omitempty"`
+	// True indicates that Private DNS for this endpoint is enabled, false otherwise.
+	PrivateDNS bool `json:"privateDNS"`
+}
+
+// AWS environment VPC endpoint configuration input.
+type AWSEnvEndpointSpecInput struct {
+	// VPC endpoint service name (in $endpoint_service_id.$region.vpce.amazonaws.com format).
+	ServiceName string `json:"serviceName"`
+	// By default, VPC endpoints get assigned $endpoint_service_id.$env_name.altinity.cloud DNS record.
+	// Alias allows to override DNS record name to $alias.$env_name.altinity.cloud.
+	Alias *string `json:"alias,omitempty"`
+	// Set to true to enable Private DNS for this endpoint. False by default.
+	PrivateDNS *bool `json:"privateDNS,omitempty"`
+}
+
+// External S3 bucket to allow access to.
+type AWSEnvExternalBucketSpec struct {
+	// External bucket name.
+	Name string `json:"name"`
+	// Optional ARN of a customer-managed KMS key used to encrypt this bucket.
+	//
+	// When set, the ClickHouse IRSA role is granted KMS decrypt/encrypt permissions
+	// on the key so SSE-KMS-encrypted objects in the bucket can be read and written
+	// (e.g. when the bucket backs a ClickHouse external disk). The key is owned by
+	// the customer; bucket-level encryption is not managed by Altinity.
+	//
+	// The env-region constraint that applies to the env-level KMS key does not
+	// apply here — the key may be in any region from the env's perspective.
+	// S3 still requires the key to be in the bucket's region (or to be a KMS
+	// multi-region key with a replica in the bucket's region); that is the
+	// customer's responsibility and is not validated here.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+}
+
+// External S3 bucket to allow access to.
+type AWSEnvExternalBucketSpecInput struct {
+	// External bucket name.
+	Name string `json:"name"`
+	// ARN of a customer-managed KMS key used to encrypt this bucket.
+	//
+	// When set, grants the ClickHouse IRSA role KMS decrypt/encrypt permissions
+	// on the key. The env-region constraint that applies to the env-level KMS
+	// key does not apply here; S3's own bucket-region:key-region rule still
+	// applies and is the customer's responsibility.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+}
+
+// AWS environments query filter.
+type AWSEnvFilter struct {
+	// Names of the environments to return.
+	// Names that don't match any of the existing environments are ignored.
+	Names []string `json:"names,omitempty"`
+}
+
+// AWS environment.
+type AWSEnvHosted struct {
+	// A globally-unique environment identifier.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *AWSEnvHostedSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+	// Environment status.
+	Status *AWSEnvHostedStatus `json:"status"`
+}
+
+// AWS environment custom S3 bucket configuration.
+type AWSEnvHostedBackupsCustomBucketSpec struct {
+	// S3 bucket name.
+	Name string `json:"name"`
+	// S3 bucket region.
+	Region string `json:"region"`
+	// IAM role to assume for bucket access.
+	RoleArn string `json:"roleARN"`
+}
+
+// AWS environment custom S3 bucket configuration input.
+type AWSEnvHostedBackupsCustomBucketSpecInput struct {
+	// S3 bucket name.
+	Name string `json:"name"`
+	// S3 bucket region.
+	Region string `json:"region"`
+	// IAM role to assume for bucket access.
+	RoleArn string `json:"roleARN"`
+}
+
+// Backups configuration.
+type AWSEnvHostedBackupsSpec struct {
+	// Custom S3 bucket.
+	CustomBucket *AWSEnvHostedBackupsCustomBucketSpec `json:"customBucket,omitempty"`
+}
+
+// Backups configuration.
+type AWSEnvHostedBackupsSpecInput struct {
+	// Custom S3 bucket.
+	CustomBucket *AWSEnvHostedBackupsCustomBucketSpecInput `json:"customBucket,omitempty"`
+}
+
+// AWS environment VPC endpoint configuration.
+type AWSEnvHostedEndpointSpec struct {
+	// VPC endpoint service name in $endpoint_service_id.$region.vpce.amazonaws.com format.
+	ServiceName string `json:"serviceName"`
+	// By default, VPC endpoints get assigned $endpoint_service_id.$env_name.altinity.cloud DNS record.
+	// Alias allows to override DNS record name to $alias.$env_name.altinity.cloud.
+	Alias *string `json:"alias,omitempty"`
+}
+
+// AWS environment VPC endpoint configuration input.
+type AWSEnvHostedEndpointSpecInput struct {
+	// VPC endpoint service name (in $endpoint_service_id.$region.vpce.amazonaws.com format).
+	ServiceName string `json:"serviceName"`
+	// By default, VPC endpoints get assigned $endpoint_service_id.$env_name.altinity.cloud DNS record.
+	// Alias allows to override DNS record name to $alias.$env_name.altinity.cloud.
+	Alias *string `json:"alias,omitempty"`
+}
+
+// External S3 bucket to allow access to.
+type AWSEnvHostedExternalBucketSpec struct {
+	// External bucket name.
+	Name string `json:"name"`
+	// Optional ARN of a customer-managed KMS key used to encrypt this bucket.
+	//
+	// When set, the ClickHouse IRSA role is granted KMS decrypt/encrypt permissions
+	// on the key so SSE-KMS-encrypted objects in the bucket can be read and written
+	// (e.g. when the bucket backs a ClickHouse external disk). The key is owned by
+	// the customer; bucket-level encryption is not managed by Altinity.
+	//
+	// The env-region constraint that applies to the env-level KMS key does not
+	// apply here — the key may be in any region from the env's perspective.
+	// S3 still requires the key to be in the bucket's region (or to be a KMS
+	// multi-region key with a replica in the bucket's region); that is the
+	// customer's responsibility and is not validated here.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+}
+
+// External S3 bucket to allow access to.
+type AWSEnvHostedExternalBucketSpecInput struct {
+	// External bucket name.
+	Name string `json:"name"`
+	// ARN of a customer-managed KMS key used to encrypt this bucket.
+	//
+	// When set, grants the ClickHouse IRSA role KMS decrypt/encrypt permissions
+	// on the key. The env-region constraint that applies to the env-level KMS
+	// key does not apply here; S3's own bucket-region:key-region rule still
+	// applies and is the customer's responsibility.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+}
+
+// AWS environments query filter.
+type AWSEnvHostedFilter struct {
+	// Names of the environments to return.
+	// Names that don't match any of the existing environments are ignored.
+	Names []string `json:"names,omitempty"`
+}
+
+// Iceberg catalog configuration input.
+type AWSEnvHostedIcebergCatalogInputSpec struct {
+	// Catalog name.
+	// Empty name represents the default catalog.
+	Name *string `json:"name,omitempty"`
+	// Catalog type.
+	Type AWSEnvHostedIcebergCatalogTypeSpec `json:"type"`
+	// Custom S3 bucket name.
+	CustomS3Bucket *string `json:"customS3Bucket,omitempty"`
+	// Path within the custom S3 bucket.
+	CustomS3BucketPath *string `json:"customS3BucketPath,omitempty"`
+	// ARN of the S3 Tables bucket.
+	CustomS3TableBucketArn *string `json:"customS3TableBucketARN,omitempty"`
+	// AWS region for the catalog.
+	Region *string `json:"region,omitempty"`
+	// Whether anonymous access is enabled.
+	AnonymousAccessEnabled *bool `json:"anonymousAccessEnabled,omitempty"`
+	// Maintenance configuration.
+	Maintenance *AWSEnvHostedIcebergCatalogMaintenanceInputSpec `json:"maintenance,omitempty"`
+	// Table watch configurations.
+	Watches []*AWSEnvHostedIcebergCatalogWatchInputSpec `json:"watches,omitempty"`
+}
+
+// Iceberg catalog maintenance configuration input.
+type AWSEnvHostedIcebergCatalogMaintenanceInputSpec struct {
+	// Whether maintenance is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// Iceberg catalog maintenance configuration.
+type AWSEnvHostedIcebergCatalogMaintenanceSpec struct {
+	// Whether maintenance is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// Iceberg catalog configuration.
+type AWSEnvHostedIcebergCatalogSpec struct {
+	// Catalog name.
+	// Empty name represents the default catalog.
+	Name *string `json:"name,omitempty"`
+	// Catalog type.
+	Type AWSEnvHostedIcebergCatalogTypeSpec `json:"type"`
+	// Custom S3 bucket name.
+	CustomS3Bucket *string `json:"customS3Bucket,omitempty"`
+	// Path within the custom S3 bucket.
+	CustomS3BucketPath *string `json:"customS3BucketPath,omitempty"`
+	// ARN of the S3 Tables bucket.
+	CustomS3TableBucketArn *string `json:"customS3TableBucketARN,omitempty"`
+	// AWS region for the catalog.
+	Region *string `json:"region,omitempty"`
+	// Whether anonymous access is enabled.
+	AnonymousAccessEnabled *bool `json:"anonymousAccessEnabled,omitempty"`
+	// Maintenance configuration.
+	Maintenance *AWSEnvHostedIcebergCatalogMaintenanceSpec `json:"maintenance"`
+	// Table watch configurations.
+	Watches []*AWSEnvHostedIcebergCatalogWatchSpec `json:"watches"`
+}
+
+// Iceberg catalog type.
+type AWSEnvHostedIcebergCatalogTypeSpec string
+
+// Iceberg catalog watch configuration input.
+type AWSEnvHostedIcebergCatalogWatchInputSpec struct {
+	// Table name to watch.
+	Table string `json:"table"`
+	// Paths relative to table location to watch.
+	PathsRelativeToTableLocation []string `json:"pathsRelativeToTableLocation,omitempty"`
+}
+
+// Iceberg catalog watch configuration.
+type AWSEnvHostedIcebergCatalogWatchSpec struct {
+	// Table name to watch.
+	Table string `json:"table"`
+	// Paths relative to table location to watch.
+	PathsRelativeToTableLocation []string `json:"pathsRelativeToTableLocation"`
+}
+
+// Iceberg configuration input.
+type AWSEnvHostedIcebergInputSpec struct {
+	// List of Iceberg catalogs.
+	Catalogs []*AWSEnvHostedIcebergCatalogInputSpec `json:"catalogs,omitempty"`
+}
+
+// Iceberg configuration.
+type AWSEnvHostedIcebergSpec struct {
+	// List of Iceberg catalogs.
+	Catalogs []*AWSEnvHostedIcebergCatalogSpec `json:"catalogs"`
+}
+
+// Iceberg configuration update input.
+type AWSEnvHostedIcebergUpdateInputSpec struct {
+	// List of Iceberg catalogs to create or update.
+	Catalogs []*AWSEnvHostedIcebergCatalogInputSpec `json:"catalogs,omitempty"`
+	// List of catalog names to delete.
+	CatalogsToDelete []string `json:"catalogsToDelete,omitempty"`
+}
+
+// AWS environment internal load balancer configuration.
+type AWSEnvHostedLoadBalancerInternalSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+	// ARNs for AWS principals that are allowed to create VPC endpoints.
+	//
+	// Examples:
+	// - arn:aws:iam::$account_id:root
+	EndpointServiceAllowedPrincipals []string `json:"endpointServiceAllowedPrincipals"`
+	// List of supported regions for VPC endpoints.
+	//
+	// Example: ["us-east-1", "us-west-2"]
+	EndpointServiceSupportedRegions []string `json:"endpointServiceSupportedRegions"`
+}
+
+// AWS environment internal load balancer configuration input.
+type AWSEnvHostedLoadBalancerInternalSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+	// ARNs for AWS principals that are allowed to create VPC endpoints.
+	//
+	// Examples:
+	// - arn:aws:iam::$account_id:root
+	EndpointServiceAllowedPrincipals []string `json:"endpointServiceAllowedPrincipals,omitempty"`
+	// List of supported regions for VPC endpoints.
+	//
+	// Example: ["us-east-1", "us-west-2"]
+	EndpointServiceSupportedRegions []string `json:"endpointServiceSupportedRegions,omitempty"`
+}
+
+// AWS environment internal load balancer status.
+type AWSEnvHostedLoadBalancerInternalStatus struct {
+	// VPC endpoint service name in $endpoint_service_id.$region.vpce.amazonaws.com format (if any).
+	EndpointServiceName *string `json:"endpointServiceName,omitempty"`
+}
+
+// AWS environment public load balancer configuration.
+type AWSEnvHostedLoadBalancerPublicSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+// AWS environment public load balancer configuration input.
+type AWSEnvHostedLoadBalancerPublicSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// AWS environment load balancers configuration.
+type AWSEnvHostedLoadBalancersSpec struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *AWSEnvHostedLoadBalancerPublicSpec `json:"public"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *AWSEnvHostedLoadBalancerInternalSpec `json:"internal"`
+}
+
+// AWS environment load balancers configuration input.
+type AWSEnvHostedLoadBalancersSpecInput struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *AWSEnvHostedLoadBalancerPublicSpecInput `json:"public,omitempty"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *AWSEnvHostedLoadBalancerInternalSpecInput `json:"internal,omitempty"`
+}
+
+// AWS environment load balancers status.
+type AWSEnvHostedLoadBalancersStatus struct {
+	// Status of internal load balancer.
+	Internal *AWSEnvHostedLoadBalancerInternalStatus `json:"internal"`
+}
+
+// AWS environment node group configuration.
+type AWSEnvHostedNodeGroupSpec struct {
+	// Unique (among environment node groups) node group identifier.
+	Name string `json:"name"`
+	// Instance type (https://aws.amazon.com/ec2/instance-types/).
+	//
+	// Example: "t4g.large"
+	NodeType string `json:"nodeType"`
+	// Availability zone ids.
+	ZoneIDs []string `json:"zoneIDs"`
+	// Maximum number of instances per availability zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workload that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations"`
+}
+
+// AWS environment node group configuration input.
+type AWSEnvHostedNodeGroupSpecInput struct {
+	// Unique (among environment node groups) node group identifier.
+	//
+	// Defaults to $nodeType.
+	Name *string `json:"name,omitempty"`
+	// Instance type (https://aws.amazon.com/ec2/instance-types/).
+	NodeType string `json:"nodeType"`
+	// Availability zone ids.
+	//
+	// Defaults to environment zones.
+	ZoneIDs []string `json:"zoneIDs,omitempty"`
+	// Maximum number of instances per availability zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workloads this group is reserved for.
+	// Defaults to all.
+	Reservations []NodeReservation `json:"reservations,omitempty"`
+}
+
+// AWS environment configuration.
+type AWSEnvHostedSpec struct {
+	// VPC CIDR block.
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// AWS region (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.RegionsAndAvailabilityZones.html#Concepts.RegionsAndAvailabilityZones.Regions).
+	//
+	// Example: "us-east-1"
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Explicit list of AWS availability zone ids (https://docs.aws.amazon.com/global-infrastructure/latest/regions/az-ids.html).
+	// At least 2 required.
+	//
+	// Example: ["usw2-az1", "usw2-az2"]
+	ZoneIDs []string `json:"zoneIDs"`
+	// Load balancers configuration.
+	LoadBalancers *AWSEnvHostedLoadBalancersSpec `json:"loadBalancers"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*AWSEnvHostedNodeGroupSpec `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpec `json:"maintenanceWindows"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains"`
+	// VPC endpoints configuration.
+	Endpoints []*AWSEnvHostedEndpointSpec `json:"endpoints"`
+	// Prefix for AWS resources created by this environment.
+	ResourcePrefix string `json:"resourcePrefix"`
+	// List of external S3 buckets to allow access to.
+	ExternalBuckets []*AWSEnvHostedExternalBucketSpec `json:"externalBuckets"`
+	// Backups configuration.
+	Backups *AWSEnvHostedBackupsSpec `json:"backups,omitempty"`
+	// Iceberg configuration.
+	Iceberg *AWSEnvHostedIcebergSpec `json:"iceberg,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpec `json:"metricsEndpoint"`
+	// Datadog agent configuration.
+	Datadog *DatadogSpec `json:"datadog"`
+	// ARN of the customer's KMS key for encrypting Altinity-provisioned data buckets
+	// and EBS volumes.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+	// ClickHouse clusters.
+	ClickHouseClusters []*ClickHouseClusterSpec `json:"clickHouseClusters"`
+	// ClickHouse Keepers.
+	ClickHouseKeepers []*ClickHouseKeeperSpec `json:"clickHouseKeepers"`
+}
+
+type AWSEnvHostedSpecFragment struct {
+	Region             string                                         `json:"region"`
+	Cidr               string                                         `json:"cidr"`
+	ZoneIDs            []string                                       `json:"zoneIDs"`
+	ResourcePrefix     string                                         `json:"resourcePrefix"`
+	KmsKeyArn          *string                                        `json:"kmsKeyARN,omitempty"`
+	CustomDomains      []string                                       `json:"customDomains"`
+	LoadBalancers      AWSEnvHostedSpecFragment_LoadBalancers         `json:"loadBalancers"`
+	NodeGroups         []*AWSEnvHostedSpecFragment_NodeGroups         `json:"nodeGroups"`
+	MaintenanceWindows []*AWSEnvHostedSpecFragment_MaintenanceWindows `json:"maintenanceWindows"`
+	Endpoints          []*AWSEnvHostedSpecFragment_Endpoints          `json:"endpoints"`
+	ExternalBuckets    []*AWSEnvHostedSpecFragment_ExternalBuckets    `json:"externalBuckets"`
+	Backups            *AWSEnvHostedSpecFragment_Backups              `json:"backups,omitempty"`
+	Iceberg            *AWSEnvHostedSpecFragment_Iceberg              `json:"iceberg,omitempty"`
+	MetricsEndpoint    AWSEnvHostedSpecFragment_MetricsEndpoint       `json:"metricsEndpoint"`
+	Datadog            AWSEnvHostedSpecFragment_Datadog               `json:"datadog"`
+}
+
+type AWSEnvHostedSpecFragment_Backups struct {
+	CustomBucket *AWSEnvHostedSpecFragment_Backups_CustomBucket `json:"customBucket,omitempty"`
+}
+
+type AWSEnvHostedSpecFragment_Backups_CustomBucket struct {
+	Name    string `json:"name"`
+	Region  string `json:"region"`
+	RoleArn string `json:"roleARN"`
+}
+
+type AWSEnvHostedSpecFragment_Datadog struct {
+	Domain         string `json:"domain"`
+	Enabled        bool   `json:"enabled"`
+	LogsEnabled    bool   `json:"logsEnabled"`
+	MetricsEnabled bool   `json:"metricsEnabled"`
+}
+
+type AWSEnvHostedSpecFragment_Endpoints struct {
+	Alias       *string `json:"alias,omitempty"`
+	ServiceName string  `json:"serviceName"`
+}
+
+type AWSEnvHostedSpecFragment_ExternalBuckets struct {
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+	Name      string  `json:"name"`
+}
+
+type AWSEnvHostedSpecFragment_Iceberg struct {
+	Catalogs []*AWSEnvHostedSpecFragment_Iceberg_Catalogs `json:"catalogs"`
+}
+
+type AWSEnvHostedSpecFragment_Iceberg_Catalogs struct {
+	AnonymousAccessEnabled *bool                                                 `json:"anonymousAccessEnabled,omitempty"`
+	CustomS3Bucket         *string                                               `json:"customS3Bucket,omitempty"`
+	CustomS3BucketPath     *string                                               `json:"customS3BucketPath,omitempty"`
+	CustomS3TableBucketArn *string                                               `json:"customS3TableBucketARN,omitempty"`
+	Maintenance            AWSEnvHostedSpecFragment_Iceberg_Catalogs_Maintenance `json:"maintenance"`
+	Name                   *string                                               `json:"name,omitempty"`
+	Region                 *string                                               `json:"region,omitempty"`
+	Type                   AWSEnvHostedIcebergCatalogTypeSpec                    `json:"type"`
+	Watches                []*AWSEnvHostedSpecFragment_Iceberg_Catalogs_Watches  `json:"watches"`
+}
+
+type AWSEnvHostedSpecFragment_Iceberg_Catalogs_Maintenance struct {
+	Enabled bool `json:"enabled"`
+}
+
+type AWSEnvHostedSpecFragment_Iceberg_Catalogs_Watches struct {
+	PathsRelativeToTableLocation []string `json:"pathsRelativeToTableLocation"`
+	Table                        string   `json:"table"`
+}
+
+type AWSEnvHostedSpecFragment_LoadBalancers struct {
+	Internal AWSEnvHostedSpecFragment_LoadBalancers_Internal `json:"internal"`
+	Public   AWSEnvHostedSpecFragment_LoadBalancers_Public   `json:"public"`
+}
+
+type AWSEnvHostedSpecFragment_LoadBalancers_Internal struct {
+	Enabled                          bool     `json:"enabled"`
+	EndpointServiceAllowedPrincipals []string `json:"endpointServiceAllowedPrincipals"`
+	EndpointServiceSupportedRegions  []string `json:"endpointServiceSupportedRegions"`
+	SourceIPRanges                   []string `json:"sourceIPRanges"`
+}
+
+type AWSEnvHostedSpecFragment_LoadBalancers_Public struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AWSEnvHostedSpecFragment_MaintenanceWindows struct {
+	Days          []Day  `json:"days"`
+	Enabled       bool   `json:"enabled"`
+	Hour          int64  `json:"hour"`
+	LengthInHours int64  `json:"lengthInHours"`
+	Name          string `json:"name"`
+}
+
+type AWSEnvHostedSpecFragment_MetricsEndpoint struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AWSEnvHostedSpecFragment_NodeGroups struct {
+	CapacityPerZone int64             `json:"capacityPerZone"`
+	Name            string            `json:"name"`
+	NodeType        string            `json:"nodeType"`
+	Reservations    []NodeReservation `json:"reservations"`
+	ZoneIDs         []string          `json:"zoneIDs"`
+}
+
+// AWS environment status.
+type AWSEnvHostedStatus struct {
+	// Applied spec revision (monotonically-increasing).
+	AppliedSpecRevision int64 `json:"appliedSpecRevision"`
+	// Status of load balancers.
+	LoadBalancers *AWSEnvHostedLoadBalancersStatus `json:"loadBalancers"`
+	// True indicates that environment is pending deletion.
+	PendingDelete bool `json:"pendingDelete"`
+	// Status errors.
+	Errors []*EnvStatusError `json:"errors"`
+}
+
+// AWS environment configuration update request input.
+type AWSEnvHostedUpdateSpecInput struct {
+	// Number of AWS availability zones.
+	// At least 2 required (if specified).
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of AWS availability zone ids (https://docs.aws.amazon.com/global-infrastructure/latest/regions/az-ids.html).
+	// At least 2 required (if specified).
+	//
+	// Example: ["usw2-az1", "usw2-az2"]
+	ZoneIDs []string `json:"zoneIDs,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *AWSEnvHostedLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// List of node groups.
+	NodeGroups []*AWSEnvHostedNodeGroupSpecInput `json:"nodeGroups,omitempty"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// VPC endpoints configuration.
+	Endpoints []*AWSEnvHostedEndpointSpecInput `json:"endpoints,omitempty"`
+	// List of external S3 buckets to allow access to.
+	ExternalBuckets []*AWSEnvHostedExternalBucketSpecInput `json:"externalBuckets,omitempty"`
+	// Backups configuration.
+	Backups *AWSEnvHostedBackupsSpecInput `json:"backups,omitempty"`
+	// Iceberg configuration.
+	Iceberg *AWSEnvHostedIcebergUpdateInputSpec `json:"iceberg,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create or patch, matched by name.
+	ClickHouseClusters []*ClickHouseClusterUpdateSpecInput `json:"clickHouseClusters,omitempty"`
+	// Names of ClickHouse clusters to delete. Naming a cluster that does not exist is an error.
+	ClickHouseClustersToDelete []string `json:"clickHouseClustersToDelete,omitempty"`
+	// ClickHouse Keepers to create or patch, matched by name.
+	ClickHouseKeepers []*ClickHouseKeeperUpdateSpecInput `json:"clickHouseKeepers,omitempty"`
+	// Names of ClickHouse Keepers to delete. Naming a Keeper that does not exist is an error.
+	ClickHouseKeepersToDelete []string `json:"clickHouseKeepersToDelete,omitempty"`
+}
+
+// AWS environment internal load balancer configuration.
+type AWSEnvLoadBalancerInternalSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+	// True indicates that traffic should be distributed across all specified availability zones,
+	// false otherwise.
+	//
+	// True by default.
+	CrossZone bool `json:"crossZone"`
+	// ARNs for AWS principals that are allowed to create VPC endpoints.
+	//
+	// Examples:
+	// - arn:aws:iam::$account_id:root
+	EndpointServiceAllowedPrincipals []string `json:"endpointServiceAllowedPrincipals"`
+	// List of supported regions for VPC endpoints.
+	//
+	// Example: ["us-east-1", "us-west-2"]
+	EndpointServiceSupportedRegions []string `json:"endpointServiceSupportedRegions"`
+}
+
+// AWS environment internal load balancer configuration input.
+type AWSEnvLoadBalancerInternalSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+	// True indicates that traffic should be distributed across all specified availability zones,
+	// false otherwise.
+	//
+	// True by default.
+	CrossZone *bool `json:"crossZone,omitempty"`
+	// ARNs for AWS principals that are allowed to create VPC endpoints.
+	//
+	// Examples:
+	// - arn:aws:iam::$account_id:root
+	EndpointServiceAllowedPrincipals []string `json:"endpointServiceAllowedPrincipals,omitempty"`
+	// List of supported regions for VPC endpoints.
+	//
+	// Example: ["us-east-1", "us-west-2"]
+	EndpointServiceSupportedRegions []string `json:"endpointServiceSupportedRegions,omitempty"`
+}
+
+// AWS environment internal load balancer status.
+type AWSEnvLoadBalancerInternalStatus struct {
+	// VPC endpoint service name in $endpoint_service_id.$region.vpce.amazonaws.com format (if any).
+	EndpointServiceName *string `json:"endpointServiceName,omitempty"`
+}
+
+// AWS environment public load balancer configuration.
+type AWSEnvLoadBalancerPublicSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+	// True indicates that traffic should be distributed across all specified availability zones,
+	// false otherwise.
+	//
+	// True by default.
+	CrossZone bool `json:"crossZone"`
+}
+
+// AWS environment public load balancer configuration input.
+type AWSEnvLoadBalancerPublicSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+	// True indicates that traffic should be distributed across all specified availability zones,
+	// false otherwise.
+	//
+	// True by default.
+	CrossZone *bool `json:"crossZone,omitempty"`
+}
+
+// AWS environment load balancers configuration.
+type AWSEnvLoadBalancersSpec struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *AWSEnvLoadBalancerPublicSpec `json:"public"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *AWSEnvLoadBalancerInternalSpec `json:"internal"`
+}
+
+// AWS environment load balancers configuration input.
+type AWSEnvLoadBalancersSpecInput struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *AWSEnvLoadBalancerPublicSpecInput `json:"public,omitempty"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *AWSEnvLoadBalancerInternalSpecInput `json:"internal,omitempty"`
+}
+
+// AWS environment load balancers status.
+type AWSEnvLoadBalancersStatus struct {
+	// Status of internal load balancer.
+	Internal *AWSEnvLoadBalancerInternalStatus `json:"internal"`
+}
+
+// AWS environment node group configuration.
+type AWSEnvNodeGroupSpec struct {
+	// Unique (among environment node groups) node group identifier.
+	Name string `json:"name"`
+	// Instance type (https://aws.amazon.com/ec2/instance-types/).
+	//
+	// Example: "t4g.large"
+	NodeType string `json:"nodeType"`
+	// Availability zones.
+	Zones []string `json:"zones"`
+	// Maximum number of instances per availability zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workload that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations"`
+}
+
+// AWS environment node group configuration input.
+type AWSEnvNodeGroupSpecInput struct {
+	// Unique (among environment node groups) node group identifier.
+	//
+	// Defaults to $nodeType.
+	Name *string `json:"name,omitempty"`
+	// Instance type (https://aws.amazon.com/ec2/instance-types/).
+	NodeType string `json:"nodeType"`
+	// Availability zones.
+	//
+	// Defaults to environment zones.
+	Zones []string `json:"zones,omitempty"`
+	// Maximum number of instances per availability zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workloads this group is reserved for.
+	// Defaults to all.
+	Reservations []NodeReservation `json:"reservations,omitempty"`
+}
+
+// AWS environment VPC peering configuration.
+type AWSEnvPeeringConnectionSpec struct {
+	// Target VPC ID.
+	VpcID string `json:"vpcID"`
+	// Target VPC region.
+	//
+	// Defaults to environment region.
+	VpcRegion *string `json:"vpcRegion,omitempty"`
+	// Target VPC AWS account ID.
+	//
+	// Defaults to environment AWS account ID.
+	AWSAccountID *string `json:"awsAccountID,omitempty"`
+}
+
+// AWS environment VPC peering configuration input.
+type AWSEnvPeeringConnectionSpecInput struct {
+	// Target VPC ID.
+	VpcID string `json:"vpcID"`
+	// Target VPC region.
+	//
+	// Defaults to environment region.
+	VpcRegion *string `json:"vpcRegion,omitempty"`
+	// Target VPC AWS account ID.
+	//
+	// Defaults to environment AWS account ID.
+	AWSAccountID *string `json:"awsAccountID,omitempty"`
+}
+
+// AWS environment peering connection status.
+type AWSEnvPeeringConnectionStatus struct {
+	// Connection ID.
+	ID *string `json:"id,omitempty"`
+	// Target VPC ID.
+	VpcID string `json:"vpcID"`
+}
+
+// AWS environment configuration.
+type AWSEnvSpec struct {
+	// ID of the AWS account (https://docs.aws.amazon.com/IAM/latest/UserGuide/console-account-id.html#w5aac11c17b5)
+	// in which to provision AWS resources.
+	//
+	// Immutable.
+	AWSAccountID string `json:"awsAccountID"`
+	// VPC CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16).
+	//
+	// At least /21 required.
+	//
+	// Examples: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// AWS region (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.RegionsAndAvailabilityZones.html#Concepts.RegionsAndAvailabilityZones.Regions).
+	//
+	// Example: "us-east-1"
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Explicit list of AWS availability zones.
+	// At least 2 required.
+	//
+	// Example: ["us-east-1a", "us-east-1b"]
+	Zones []string `json:"zones"`
+	// Enable AWS NAT Gateway.
+	//
+	// Immutable.
+	Nat bool `json:"NAT"`
+	// Load balancers configuration.
+	LoadBalancers *AWSEnvLoadBalancersSpec `json:"loadBalancers"`
+	// Load balancing strategy.
+	LoadBalancingStrategy LoadBalancingStrategy `json:"loadBalancingStrategy"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*AWSEnvNodeGroupSpec `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpec `json:"maintenanceWindows"`
+	// Custom domain (deprecated, use customDomains instead).
+	//
+	// Returns customDomains[0] for backwards compatibility.
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains"`
+	// VPC peering configuration.
+	PeeringConnections []*AWSEnvPeeringConnectionSpec `json:"peeringConnections"`
+	// VPC endpoints configuration.
+	Endpoints []*AWSEnvEndpointSpec `json:"endpoints"`
+	// Tags to apply to AWS resources.
+	Tags []*KeyValue `json:"tags"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management.
+	CloudConnect bool `json:"cloudConnect"`
+	// Permissions boundary policy ARN.
+	PermissionsBoundaryPolicyArn *string `json:"permissionsBoundaryPolicyArn,omitempty"`
+	// Prefix for AWS resources created by this environment.
+	ResourcePrefix string `json:"resourcePrefix"`
+	// List of external S3 buckets to allow access to.
+	ExternalBuckets []*AWSEnvExternalBucketSpec `json:"externalBuckets"`
+	// Backups configuration.
+	Backups *AWSEnvBackupsSpec `json:"backups,omitempty"`
+	// Iceberg configuration.
+	Iceberg *IcebergSpec `json:"iceberg,omitempty"`
+	// Enable/Disable EKS control plane logging to CloudWatch.
+	EksLogging bool `json:"eksLogging"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpec `json:"metricsEndpoint"`
+	// Datadog agent configuration.
+	Datadog *DatadogSpec `json:"datadog"`
+	// ARN of the customer's KMS key for encrypting Altinity-provisioned data buckets
+	// and EBS volumes.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+	// ClickHouse clusters.
+	ClickHouseClusters []*ClickHouseClusterSpec `json:"clickHouseClusters"`
+	// ClickHouse Keepers.
+	ClickHouseKeepers []*ClickHouseKeeperSpec `json:"clickHouseKeepers"`
+}
+
+type AWSEnvSpecFragment struct {
+	LoadBalancers                AWSEnvSpecFragment_LoadBalancers         `json:"loadBalancers"`
+	LoadBalancingStrategy        LoadBalancingStrategy                    `json:"loadBalancingStrategy"`
+	CustomDomain                 *string                                  `json:"customDomain,omitempty"`
+	CustomDomains                []string                                 `json:"customDomains"`
+	NodeGroups                   []*AWSEnvSpecFragment_NodeGroups         `json:"nodeGroups"`
+	MaintenanceWindows           []*AWSEnvSpecFragment_MaintenanceWindows `json:"maintenanceWindows"`
+	Region                       string                                   `json:"region"`
+	Nat                          bool                                     `json:"NAT"`
+	Zones                        []string                                 `json:"zones"`
+	Cidr                         string                                   `json:"cidr"`
+	AWSAccountID                 string                                   `json:"awsAccountID"`
+	PermissionsBoundaryPolicyArn *string                                  `json:"permissionsBoundaryPolicyArn,omitempty"`
+	ResourcePrefix               string                                   `json:"resourcePrefix"`
+	KmsKeyArn                    *string                                  `json:"kmsKeyARN,omitempty"`
+	PeeringConnections           []*AWSEnvSpecFragment_PeeringConnections `json:"peeringConnections"`
+	Endpoints                    []*AWSEnvSpecFragment_Endpoints          `json:"endpoints"`
+	Tags                         []*AWSEnvSpecFragment_Tags               `json:"tags"`
+	CloudConnect                 bool                                     `json:"cloudConnect"`
+	ExternalBuckets              []*AWSEnvSpecFragment_ExternalBuckets    `json:"externalBuckets"`
+	Backups                      *AWSEnvSpecFragment_Backups              `json:"backups,omitempty"`
+	Iceberg                      *AWSEnvSpecFragment_Iceberg              `json:"iceberg,omitempty"`
+	EksLogging                   bool                                     `json:"eksLogging"`
+	MetricsEndpoint              AWSEnvSpecFragment_MetricsEndpoint       `json:"metricsEndpoint"`
+	Datadog                      AWSEnvSpecFragment_Datadog               `json:"datadog"`
+}
+
+type AWSEnvSpecFragment_Backups struct {
+	CustomBucket *AWSEnvSpecFragment_Backups_CustomBucket `json:"customBucket,omitempty"`
+}
+
+type AWSEnvSpecFragment_Backups_CustomBucket struct {
+	Name    string `json:"name"`
+	Region  string `json:"region"`
+	RoleArn string `json:"roleARN"`
+}
+
+type AWSEnvSpecFragment_Datadog struct {
+	Domain         string `json:"domain"`
+	Enabled        bool   `json:"enabled"`
+	LogsEnabled    bool   `json:"logsEnabled"`
+	MetricsEnabled bool   `json:"metricsEnabled"`
+}
+
+type AWSEnvSpecFragment_Endpoints struct {
+	Alias       *string `json:"alias,omitempty"`
+	PrivateDNS  bool    `json:"privateDNS"`
+	ServiceName string  `json:"serviceName"`
+}
+
+type AWSEnvSpecFragment_ExternalBuckets struct {
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+	Name      string  `json:"name"`
+}
+
+type AWSEnvSpecFragment_Iceberg struct {
+	Catalogs []*AWSEnvSpecFragment_Iceberg_Catalogs `json:"catalogs"`
+}
+
+type AWSEnvSpecFragment_Iceberg_Catalogs struct {
+	AnonymousAccessEnabled *bool                                           `json:"anonymousAccessEnabled,omitempty"`
+	CustomS3TableBucketArn *string                                         `json:"customS3TableBucketARN,omitempty"`
+	Maintenance            AWSEnvSpecFragment_Iceberg_Catalogs_Maintenance `json:"maintenance"`
+	Name                   *string                                         `json:"name,omitempty"`
+	Type                   IcebergCatalogTypeSpec                          `json:"type"`
+	Watches                []*AWSEnvSpecFragment_Iceberg_Catalogs_Watches  `json:"watches"`
+}
+
+type AWSEnvSpecFragment_Iceberg_Catalogs_Maintenance struct {
+	Enabled bool `json:"enabled"`
+}
+
+type AWSEnvSpecFragment_Iceberg_Catalogs_Watches struct {
+	PathsRelativeToTableLocation []string `json:"pathsRelativeToTableLocation"`
+	Table                        string   `json:"table"`
+}
+
+type AWSEnvSpecFragment_LoadBalancers struct {
+	Internal AWSEnvSpecFragment_LoadBalancers_Internal `json:"internal"`
+	Public   AWSEnvSpecFragment_LoadBalancers_Public   `json:"public"`
+}
+
+type AWSEnvSpecFragment_LoadBalancers_Internal struct {
+	CrossZone                        bool     `json:"crossZone"`
+	Enabled                          bool     `json:"enabled"`
+	EndpointServiceAllowedPrincipals []string `json:"endpointServiceAllowedPrincipals"`
+	EndpointServiceSupportedRegions  []string `json:"endpointServiceSupportedRegions"`
+	SourceIPRanges                   []string `json:"sourceIPRanges"`
+}
+
+type AWSEnvSpecFragment_LoadBalancers_Public struct {
+	CrossZone      bool     `json:"crossZone"`
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AWSEnvSpecFragment_MaintenanceWindows struct {
+	Days          []Day  `json:"days"`
+	Enabled       bool   `json:"enabled"`
+	Hour          int64  `json:"hour"`
+	LengthInHours int64  `json:"lengthInHours"`
+	Name          string `json:"name"`
+}
+
+type AWSEnvSpecFragment_MetricsEndpoint struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AWSEnvSpecFragment_NodeGroups struct {
+	CapacityPerZone int64             `json:"capacityPerZone"`
+	Name            string            `json:"name"`
+	NodeType        string            `json:"nodeType"`
+	Reservations    []NodeReservation `json:"reservations"`
+	Zones           []string          `json:"zones"`
+}
+
+type AWSEnvSpecFragment_PeeringConnections struct {
+	AWSAccountID *string `json:"awsAccountID,omitempty"`
+	VpcID        string  `json:"vpcID"`
+	VpcRegion    *string `json:"vpcRegion,omitempty"`
+}
+
+type AWSEnvSpecFragment_Tags struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// AWS environment status.
+type AWSEnvStatus struct {
+	// Applied spec revision (monotonically-increasing).
+	AppliedSpecRevision int64 `json:"appliedSpecRevision"`
+	// Status of load balancers.
+	LoadBalancers *AWSEnvLoadBalancersStatus `json:"loadBalancers"`
+	// Status of peering connections.
+	PeeringConnections []*AWSEnvPeeringConnectionStatus `json:"peeringConnections"`
+	// True indicates that environment is pending deletion.
+	PendingDelete bool `json:"pendingDelete"`
+	// AWS resources information.
+	AWSResources []*AWSResource `json:"awsResources"`
+	// Status errors.
+	Errors []*EnvStatusError `json:"errors"`
+}
+
+// AWS environment configuration update request input.
+type AWSEnvUpdateSpecInput struct {
+	// Number of AWS availability zones.
+	// At least 2 required (if specified).
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of AWS availability zones.
+	// At least 2 required (if specified).
+	//
+	// Example: ["us-east-1a", "us-east-1b"]
+	Zones []string `json:"zones,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *AWSEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	NodeGroups []*AWSEnvNodeGroupSpecInput `json:"nodeGroups,omitempty"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// VPC peering configuration.
+	PeeringConnections []*AWSEnvPeeringConnectionSpecInput `json:"peeringConnections,omitempty"`
+	// VPC endpoints configuration.
+	Endpoints []*AWSEnvEndpointSpecInput `json:"endpoints,omitempty"`
+	// Tags to apply to AWS resources.
+	Tags []*KeyValueInput `json:"tags,omitempty"`
+	// List of external S3 buckets to allow access to.
+	ExternalBuckets []*AWSEnvExternalBucketSpecInput `json:"externalBuckets,omitempty"`
+	// Backups configuration.
+	Backups *AWSEnvBackupsSpecInput `json:"backups,omitempty"`
+	// Iceberg configuration.
+	Iceberg *IcebergUpdateInputSpec `json:"iceberg,omitempty"`
+	// Enable/Disable EKS control plane logging to CloudWatch.
+	EksLogging *bool `json:"eksLogging,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create or patch, matched by name.
+	ClickHouseClusters []*ClickHouseClusterUpdateSpecInput `json:"clickHouseClusters,omitempty"`
+	// Names of ClickHouse clusters to delete. Naming a cluster that does not exist is an error.
+	ClickHouseClustersToDelete []string `json:"clickHouseClustersToDelete,omitempty"`
+	// ClickHouse Keepers to create or patch, matched by name.
+	ClickHouseKeepers []*ClickHouseKeeperUpdateSpecInput `json:"clickHouseKeepers,omitempty"`
+	// Names of ClickHouse Keepers to delete. Naming a Keeper that does not exist is an error.
+	ClickHouseKeepersToDelete []string `json:"clickHouseKeepersToDelete,omitempty"`
+}
+
+// AWS resource information.
+type AWSResource struct {
+	// Resource name (e.g. "oidcProvider").
+	Name string `json:"name"`
+	// Resource ARN.
+	Arn string `json:"arn"`
+	// Resource ID.
+	ID string `json:"id"`
+}
+
+// Azure environment.
+type AzureEnv struct {
+	// A globally-unique environment identifier.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *AzureEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+	// Environment status.
+	Status *AzureEnvStatus `json:"status"`
+}
+
+// Azure environments query filter.
+type AzureEnvFilter struct {
+	// Names of the environments to return.
+	// Names that don't match any of the existing environments are ignored.
+	Names []string `json:"names,omitempty"`
+}
+
+// Azure environment internal load balancer configuration.
+type AzureEnvLoadBalancerInternalSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AzureEnvLoadBalancerInternalSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// Azure environment internal load balancer status.
+type AzureEnvLoadBalancerInternalStatus struct {
+	// Private Link Service Alias / DNS Name in prefix.GUID.suffix format.
+	PrivateLinkServiceAlias *string `json:"privateLinkServiceAlias,omitempty"`
+}
+
+// Azure environment public load balancer configuration.
+type AzureEnvLoadBalancerPublicSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AzureEnvLoadBalancerPublicSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// Azure environment load balancers configuration.
+type AzureEnvLoadBalancersSpec struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *AzureEnvLoadBalancerPublicSpec `json:"public"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *AzureEnvLoadBalancerInternalSpec `json:"internal"`
+}
+
+// Azure environment load balancers configuration input.
+type AzureEnvLoadBalancersSpecInput struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *AzureEnvLoadBalancerPublicSpecInput `json:"public,omitempty"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *AzureEnvLoadBalancerInternalSpecInput `json:"internal,omitempty"`
+}
+
+// Azure environment load balancers status.
+type AzureEnvLoadBalancersStatus struct {
+	// Status of internal load balancer.
+	Internal *AzureEnvLoadBalancerInternalStatus `json:"internal"`
+}
+
+// Azure environment node group configuration.
+type AzureEnvNodeGroupSpec struct {
+	// Unique (among environment node groups) node group identifier.
+	Name string `json:"name"`
+	// Instance type (https://azure.microsoft.com/en-us/pricing/details/virtual-machines/series).
+	//
+	// Example: "PremiumV2_LRS"
+	NodeType string `json:"nodeType"`
+	// Availability zones.
+	Zones []string `json:"zones"`
+	// Maximum number of instances per availability zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workload that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations"`
+}
+
+// Azure environment node group configuration input.
+type AzureEnvNodeGroupSpecInput struct {
+	// Unique (among environment node groups) node group identifier.
+	//
+	// Defaults to $nodeType.
+	Name *string `json:"name,omitempty"`
+	// Machine type (https://azure.microsoft.com/en-us/pricing/details/virtual-machines/series).
+	//
+	// Example: "PremiumV2_LRS"
+	NodeType string `json:"nodeType"`
+	// Azure zones.
+	//
+	// Defaults to environment zones.
+	Zones []string `json:"zones,omitempty"`
+	// Maximum number of instances per zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workloads that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations,omitempty"`
+}
+
+// Azure environment configuration.
+type AzureEnvSpec struct {
+	// ID of the Azure Active Directory tenant for user identity and access management.
+	//
+	// Immutable.
+	TenantID string `json:"tenantID"`
+	// ID linking the environment to a specific Azure subscription for resource management.
+	//
+	// Immutable.
+	SubscriptionID string `json:"subscriptionID"`
+	// VPC CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16).
+	//
+	// At least /21 required.
+	//
+	// Examples: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// Azure region (https://azure.microsoft.com/en-us/explore/global-infrastructure/geographies).
+	//
+	// Example: "eastus"
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Explicit list of Azure availability zones.
+	// At least 2 required.
+	//
+	// Example: ["eastus-1", "eastus-2"]
+	Zones []string `json:"zones"`
+	// Load balancers configuration.
+	LoadBalancers *AzureEnvLoadBalancersSpec `json:"loadBalancers"`
+	// Load balancing strategy.
+	LoadBalancingStrategy LoadBalancingStrategy `json:"loadBalancingStrategy"`
+	// AKS Kubernetes support policy.
+	AksSupportPolicy AKSSupportPolicy `json:"aksSupportPolicy"`
+	// AKS SKU tier.
+	AksSKUTier AKSSKUTier `json:"aksSKUTier"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*AzureEnvNodeGroupSpec `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpec `json:"maintenanceWindows"`
+	// Custom domain (deprecated, use customDomains instead).
+	//
+	// Returns customDomains[0] for backwards compatibility.
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                              → _.$env_name.altinity.cloud.
+	// - *.example.com.                            → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com.     → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                     → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.                   → _.internal.$env_name.altinity.cloud.
+	//
+	// Private Link (if used):
+	// - _acme-challenge.privatelink.example.com.  → privatelink.$env_name.altinity.cloud.
+	// - privatelink.example.com.                  → _.privatelink.$env_name.altinity.cloud.
+	// - *.privatelink.example.com.                → _.privatelink.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains"`
+	// Azure Private Link service configuration.
+	PrivateLinkService *PrivateLinkServiceSpec `json:"privateLinkService"`
+	// Tags to apply to Azure resources.
+	Tags []*KeyValue `json:"tags"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management.
+	CloudConnect bool `json:"cloudConnect"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpec `json:"metricsEndpoint"`
+	// Datadog agent configuration.
+	Datadog *DatadogSpec `json:"datadog"`
+	// ClickHouse clusters.
+	ClickHouseClusters []*ClickHouseClusterSpec `json:"clickHouseClusters"`
+	// ClickHouse Keepers.
+	ClickHouseKeepers []*ClickHouseKeeperSpec `json:"clickHouseKeepers"`
+}
+
+type AzureEnvSpecFragment struct {
+	LoadBalancers         AzureEnvSpecFragment_LoadBalancers         `json:"loadBalancers"`
+	LoadBalancingStrategy LoadBalancingStrategy                      `json:"loadBalancingStrategy"`
+	CustomDomain          *string                                    `json:"customDomain,omitempty"`
+	CustomDomains         []string                                   `json:"customDomains"`
+	NodeGroups            []*AzureEnvSpecFragment_NodeGroups         `json:"nodeGroups"`
+	MaintenanceWindows    []*AzureEnvSpecFragment_MaintenanceWindows `json:"maintenanceWindows"`
+	Tags                  []*AzureEnvSpecFragment_Tags               `json:"tags"`
+	PrivateLinkService    AzureEnvSpecFragment_PrivateLinkService    `json:"privateLinkService"`
+	Region                string                                     `json:"region"`
+	Zones                 []string                                   `json:"zones"`
+	Cidr                  string                                     `json:"cidr"`
+	TenantID              string                                     `json:"tenantID"`
+	SubscriptionID        string                                     `json:"subscriptionID"`
+	CloudConnect          bool                                       `json:"cloudConnect"`
+	MetricsEndpoint       AzureEnvSpecFragment_MetricsEndpoint       `json:"metricsEndpoint"`
+	Datadog               AzureEnvSpecFragment_Datadog               `json:"datadog"`
+}
+
+type AzureEnvSpecFragment_Datadog struct {
+	Domain         string `json:"domain"`
+	Enabled        bool   `json:"enabled"`
+	LogsEnabled    bool   `json:"logsEnabled"`
+	MetricsEnabled bool   `json:"metricsEnabled"`
+}
+
+type AzureEnvSpecFragment_LoadBalancers struct {
+	Internal AzureEnvSpecFragment_LoadBalancers_Internal `json:"internal"`
+	Public   AzureEnvSpecFragment_LoadBalancers_Public   `json:"public"`
+}
+
+type AzureEnvSpecFragment_LoadBalancers_Internal struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AzureEnvSpecFragment_LoadBalancers_Public struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AzureEnvSpecFragment_MaintenanceWindows struct {
+	Days          []Day  `json:"days"`
+	Enabled       bool   `json:"enabled"`
+	Hour          int64  `json:"hour"`
+	LengthInHours int64  `json:"lengthInHours"`
+	Name          string `json:"name"`
+}
+
+type AzureEnvSpecFragment_MetricsEndpoint struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type AzureEnvSpecFragment_NodeGroups struct {
+	CapacityPerZone int64             `json:"capacityPerZone"`
+	Name            string            `json:"name"`
+	NodeType        string            `json:"nodeType"`
+	Reservations    []NodeReservation `json:"reservations"`
+	Zones           []string          `json:"zones"`
+}
+
+type AzureEnvSpecFragment_PrivateLinkService struct {
+	AllowedSubscriptions []string `json:"allowedSubscriptions"`
+}
+
+type AzureEnvSpecFragment_Tags struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Azure environment status.
+type AzureEnvStatus struct {
+	// Applied spec revision (monotonically-increasing).
+	AppliedSpecRevision int64 `json:"appliedSpecRevision"`
+	// Status of load balancers.
+	LoadBalancers *AzureEnvLoadBalancersStatus `json:"loadBalancers"`
+	// True indicates that environment is pending deletion.
+	PendingDelete bool `json:"pendingDelete"`
+	// Status errors.
+	Errors []*EnvStatusError `json:"errors"`
+}
+
+// ClickHouse cluster to create with the environment.
+type ClickHouseClusterCreateSpecInput struct {
+	// Cluster identifier, unique within the environment. 2-15 chars, lowercase alphanumerics and hyphens, must start and end with an alphanumeric. Immutable.
+	Name string `json:"name"`
+	// Topology mode. STANDARD by default. Immutable.
+	Mode *ClickHouseClusterModeSpec `json:"mode,omitempty"`
+	// Server image, tag included. Environments hosted by Altinity accept altinity/clickhouse-server images only.
+	Image string `json:"image"`
+	// Machine type for the cluster nodes. Must match a node group with a CLICKHOUSE reservation.
+	InstanceType string `json:"instanceType"`
+	// Zones the cluster is spread across. All environment zones by default. Immutable.
+	Zones []string `json:"zones,omitempty"`
+	// Number of shards.
+	Shards int64 `json:"shards"`
+	// Number of replicas per shard.
+	Replicas int64 `json:"replicas"`
+	// True to leave the cluster stopped.
+	Stopped *bool `json:"stopped,omitempty"`
+	// Main data volume, named `default`. It cannot be removed later.
+	Disk *ClickHouseDiskCreateSpecInput `json:"disk"`
+	// Extra data volumes beside the main one, each under its own name. 8 maximum.
+	AdditionalDisks []*ClickHouseDiskCreateSpecInput `json:"additionalDisks,omitempty"`
+	// Keeper the cluster coordinates through. Only a SWARM cluster may create it disabled.
+	Keeper *ClickHouseKeeperSpecInput `json:"keeper"`
+	// Server-level settings applied to every node.
+	Settings []*ClickHouseSettingSpecInput `json:"settings,omitempty"`
+	// Settings profiles users can be assigned to.
+	Profiles []*ClickHouseProfileCreateSpecInput `json:"profiles,omitempty"`
+	// ClickHouse users.
+	Users []*ClickHouseUserSpecInput `json:"users,omitempty"`
+}
+
+// ClickHouse cluster topology mode.
+type ClickHouseClusterModeSpec string
+
+// ClickHouse cluster configuration.
+type ClickHouseClusterSpec struct {
+	// Cluster identifier, unique within the environment.
+	Name string `json:"name"`
+	// Topology mode.
+	Mode ClickHouseClusterModeSpec `json:"mode"`
+	// Server image, tag included.
+	Image string `json:"image"`
+	// Machine type the cluster nodes run on.
+	InstanceType string `json:"instanceType"`
+	// Zones the cluster is spread across.
+	Zones []string `json:"zones"`
+	// Number of shards.
+	Shards int64 `json:"shards"`
+	// Number of replicas per shard.
+	Replicas int64 `json:"replicas"`
+	// True when the cluster is kept stopped.
+	Stopped bool `json:"stopped"`
+	// Main data volume.
+	Disk *ClickHouseDiskSpec `json:"disk"`
+	// Extra data volumes beside the main one.
+	AdditionalDisks []*ClickHouseDiskSpec `json:"additionalDisks"`
+	// Keeper this cluster coordinates through. Null when it has none.
+	Keeper *ClickHouseKeeperRefSpec `json:"keeper,omitempty"`
+	// Server-level settings applied to every node.
+	Settings []*ClickHouseSettingSpec `json:"settings"`
+	// Settings profiles users can be assigned to.
+	Profiles []*ClickHouseProfileSpec `json:"profiles"`
+	// ClickHouse users. Passwords are never returned.
+	Users []*ClickHouseUserSpec `json:"users"`
+}
+
+// ClickHouse cluster to create or patch. Entries are matched by name.
+type ClickHouseClusterUpdateSpecInput struct {
+	// Cluster identifier, unique within the environment. Uses the name to find the cluster to update. Immutable.
+	Name string `json:"name"`
+	// Server image, tag included. Environments hosted by Altinity accept altinity/clickhouse-server images only.
+	Image *string `json:"image,omitempty"`
+	// Machine type for the cluster nodes. Must match a node group with a CLICKHOUSE reservation.
+	InstanceType *string `json:"instanceType,omitempty"`
+	// Number of shards.
+	Shards *int64 `json:"shards,omitempty"`
+	// Number of replicas per shard.
+	Replicas *int64 `json:"replicas,omitempty"`
+	// True to keep the cluster stopped.
+	Stopped *bool `json:"stopped,omitempty"`
+	// Main data volume, named `default`. Omit it to leave the volume as it is. It can never be removed.
+	Disk *ClickHouseDiskUpdateSpecInput `json:"disk,omitempty"`
+	// Extra volumes to create or patch, matched by name. An omitted volume keeps its configuration. 8 maximum.
+	AdditionalDisks []*ClickHouseDiskUpdateSpecInput `json:"additionalDisks,omitempty"`
+	// Names of extra volumes to delete. The main volume cannot be named here.
+	AdditionalDisksToDelete []string `json:"additionalDisksToDelete,omitempty"`
+	// Keeper the cluster coordinates through. Omit it to leave the current one attached. Only a SWARM cluster may disable it and detach.
+	Keeper *ClickHouseKeeperSpecInput `json:"keeper,omitempty"`
+	// Server-level settings to create or patch, matched by key.
+	Settings []*ClickHouseSettingSpecInput `json:"settings,omitempty"`
+	// Keys of server-level settings to delete.
+	SettingsToDelete []string `json:"settingsToDelete,omitempty"`
+	// Settings profiles to create or patch, matched by name.
+	Profiles []*ClickHouseProfileUpdateSpecInput `json:"profiles,omitempty"`
+	// Names of settings profiles to delete.
+	ProfilesToDelete []string `json:"profilesToDelete,omitempty"`
+	// ClickHouse users to create or patch, matched by name.
+	Users []*ClickHouseUserSpecInput `json:"users,omitempty"`
+	// Names of ClickHouse users to delete.
+	UsersToDelete []string `json:"usersToDelete,omitempty"`
+}
+
+// Data volume to create. The underlying volume is always deleted along with the
+// resource that owns it.
+type ClickHouseDiskCreateSpecInput struct {
+	// Volume identifier. `default` for a cluster's main volume and for a Keeper volume. An extra volume must start with `disk` and cannot exceed 16 characters. Immutable.
+	Name string `json:"name"`
+	// Size in GiB. Required: a volume is sized once and can only ever grow. 10240 maximum on Hetzner Cloud.
+	Size *int64 `json:"size,omitempty"`
+	// Storage class backing the volume. Environment default when omitted. Immutable.
+	StorageClass *string `json:"storageClass,omitempty"`
+	// Provisioned IOPS. Honored only by storage classes that support it, such as AWS gp3 and io2.
+	Iops *int64 `json:"iops,omitempty"`
+	// Provisioned throughput in MiB/s. Honored only by storage classes that support it, such as AWS gp3.
+	Throughput *int64 `json:"throughput,omitempty"`
+}
+
+// ClickHouse data volume.
+type ClickHouseDiskSpec struct {
+	// Volume identifier. `default` for a cluster's main volume and for a Keeper volume.
+	Name string `json:"name"`
+	// Size in GiB.
+	Size int64 `json:"size"`
+	// Storage class backing the volume. Empty when the environment default is used.
+	StorageClass string `json:"storageClass"`
+	// Provisioned IOPS. 0 when not set.
+	Iops int64 `json:"iops"`
+	// Provisioned throughput in MiB/s. 0 when not set.
+	Throughput int64 `json:"throughput"`
+}
+
+// Data volume to create or patch, matched by name.
+//
+// The storage class is immutable and so cannot be set here; a volume added
+// through an update uses the environment default.
+type ClickHouseDiskUpdateSpecInput struct {
+	// Volume identifier. `default` for a cluster's main volume and for a Keeper volume. An extra volume must start with `disk` and cannot exceed 16 characters. Immutable.
+	Name string `json:"name"`
+	// Size in GiB. Can only be increased, never decreased.
+	Size *int64 `json:"size,omitempty"`
+	// Provisioned IOPS. Honored only by storage classes that support it, such as AWS gp3 and io2.
+	Iops *int64 `json:"iops,omitempty"`
+	// Provisioned throughput in MiB/s. Honored only by storage classes that support it, such as AWS gp3.
+	Throughput *int64 `json:"throughput,omitempty"`
+}
+
+// ClickHouse Keeper to create with the environment.
+type ClickHouseKeeperCreateSpecInput struct {
+	// Keeper identifier, unique within the environment. 2-15 chars, lowercase alphanumerics and hyphens, must start and end with an alphanumeric. Immutable.
+	Name string `json:"name"`
+	// Machine type for the Keeper nodes. Must match a node group with a ZOOKEEPER reservation.
+	InstanceType string `json:"instanceType"`
+	// Zones the Keeper is spread across. All environment zones by default. Immutable.
+	Zones []string `json:"zones,omitempty"`
+	// True for a 3-node highly-available ensemble, false for a single node. True by default.
+	Ha *bool `json:"ha,omitempty"`
+	// True to leave the Keeper stopped.
+	Stopped *bool `json:"stopped,omitempty"`
+	// Data volume, named `default`.
+	Disk *ClickHouseDiskCreateSpecInput `json:"disk"`
+}
+
+// Reference to the Keeper a cluster coordinates through.
+type ClickHouseKeeperRefSpec struct {
+	// Name of the Keeper in this environment.
+	Name string `json:"name"`
+}
+
+// ClickHouse Keeper configuration.
+type ClickHouseKeeperSpec struct {
+	// Keeper identifier, unique within the environment.
+	Name string `json:"name"`
+	// Machine type the Keeper nodes run on.
+	InstanceType string `json:"instanceType"`
+	// Zones the Keeper is spread across.
+	Zones []string `json:"zones"`
+	// True when the Keeper is a 3-node highly-available ensemble.
+	Ha bool `json:"ha"`
+	// True when the Keeper is kept stopped.
+	Stopped bool `json:"stopped"`
+	// Data volume.
+	Disk *ClickHouseDiskSpec `json:"disk"`
+}
+
+// Whether a cluster coordinates through a Keeper.
+type ClickHouseKeeperSpecInput struct {
+	// Whether the cluster coordinates through a Keeper at all. Only a SWARM cluster may set it to false.
+	Enabled bool `json:"enabled"`
+	// Name of a Keeper in this environment, created by this request or already present. Ignored when enabled is false, which clears any name already stored.
+	Name string `json:"name"`
+}
+
+// ClickHouse Keeper to create or patch. Entries are matched by name.
+type ClickHouseKeeperUpdateSpecInput struct {
+	// Keeper identifier, unique within the environment. Creates the Keeper when no Keeper carries this name.
+	Name string `json:"name"`
+	// Machine type for the Keeper nodes. Must match a node group with a ZOOKEEPER reservation. Required when this entry creates the Keeper.
+	InstanceType *string `json:"instanceType,omitempty"`
+	// True for a 3-node highly-available ensemble, false for a single node. True by default when this entry creates the Keeper. On a Keeper that already exists it can only be turned on: an ensemble already running as HA cannot be shrunk back to a single node.
+	Ha *bool `json:"ha,omitempty"`
+	// True to keep the Keeper stopped.
+	Stopped *bool `json:"stopped,omitempty"`
+	// Data volume, named `default`.
+	Disk *ClickHouseDiskUpdateSpecInput `json:"disk,omitempty"`
+}
+
+// Settings profile to create with the cluster.
+type ClickHouseProfileCreateSpecInput struct {
+	// Profile name, unique within the cluster. Referenced by a user's profile field.
+	Name string `json:"name"`
+	// Settings the profile carries.
+	Settings []*ClickHouseSettingSpecInput `json:"settings,omitempty"`
+}
+
+// ClickHouse settings profile.
+type ClickHouseProfileSpec struct {
+	// Profile name, unique within the cluster.
+	Name string `json:"name"`
+	// Settings the profile carries.
+	Settings []*ClickHouseSettingSpec `json:"settings"`
+}
+
+// Settings profile to create or patch, matched by name.
+type ClickHouseProfileUpdateSpecInput struct {
+	// Profile name, unique within the cluster. Referenced by a user's profile field.
+	Name string `json:"name"`
+	// Settings to create or patch, matched by key.
+	Settings []*ClickHouseSettingSpecInput `json:"settings,omitempty"`
+	// Keys of settings to delete from this profile.
+	SettingsToDelete []string `json:"settingsToDelete,omitempty"`
+}
+
+// Reference to a key of a Kubernetes secret in the environment's namespace.
+type ClickHouseSecretRefSpec struct {
+	// Secret name.
+	Name string `json:"name"`
+	// Key within the secret.
+	Key string `json:"key"`
+}
+
+// Reference to a key of a Kubernetes secret in the environment's namespace. The
+// secret must already exist; the platform never creates it.
+type ClickHouseSecretRefSpecInput struct {
+	// Secret name.
+	Name string `json:"name"`
+	// Key within the secret.
+	Key string `json:"key"`
+}
+
+// A single ClickHouse setting.
+type ClickHouseSettingSpec struct {
+	// Setting name.
+	Key string `json:"key"`
+	// Literal value. Empty when the value comes from a secret.
+	Value string `json:"value"`
+	// Secret the value is read from, when it is not stored in the spec.
+	ValueFromSecret *ClickHouseSecretRefSpec `json:"valueFromSecret,omitempty"`
+}
+
+// A single ClickHouse setting. value and valueFromSecret are mutually exclusive.
+type ClickHouseSettingSpecInput struct {
+	// Setting name, for example max_concurrent_queries.
+	Key string `json:"key"`
+	// Literal value.
+	Value *string `json:"value,omitempty"`
+	// Value read from a Kubernetes secret instead of being stored in the spec.
+	ValueFromSecret *ClickHouseSecretRefSpecInput `json:"valueFromSecret,omitempty"`
+}
+
+// Form a user's password is held in.
+type ClickHouseUserPasswordTypeSpec string
+
+// Form in which a user's password is supplied.
+type ClickHouseUserPasswordTypeSpecInput string
+
+// ClickHouse user. Passwords are never returned, only the form they are held in.
+type ClickHouseUserSpec struct {
+	// User name, unique within the cluster.
+	Name string `json:"name"`
+	// Settings profile assigned to this user.
+	Profile string `json:"profile"`
+	// Quota assigned to this user.
+	Quota string `json:"quota"`
+	// CIDRs the user may connect from. Empty when unrestricted.
+	AllowedCIDRs []string `json:"allowedCIDRs"`
+	// Databases the user is granted access to. Empty when all are granted.
+	Databases []string `json:"databases"`
+	// True when the user can manage access control: roles, users, grants.
+	AccessManagement bool `json:"accessManagement"`
+	// True when the user can create and drop named collections.
+	NamedCollectionControl bool `json:"namedCollectionControl"`
+	// True when the user can list named collections.
+	ShowNamedCollections bool `json:"showNamedCollections"`
+	// True when the user can read secrets stored in named collections.
+	ShowNamedCollectionsSecrets bool `json:"showNamedCollectionsSecrets"`
+	// Form the password is held in. Null when the user has no password.
+	PasswordType *ClickHouseUserPasswordTypeSpec `json:"passwordType,omitempty"`
+	// Secret the password is read from, when it is not stored in the spec.
+	PasswordValueFromSecret *ClickHouseSecretRefSpec `json:"passwordValueFromSecret,omitempty"`
+}
+
+// ClickHouse user to create or patch, matched by name.
+type ClickHouseUserSpecInput struct {
+	// User name, unique within the cluster. grafana and datadog are reserved for platform-injected users and are rejected.
+	Name string `json:"name"`
+	// Settings profile assigned to this user. Must be a profile of this cluster or one ClickHouse ships with.
+	Profile *string `json:"profile,omitempty"`
+	// Quota assigned to this user.
+	Quota *string `json:"quota,omitempty"`
+	// CIDRs the user may connect from. Unrestricted when omitted.
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+	// Databases the user is granted access to. All databases when omitted.
+	Databases []string `json:"databases,omitempty"`
+	// True to let the user manage access control: roles, users, grants.
+	AccessManagement *bool `json:"accessManagement,omitempty"`
+	// True to let the user create and drop named collections.
+	NamedCollectionControl *bool `json:"namedCollectionControl,omitempty"`
+	// True to let the user list named collections.
+	ShowNamedCollections *bool `json:"showNamedCollections,omitempty"`
+	// True to let the user read secrets stored in named collections.
+	ShowNamedCollectionsSecrets *bool `json:"showNamedCollectionsSecrets,omitempty"`
+	// Form the password is supplied in. Required whenever a password value is set.
+	PasswordType *ClickHouseUserPasswordTypeSpecInput `json:"passwordType,omitempty"`
+	// Password digest, in the form declared by passwordType.
+	PasswordValue *string `json:"passwordValue,omitempty"`
+	// Password digest read from a Kubernetes secret, as an alternative to passwordValue.
+	PasswordValueFromSecret *ClickHouseSecretRefSpecInput `json:"passwordValueFromSecret,omitempty"`
+}
+
+// AWS environment create request input.
+type CreateAWSEnvHostedInput struct {
+	// A globally-unique environment identifier.
+	//
+	// Immutable.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *CreateAWSEnvHostedSpecInput `json:"spec"`
+}
+
+// CreateAWSEnvHostedResponse is returned by AWSEnvsHosted.Create.
+type CreateAWSEnvHostedResponse struct {
+	MutationID   string                    `json:"mutationId"`
+	Spec         *AWSEnvHostedSpecFragment `json:"spec"`
+	SpecRevision int64                     `json:"specRevision"`
+}
+
+// AWS environment create request result.
+type CreateAWSEnvHostedResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// AWS environment active configuration.
+	Spec *AWSEnvHostedSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// AWS environment configuration create request input.
+type CreateAWSEnvHostedSpecInput struct {
+	// AWS region (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.RegionsAndAvailabilityZones.html#Concepts.RegionsAndAvailabilityZones.Regions).
+	//
+	// Example: "us-east-1"
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Number of AWS availability zones.
+	// At least 2 required.
+	//
+	// This field is optional if "zoneIDs" is specified.
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of AWS availability zones ids (https://docs.aws.amazon.com/global-infrastructure/latest/regions/az-ids.html).
+	// At least 2 required.
+	//
+	// Example: ["usw2-az1", "usw2-az2"]
+	//
+	// This field is optional if "numberOfZones" is specified.
+	ZoneIDs []string `json:"zoneIDs,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *AWSEnvHostedLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*AWSEnvHostedNodeGroupSpecInput `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// VPC endpoints configuration.
+	Endpoints []*AWSEnvHostedEndpointSpecInput `json:"endpoints,omitempty"`
+	// Prefix to apply to the names of AWS resources during creation.
+	//
+	// Immutable.
+	ResourcePrefix *string `json:"resourcePrefix,omitempty"`
+	// List of external S3 buckets to allow access to.
+	ExternalBuckets []*AWSEnvHostedExternalBucketSpecInput `json:"externalBuckets,omitempty"`
+	// Backups configuration.
+	Backups *AWSEnvHostedBackupsSpecInput `json:"backups,omitempty"`
+	// Iceberg configuration.
+	Iceberg *AWSEnvHostedIcebergInputSpec `json:"iceberg,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ARN of the customer's KMS key for encrypting Altinity-provisioned data buckets
+	// and EBS volumes.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+	// ClickHouse clusters to create.
+	ClickHouseClusters []*ClickHouseClusterCreateSpecInput `json:"clickHouseClusters,omitempty"`
+	// ClickHouse Keepers to create.
+	ClickHouseKeepers []*ClickHouseKeeperCreateSpecInput `json:"clickHouseKeepers,omitempty"`
+}
+
+// AWS environment create request input.
+type CreateAWSEnvInput struct {
+	// A globally-unique environment identifier.
+	//
+	// Immutable.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *CreateAWSEnvSpecInput `json:"spec"`
+}
+
+// CreateAWSEnvResponse is returned by AWSEnvs.Create.
+type CreateAWSEnvResponse struct {
+	MutationID   string              `json:"mutationId"`
+	Spec         *AWSEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// AWS environment create request result.
+type CreateAWSEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// AWS environment active configuration.
+	Spec *AWSEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// AWS environment configuration create request input.
+type CreateAWSEnvSpecInput struct {
+	// ID of the AWS account (https://docs.aws.amazon.com/IAM/latest/UserGuide/console-account-id.html#w5aac11c17b5)
+	// in which to provision AWS resources.
+	//
+	// Immutable.
+	AWSAccountID string `json:"awsAccountID"`
+	// VPC CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10/8, 172.16/12, 192.168/16).
+	//
+	// At least /21 required.
+	//
+	// Example: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// AWS region (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Concepts.RegionsAndAvailabilityZones.html#Concepts.RegionsAndAvailabilityZones.Regions).
+	//
+	// Example: "us-east-1"
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Number of AWS availability zones.
+	// At least 2 required.
+	//
+	// This field is optional if "zones" is specified.
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of AWS availability zones.
+	// At least 2 required.
+	//
+	// Example: ["us-east-1a", "us-east-1b"]
+	//
+	// This field is optional if "numberOfZones" is specified.
+	Zones []string `json:"zones,omitempty"`
+	// Enable AWS NAT Gateway.
+	//
+	// Immutable.
+	Nat *bool `json:"NAT,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *AWSEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*AWSEnvNodeGroupSpecInput `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// VPC peering configuration.
+	PeeringConnections []*AWSEnvPeeringConnectionSpecInput `json:"peeringConnections,omitempty"`
+	// VPC endpoints configuration.
+	Endpoints []*AWSEnvEndpointSpecInput `json:"endpoints,omitempty"`
+	// Tags to apply to AWS resources.
+	Tags []*KeyValueInput `json:"tags,omitempty"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management (default).
+	//
+	// Immutable.
+	CloudConnect *bool `json:"cloudConnect,omitempty"`
+	// Permissions boundary policy ARN.
+	//
+	// Immutable.
+	PermissionsBoundaryPolicyArn *string `json:"permissionsBoundaryPolicyArn,omitempty"`
+	// Prefix to apply to the names of AWS resources during creation.
+	//
+	// Immutable.
+	ResourcePrefix *string `json:"resourcePrefix,omitempty"`
+	// List of external S3 buckets to allow access to.
+	ExternalBuckets []*AWSEnvExternalBucketSpecInput `json:"externalBuckets,omitempty"`
+	// Backups configuration.
+	Backups *AWSEnvBackupsSpecInput `json:"backups,omitempty"`
+	// Iceberg configuration.
+	Iceberg *IcebergInputSpec `json:"iceberg,omitempty"`
+	// Enable/Disable EKS control plane logging to CloudWatch.
+	EksLogging *bool `json:"eksLogging,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ARN of the customer's KMS key for encrypting Altinity-provisioned data buckets
+	// and EBS volumes.
+	KmsKeyArn *string `json:"kmsKeyARN,omitempty"`
+	// ClickHouse clusters to create.
+	ClickHouseClusters []*ClickHouseClusterCreateSpecInput `json:"clickHouseClusters,omitempty"`
+	// ClickHouse Keepers to create.
+	ClickHouseKeepers []*ClickHouseKeeperCreateSpecInput `json:"clickHouseKeepers,omitempty"`
+}
+
+// Azure environment create request input.
+type CreateAzureEnvInput struct {
+	// A globally-unique environment identifier.
+	//
+	// Immutable.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *CreateAzureEnvSpecInput `json:"spec"`
+}
+
+// CreateAzureEnvResponse is returned by AzureEnvs.Create.
+type CreateAzureEnvResponse struct {
+	MutationID   string                `json:"mutationId"`
+	Spec         *AzureEnvSpecFragment `json:"spec"`
+	SpecRevision int64                 `json:"specRevision"`
+}
+
+// Azure environment create request result.
+type CreateAzureEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// Azure environment active configuration.
+	Spec *AzureEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// Azure environment configuration create request input.
+type CreateAzureEnvSpecInput struct {
+	// ID of the Azure Active Directory tenant for user identity and access management.
+	//
+	// Immutable.
+	TenantID string `json:"tenantID"`
+	// ID linking the environment to a specific Azure subscription for resource management.
+	//
+	// Immutable.
+	SubscriptionID string `json:"subscriptionID"`
+	// Network CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10/8, 172.16/12, 192.168/16).
+	//
+	// At least /21 required.
+	//
+	// Example: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// Azure region (https://azure.microsoft.com/en-us/explore/global-infrastructure/geographies).
+	//
+	// Example: "eastus".
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Number of Azure zones.
+	// At least 2 required.
+	//
+	// This field is optional if "zones" is specified.
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of Azure zones.
+	// At least 2 required.
+	//
+	// Example: ["eastus-1", "eastus-2"]
+	Zones []string `json:"zones,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *AzureEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// AKS Kubernetes support policy.
+	// STANDARD by default.
+	AksSupportPolicy *AKSSupportPolicy `json:"aksSupportPolicy,omitempty"`
+	// AKS SKU tier.
+	// FREE by default. PREMIUM is required when aksSupportPolicy is EXTENDED.
+	AksSKUTier *AKSSKUTier `json:"aksSKUTier,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*AzureEnvNodeGroupSpecInput `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                              → _.$env_name.altinity.cloud.
+	// - *.example.com.                            → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com.     → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                     → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.                   → _.internal.$env_name.altinity.cloud.
+	//
+	// Private Link (if used):
+	// - _acme-challenge.privatelink.example.com.  → privatelink.$env_name.altinity.cloud.
+	// - privatelink.example.com.                  → _.privatelink.$env_name.altinity.cloud.
+	// - *.privatelink.example.com.                → _.privatelink.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// Azure Private Link service configuration.
+	PrivateLinkService *PrivateLinkServiceSpecInput `json:"privateLinkService,omitempty"`
+	Tags               []*KeyValueInput             `json:"tags,omitempty"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management (default).
+	//
+	// Immutable.
+	CloudConnect *bool `json:"cloudConnect,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create.
+	ClickHouseClusters []*ClickHouseClusterCreateSpecInput `json:"clickHouseClusters,omitempty"`
+	// ClickHouse Keepers to create.
+	ClickHouseKeepers []*ClickHouseKeeperCreateSpecInput `json:"clickHouseKeepers,omitempty"`
+}
+
+// GCP environment create request input.
+type CreateGCPEnvInput struct {
+	// A globally-unique environment identifier.
+	//
+	// Immutable.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *CreateGCPEnvSpecInput `json:"spec"`
+}
+
+// CreateGCPEnvResponse is returned by GCPEnvs.Create.
+type CreateGCPEnvResponse struct {
+	MutationID   string              `json:"mutationId"`
+	Spec         *GCPEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// GCP environment create request result.
+type CreateGCPEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// GCP environment active configuration.
+	Spec *GCPEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// GCP environment configuration create request input.
+type CreateGCPEnvSpecInput struct {
+	// ID of the GCP project (https://support.google.com/googleapi/answer/7014113?hl=en#:~:text=The%20project%20ID%20is%20a,ID%20or%20create%20your%20own.)
+	// in which to provision GCP resources.
+	//
+	// Immutable.
+	GCPProjectID string `json:"gcpProjectId"`
+	// Network CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10/8, 172.16/12, 192.168/16).
+	//
+	// At least /21 required.
+	//
+	// Example: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// GCP region (https://cloud.google.com/about/locations).
+	//
+	// Example: "us-west1".
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Number of GCP zones.
+	// At least 2 required.
+	//
+	// This field is optional if "zones" is specified.
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of GCP zones.
+	// At least 2 required.
+	//
+	// Example: ["us-west1a", "us-west1b"]
+	Zones []string `json:"zones,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *GCPEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*GCPEnvNodeGroupSpecInput `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management (default).
+	//
+	// Immutable.
+	CloudConnect *bool `json:"cloudConnect,omitempty"`
+	// Labels to apply to GCP resources.
+	Labels []*KeyValueInput `json:"labels,omitempty"`
+	// Network peering configuration.
+	PeeringConnections []*GCPEnvPeeringConnectionSpecInput `json:"peeringConnections,omitempty"`
+	// List of project IDs + connection limits [projectID=connectionLimit] representing the network's private service consumers. Connection limits are optional, will be set to 1 if not specified.
+	//
+	// Example: ["project1", "project2=5"]
+	PrivateServiceConsumers []string `json:"privateServiceConsumers,omitempty"`
+	// List of private service connections.
+	PrivateServiceConnections []*GCPEnvPrivateServiceConnectionSpecInput `json:"privateServiceConnections,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create.
+	ClickHouseClusters []*ClickHouseClusterCreateSpecInput `json:"clickHouseClusters,omitempty"`
+	// ClickHouse Keepers to create.
+	ClickHouseKeepers []*ClickHouseKeeperCreateSpecInput `json:"clickHouseKeepers,omitempty"`
+}
+
+// HCloud environment create request input.
+type CreateHCloudEnvInput struct {
+	// A globally-unique environment identifier.
+	//
+	// Immutable.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *CreateHCloudEnvSpecInput `json:"spec"`
+}
+
+// CreateHCloudEnvResponse is returned by HCloudEnvs.Create.
+type CreateHCloudEnvResponse struct {
+	MutationID   string                 `json:"mutationId"`
+	Spec         *HCloudEnvSpecFragment `json:"spec"`
+	SpecRevision int64                  `json:"specRevision"`
+}
+
+// HCloud environment create request result.
+type CreateHCloudEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// HCloud environment active configuration.
+	Spec *HCloudEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// HCloud environment configuration create request input.
+type CreateHCloudEnvSpecInput struct {
+	// Network CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10/8, 172.16/12, 192.168/16).
+	//
+	// At least /21 required.
+	//
+	// Example: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// HCloud network https://docs.hetzner.com/cloud/general/locations/
+	//
+	// Example: "us-west"
+	//
+	// Immutable.
+	NetworkZone string `json:"networkZone"`
+	// Explicit list of HCloud locations.
+	// (currently supports single location only)
+	//
+	// Example: ["hil"]
+	Locations []string `json:"locations"`
+	// Load balancers configuration.
+	LoadBalancers *HCloudEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*HCloudEnvNodeGroupSpecInput `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management.
+	CloudConnect *bool `json:"cloudConnect,omitempty"`
+	// Labels to apply to HCloud resources.
+	Labels []*KeyValueInput `json:"labels,omitempty"`
+	// Encrypted value of HCLOUD_TOKEN
+	HcloudTokenEnc string `json:"hcloudTokenEnc"`
+	// Wireguard peers configuration.
+	WireguardPeers []*HCloudEnvWireguardPeerSpecInput `json:"wireguardPeers,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create.
+	ClickHouseClusters []*ClickHouseClusterCreateSpecInput `json:"clickHouseClusters,omitempty"`
+	// ClickHouse Keepers to create.
+	ClickHouseKeepers []*ClickHouseKeeperCreateSpecInput `json:"clickHouseKeepers,omitempty"`
+}
+
+// Kubernetes environment create request input.
+type CreateK8SEnvInput struct {
+	// A globally-unique environment identifier.
+	//
+	// Immutable.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *CreateK8SEnvSpecInput `json:"spec"`
+}
+
+// CreateK8SEnvResponse is returned by K8SEnvs.Create.
+type CreateK8SEnvResponse struct {
+	MutationID   string              `json:"mutationId"`
+	Spec         *K8SEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// Kubernetes environment create request result.
+type CreateK8SEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// Kubernetes environment active configuration.
+	Spec *K8SEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// Kubernetes environment configuration create request input.
+type CreateK8SEnvSpecInput struct {
+	// Kubernetes distribution.
+	//
+	// Immutable.
+	Distribution K8SDistribution `json:"distribution"`
+	// Load balancers configuration.
+	LoadBalancers *K8SEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*K8SEnvNodeGroupSpecInput `json:"nodeGroups"`
+	// Custom node types.
+	CustomNodeTypes []*K8SEnvCustomNodeTypeSpecInput `json:"customNodeTypes,omitempty"`
+	// Metrics configuration.
+	Metrics *K8SEnvMetricsSpecInput `json:"metrics,omitempty"`
+	// Logs configuration.
+	Logs *K8SEnvLogsSpecInput `json:"logs,omitempty"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create.
+	ClickHouseClusters []*ClickHouseClusterCreateSpecInput `json:"clickHouseClusters,omitempty"`
+	// ClickHouse Keepers to create.
+	ClickHouseKeepers []*ClickHouseKeeperCreateSpecInput `json:"clickHouseKeepers,omitempty"`
+}
+
+// Datadog monitoring agent configuration .
+type DatadogSpec struct {
+	// True if the Datadog agent is enabled, false otherwise.
+	Enabled bool `json:"enabled"`
+	// Datadog intake site domain (e.g. us3.datadoghq.com, app.datadoghq.eu).
+	Domain string `json:"domain"`
+	// True if ClickHouse log collection is enabled, false otherwise.
+	LogsEnabled bool `json:"logsEnabled"`
+	// True if ClickHouse metrics collection is enabled, false otherwise.
+	MetricsEnabled bool `json:"metricsEnabled"`
+}
+
+// Datadog monitoring agent configuration input.
+type DatadogSpecInput struct {
+	// Enable or disable the Datadog agent.
+	// Defaults to false.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Datadog encrypted API key. Write-only — set to configure or rotate the key.
+	EncAPIKey *string `json:"encApiKey,omitempty"`
+	// Datadog intake site domain (e.g. us3.datadoghq.com, app.datadoghq.eu).
+	// Defaults to datadoghq.com.
+	Domain *string `json:"domain,omitempty"`
+	// Enable ClickHouse log collection.
+	LogsEnabled *bool `json:"logsEnabled,omitempty"`
+	// Enable ClickHouse metrics collection.
+	MetricsEnabled *bool `json:"metricsEnabled,omitempty"`
+}
+
+// Day of the week.
+type Day string
+
+// AWS environment delete request input.
+type DeleteAWSEnvHostedInput struct {
+	// The name of AWS environment to delete.
+	Name string `json:"name"`
+	// By default, delete mutation does not delete the env but rather marks it to be
+	// removed once it's deprovisioned (which may take some time).
+	// Set "force" to true to skip deprovisioning.
+	Force *bool `json:"force,omitempty"`
+	// By default, the delete operation will not delete any provisioned clusters and
+	// the deletion will fail until the clusters get removed.
+	// Set "forceDestroyClusters" to true remove all provisioned clusters as part of the environment deletion process.
+	ForceDestroyClusters *bool `json:"forceDestroyClusters,omitempty"`
+}
+
+// DeleteAWSEnvHostedResponse is returned by AWSEnvsHosted.Delete.
+type DeleteAWSEnvHostedResponse struct {
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// AWS environment delete request result.
+type DeleteAWSEnvHostedResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// AWS environment delete request input.
+type DeleteAWSEnvInput struct {
+	// The name of AWS environment to delete.
+	Name string `json:"name"`
+	// By default, delete mutation does not delete the env but rather marks it to be
+	// removed once it's deprovisioned (which may take some time).
+	// Set "force" to true to skip deprovisioning.
+	Force *bool `json:"force,omitempty"`
+	// By default, the delete operation will not delete any provisioned clusters and
+	// the deletion will fail until the clusters get removed.
+	// Set "forceDestroyClusters" to true remove all provisioned clusters as part of the environment deletion process.
+	ForceDestroyClusters *bool `json:"forceDestroyClusters,omitempty"`
+}
+
+// DeleteAWSEnvResponse is returned by AWSEnvs.Delete.
+type DeleteAWSEnvResponse struct {
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// AWS environment delete request result.
+type DeleteAWSEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// Azure environment delete request input.
+type DeleteAzureEnvInput struct {
+	// The name of Azure environment to delete.
+	Name string `json:"name"`
+	// By default, delete mutation does not delete the env but rather marks it to be
+	// removed once it's deprovisioned (which may take some time).
+	// Set "force" to true to skip deprovisioning.
+	Force *bool `json:"force,omitempty"`
+	// By default, the delete operation will not delete any provisioned clusters and
+	// the deletion will fail until the clusters get removed.
+	// Set "forceDestroyClusters" to true remove all provisioned clusters as part of the environment deletion process.
+	ForceDestroyClusters *bool `json:"forceDestroyClusters,omitempty"`
+}
+
+// DeleteAzureEnvResponse is returned by AzureEnvs.Delete.
+type DeleteAzureEnvResponse struct {
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// Azure environment delete request result.
+type DeleteAzureEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// GCP environment delete request input.
+type DeleteGCPEnvInput struct {
+	// The name of GCP environment to delete.
+	Name string `json:"name"`
+	// By default, delete mutation does not delete the env but rather marks it to be
+	// removed once it's deprovisioned (which may take some time).
+	// Set "force" to true to skip deprovisioning.
+	Force *bool `json:"force,omitempty"`
+	// By default, the delete operation will not delete any provisioned clusters and
+	// the deletion will fail until the clusters get removed.
+	// Set "forceDestroyClusters" to true remove all provisioned clusters as part of the environment deletion process.
+	ForceDestroyClusters *bool `json:"forceDestroyClusters,omitempty"`
+}
+
+// DeleteGCPEnvResponse is returned by GCPEnvs.Delete.
+type DeleteGCPEnvResponse struct {
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// GCP environment delete request result.
+type DeleteGCPEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// HCloud environment delete request input.
+type DeleteHCloudEnvInput struct {
+	// The name of HCloud environment to delete.
+	Name string `json:"name"`
+	// By default, delete mutation does not delete the env but rather marks it to be
+	// removed once it's deprovisioned (which may take some time).
+	// Set "force" to true to skip deprovisioning.
+	Force *bool `json:"force,omitempty"`
+	// By default, the delete operation will not delete any provisioned clusters and
+	// the deletion will fail until the clusters get removed.
+	// Set "forceDestroyClusters" to true remove all provisioned clusters as part of the environment deletion process.
+	ForceDestroyClusters *bool `json:"forceDestroyClusters,omitempty"`
+}
+
+// DeleteHCloudEnvResponse is returned by HCloudEnvs.Delete.
+type DeleteHCloudEnvResponse struct {
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// HCloud environment delete request result.
+type DeleteHCloudEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// Kubernetes environment delete request input.
+type DeleteK8SEnvInput struct {
+	// The name of Kubernetes environment to delete.
+	Name string `json:"name"`
+	// By default, delete mutation does not delete the env but rather marks it to be
+	// removed once it's deprovisioned (which may take some time).
+	// Set "force" to true to skip deprovisioning.
+	Force *bool `json:"force,omitempty"`
+	// By default, the delete operation will not delete any provisioned clusters and
+	// the deletion will fail until the clusters get removed.
+	// Set "forceDestroyClusters" to true remove all provisioned clusters as part of the environment deletion process.
+	ForceDestroyClusters *bool `json:"forceDestroyClusters,omitempty"`
+}
+
+// DeleteK8SEnvResponse is returned by K8SEnvs.Delete.
+type DeleteK8SEnvResponse struct {
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// Kubernetes environment delete request result.
+type DeleteK8SEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	PendingMfa bool   `json:"pendingMFA"`
+}
+
+// Status error.
+type EnvStatusError struct {
+	// Status error code.
+	Code EnvStatusErrorCode `json:"code"`
+	// Status error message.
+	Message string `json:"message"`
+}
+
+// Status error codes.
+type EnvStatusErrorCode string
+
+// GCP environment.
+type GCPEnv struct {
+	// A globally-unique environment identifier.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *GCPEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+	// Environment status.
+	Status *GCPEnvStatus `json:"status"`
+}
+
+// GCP environments query filter.
+type GCPEnvFilter struct {
+	// Names of the environments to return.
+	// Names that don't match any of the existing environments are ignored.
+	Names []string `json:"names,omitempty"`
+}
+
+// GCP environment internal load balancer configuration.
+type GCPEnvLoadBalancerInternalSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type GCPEnvLoadBalancerInternalSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// GCP environment public load balancer configuration.
+type GCPEnvLoadBalancerPublicSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type GCPEnvLoadBalancerPublicSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// GCP environment load balancers configuration.
+type GCPEnvLoadBalancersSpec struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *GCPEnvLoadBalancerPublicSpec `json:"public"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *GCPEnvLoadBalancerInternalSpec `json:"internal"`
+}
+
+// GCP environment load balancers configuration input.
+type GCPEnvLoadBalancersSpecInput struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *GCPEnvLoadBalancerPublicSpecInput `json:"public,omitempty"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *GCPEnvLoadBalancerInternalSpecInput `json:"internal,omitempty"`
+}
+
+type GCPEnvNodeGroupSpec struct {
+	// Unique (among environment node groups) node group identifier.
+	Name string `json:"name"`
+	// Machine type (https://cloud.google.com/compute/docs/machine-resource).
+	//
+	// Example: "e2-standard-2"
+	NodeType string `json:"nodeType"`
+	// GCP zones.
+	Zones []string `json:"zones"`
+	// Maximum number of instances per zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workloads that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations"`
+}
+
+// GCP environment node group configuration input.
+type GCPEnvNodeGroupSpecInput struct {
+	// Unique (among environment node groups) node group identifier.
+	//
+	// Defaults to $nodeType.
+	Name *string `json:"name,omitempty"`
+	// Machine type (https://cloud.google.com/compute/docs/machine-resource).
+	//
+	// Example: "e2-standard-2"
+	NodeType string `json:"nodeType"`
+	// GCP zones.
+	//
+	// Defaults to environment zones.
+	Zones []string `json:"zones,omitempty"`
+	// Maximum number of instances per zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workloads that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations,omitempty"`
+}
+
+// GCP environment network peering configuration.
+type GCPEnvPeeringConnectionSpec struct {
+	// Target network's project ID.
+	ProjectID *string `json:"projectID,omitempty"`
+	// Target network name.
+	NetworkName string `json:"networkName"`
+}
+
+// GCP environment network peering configuration input.
+type GCPEnvPeeringConnectionSpecInput struct {
+	// Target network's project ID.
+	ProjectID *string `json:"projectID,omitempty"`
+	// Target network name.
+	NetworkName string `json:"networkName"`
+}
+
+// GCP environment private service connection configuration.
+type GCPEnvPrivateServiceConnectionSpec struct {
+	// Name of the private service connection.
+	Name string `json:"name"`
+	// GCP service attachment resource name.
+	//
+	// Example: "projects/my-service-project/regions/us-central1/serviceAttachments/my-service"
+	Target string `json:"target"`
+	// Alias of the private service connection.
+	Alias *string `json:"alias,omitempty"`
+}
+
+// GCP environment private service connection configuration input.
+type GCPEnvPrivateServiceConnectionSpecInput struct {
+	// Name of the private service connection.
+	Name string `json:"name"`
+	// GCP service attachment resource name.
+	//
+	// Example: "projects/my-service-project/regions/us-central1/serviceAttachments/my-service"
+	Target string `json:"target"`
+	// Alias of the private service connection.
+	Alias *string `json:"alias,omitempty"`
+}
+
+// GCP environment configuration.
+type GCPEnvSpec struct {
+	// ID of the GCP project (https://support.google.com/googleapi/answer/7014113?hl=en#:~:text=The%20project%20ID%20is%20a,ID%20or%20create%20your%20own.)
+	// in which to provision GCP resources.
+	//
+	// Immutable.
+	GCPProjectID string `json:"gcpProjectId"`
+	// Network CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16).
+	//
+	// At least /21 required.
+	//
+	// Examples: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// GCP region (https://cloud.google.com/about/locations).
+	//
+	// Example: "us-west1".
+	//
+	// Immutable.
+	Region string `json:"region"`
+	// Explicit list of GCP zones.
+	// At least 2 required.
+	//
+	// Example: ["us-west1a", "us-west1b"]
+	Zones []string `json:"zones"`
+	// Load balancers configuration.
+	LoadBalancers *GCPEnvLoadBalancersSpec `json:"loadBalancers"`
+	// Load balancing strategy.
+	LoadBalancingStrategy LoadBalancingStrategy `json:"loadBalancingStrategy"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*GCPEnvNodeGroupSpec `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpec `json:"maintenanceWindows"`
+	// Custom domain (deprecated, use customDomains instead).
+	//
+	// Returns customDomains[0] for backwards compatibility.
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management.
+	CloudConnect bool `json:"cloudConnect"`
+	// Labels to apply to GCP resources.
+	Labels []*KeyValue `json:"labels"`
+	// List of network peering configurations.
+	PeeringConnections []*GCPEnvPeeringConnectionSpec `json:"peeringConnections"`
+	// List of project IDs + connection limits [projectID=connectionLimit] representing the network's private service consumers. Connection limits are optional, will be set to 1 if not specified.
+	//
+	// Example: ["project1", "project2=5"]
+	PrivateServiceConsumers []string `json:"privateServiceConsumers"`
+	// List of private service connections.
+	PrivateServiceConnections []*GCPEnvPrivateServiceConnectionSpec `json:"privateServiceConnections"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpec `json:"metricsEndpoint"`
+	// Datadog agent configuration.
+	Datadog *DatadogSpec `json:"datadog"`
+	// ClickHouse clusters.
+	ClickHouseClusters []*ClickHouseClusterSpec `json:"clickHouseClusters"`
+	// ClickHouse Keepers.
+	ClickHouseKeepers []*ClickHouseKeeperSpec `json:"clickHouseKeepers"`
+}
+
+type GCPEnvSpecFragment struct {
+	LoadBalancers           GCPEnvSpecFragment_LoadBalancers         `json:"loadBalancers"`
+	LoadBalancingStrategy   LoadBalancingStrategy                    `json:"loadBalancingStrategy"`
+	CustomDomain            *string                                  `json:"customDomain,omitempty"`
+	CustomDomains           []string                                 `json:"customDomains"`
+	NodeGroups              []*GCPEnvSpecFragment_NodeGroups         `json:"nodeGroups"`
+	MaintenanceWindows      []*GCPEnvSpecFragment_MaintenanceWindows `json:"maintenanceWindows"`
+	PeeringConnections      []*GCPEnvSpecFragment_PeeringConnections `json:"peeringConnections"`
+	PrivateServiceConsumers []string                                 `json:"privateServiceConsumers"`
+	Region                  string                                   `json:"region"`
+	Zones                   []string                                 `json:"zones"`
+	Cidr                    string                                   `json:"cidr"`
+	GCPProjectID            string                                   `json:"gcpProjectId"`
+	CloudConnect            bool                                     `json:"cloudConnect"`
+	Labels                  []*GCPEnvSpecFragment_Labels             `json:"labels"`
+	MetricsEndpoint         GCPEnvSpecFragment_MetricsEndpoint       `json:"metricsEndpoint"`
+	Datadog                 GCPEnvSpecFragment_Datadog               `json:"datadog"`
+}
+
+type GCPEnvSpecFragment_Datadog struct {
+	Domain         string `json:"domain"`
+	Enabled        bool   `json:"enabled"`
+	LogsEnabled    bool   `json:"logsEnabled"`
+	MetricsEnabled bool   `json:"metricsEnabled"`
+}
+
+type GCPEnvSpecFragment_Labels struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type GCPEnvSpecFragment_LoadBalancers struct {
+	Internal GCPEnvSpecFragment_LoadBalancers_Internal `json:"internal"`
+	Public   GCPEnvSpecFragment_LoadBalancers_Public   `json:"public"`
+}
+
+type GCPEnvSpecFragment_LoadBalancers_Internal struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type GCPEnvSpecFragment_LoadBalancers_Public struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type GCPEnvSpecFragment_MaintenanceWindows struct {
+	Days          []Day  `json:"days"`
+	Enabled       bool   `json:"enabled"`
+	Hour          int64  `json:"hour"`
+	LengthInHours int64  `json:"lengthInHours"`
+	Name          string `json:"name"`
+}
+
+type GCPEnvSpecFragment_MetricsEndpoint struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type GCPEnvSpecFragment_NodeGroups struct {
+	CapacityPerZone int64             `json:"capacityPerZone"`
+	Name            string            `json:"name"`
+	NodeType        string            `json:"nodeType"`
+	Reservations    []NodeReservation `json:"reservations"`
+	Zones           []string          `json:"zones"`
+}
+
+type GCPEnvSpecFragment_PeeringConnections struct {
+	NetworkName string  `json:"networkName"`
+	ProjectID   *string `json:"projectID,omitempty"`
+}
+
+// GCP environment status.
+type GCPEnvStatus struct {
+	// Applied spec revision (monotonically-increasing).
+	AppliedSpecRevision int64 `json:"appliedSpecRevision"`
+	// True indicates that environment is pending deletion.
+	PendingDelete bool `json:"pendingDelete"`
+	// Status errors.
+	Errors []*EnvStatusError `json:"errors"`
+}
+
+// GetAWSEnvHostedResponse is an Altinity-hosted AWS env as returned by AWSEnvsHosted.Get.
+type GetAWSEnvHostedResponse struct {
+	Name         string                    `json:"name"`
+	Spec         *AWSEnvHostedSpecFragment `json:"spec"`
+	SpecRevision int64                     `json:"specRevision"`
+}
+
+// GetAWSEnvHostedStatusResponse is the status of an Altinity-hosted AWS env as returned by AWSEnvsHosted.Status.
+type GetAWSEnvHostedStatusResponse struct {
+	Name         string                               `json:"name"`
+	SpecRevision int64                                `json:"specRevision"`
+	Status       GetAWSEnvHostedStatusResponse_Status `json:"status"`
+}
+
+type GetAWSEnvHostedStatusResponse_Status struct {
+	AppliedSpecRevision int64                                              `json:"appliedSpecRevision"`
+	Errors              []*GetAWSEnvHostedStatusResponse_Status_Errors     `json:"errors"`
+	LoadBalancers       GetAWSEnvHostedStatusResponse_Status_LoadBalancers `json:"loadBalancers"`
+	PendingDelete       bool                                               `json:"pendingDelete"`
+}
+
+type GetAWSEnvHostedStatusResponse_Status_Errors struct {
+	Code    EnvStatusErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+type GetAWSEnvHostedStatusResponse_Status_LoadBalancers struct {
+	Internal GetAWSEnvHostedStatusResponse_Status_LoadBalancers_Internal `json:"internal"`
+}
+
+type GetAWSEnvHostedStatusResponse_Status_LoadBalancers_Internal struct {
+	EndpointServiceName *string `json:"endpointServiceName,omitempty"`
+}
+
+// GetAWSEnvResponse is an AWS env as returned by AWSEnvs.Get.
+type GetAWSEnvResponse struct {
+	Name         string              `json:"name"`
+	Spec         *AWSEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// GetAWSEnvStatusResponse is the status of an AWS env as returned by AWSEnvs.Status.
+type GetAWSEnvStatusResponse struct {
+	Name         string                         `json:"name"`
+	SpecRevision int64                          `json:"specRevision"`
+	Status       GetAWSEnvStatusResponse_Status `json:"status"`
+}
+
+type GetAWSEnvStatusResponse_Status struct {
+	AppliedSpecRevision int64                                                `json:"appliedSpecRevision"`
+	AWSResources        []*GetAWSEnvStatusResponse_Status_AWSResources       `json:"awsResources"`
+	Errors              []*GetAWSEnvStatusResponse_Status_Errors             `json:"errors"`
+	LoadBalancers       GetAWSEnvStatusResponse_Status_LoadBalancers         `json:"loadBalancers"`
+	PeeringConnections  []*GetAWSEnvStatusResponse_Status_PeeringConnections `json:"peeringConnections"`
+	PendingDelete       bool                                                 `json:"pendingDelete"`
+}
+
+type GetAWSEnvStatusResponse_Status_AWSResources struct {
+	Arn  string `json:"arn"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type GetAWSEnvStatusResponse_Status_Errors struct {
+	Code    EnvStatusErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+type GetAWSEnvStatusResponse_Status_LoadBalancers struct {
+	Internal GetAWSEnvStatusResponse_Status_LoadBalancers_Internal `json:"internal"`
+}
+
+type GetAWSEnvStatusResponse_Status_LoadBalancers_Internal struct {
+	EndpointServiceName *string `json:"endpointServiceName,omitempty"`
+}
+
+type GetAWSEnvStatusResponse_Status_PeeringConnections struct {
+	ID    *string `json:"id,omitempty"`
+	VpcID string  `json:"vpcID"`
+}
+
+// GetAzureEnvResponse is an Azure env as returned by AzureEnvs.Get.
+type GetAzureEnvResponse struct {
+	Name         string                `json:"name"`
+	Spec         *AzureEnvSpecFragment `json:"spec"`
+	SpecRevision int64                 `json:"specRevision"`
+}
+
+// GetAzureEnvStatusResponse is the status of an Azure env as returned by AzureEnvs.Status.
+type GetAzureEnvStatusResponse struct {
+	Name         string                           `json:"name"`
+	SpecRevision int64                            `json:"specRevision"`
+	Status       GetAzureEnvStatusResponse_Status `json:"status"`
+}
+
+type GetAzureEnvStatusResponse_Status struct {
+	AppliedSpecRevision int64                                          `json:"appliedSpecRevision"`
+	Errors              []*GetAzureEnvStatusResponse_Status_Errors     `json:"errors"`
+	LoadBalancers       GetAzureEnvStatusResponse_Status_LoadBalancers `json:"loadBalancers"`
+	PendingDelete       bool                                           `json:"pendingDelete"`
+}
+
+type GetAzureEnvStatusResponse_Status_Errors struct {
+	Code    EnvStatusErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+type GetAzureEnvStatusResponse_Status_LoadBalancers struct {
+	Internal GetAzureEnvStatusResponse_Status_LoadBalancers_Internal `json:"internal"`
+}
+
+type GetAzureEnvStatusResponse_Status_LoadBalancers_Internal struct {
+	PrivateLinkServiceAlias *string `json:"privateLinkServiceAlias,omitempty"`
+}
+
+// GetGCPEnvResponse is a GCP env as returned by GCPEnvs.Get.
+type GetGCPEnvResponse struct {
+	Name         string              `json:"name"`
+	Spec         *GCPEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// GetGCPEnvStatusResponse is the status of a GCP env as returned by GCPEnvs.Status.
+type GetGCPEnvStatusResponse struct {
+	Name         string                         `json:"name"`
+	SpecRevision int64                          `json:"specRevision"`
+	Status       GetGCPEnvStatusResponse_Status `json:"status"`
+}
+
+type GetGCPEnvStatusResponse_Status struct {
+	AppliedSpecRevision int64                                    `json:"appliedSpecRevision"`
+	Errors              []*GetGCPEnvStatusResponse_Status_Errors `json:"errors"`
+	PendingDelete       bool                                     `json:"pendingDelete"`
+}
+
+type GetGCPEnvStatusResponse_Status_Errors struct {
+	Code    EnvStatusErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+// GetHCloudEnvResponse is an HCloud env as returned by HCloudEnvs.Get.
+type GetHCloudEnvResponse struct {
+	Name         string                 `json:"name"`
+	Spec         *HCloudEnvSpecFragment `json:"spec"`
+	SpecRevision int64                  `json:"specRevision"`
+}
+
+// GetHCloudEnvStatusResponse is the status of an HCloud env as returned by HCloudEnvs.Status.
+type GetHCloudEnvStatusResponse struct {
+	Name         string                            `json:"name"`
+	SpecRevision int64                             `json:"specRevision"`
+	Status       GetHCloudEnvStatusResponse_Status `json:"status"`
+}
+
+type GetHCloudEnvStatusResponse_Status struct {
+	AppliedSpecRevision int64                                       `json:"appliedSpecRevision"`
+	Errors              []*GetHCloudEnvStatusResponse_Status_Errors `json:"errors"`
+	PendingDelete       bool                                        `json:"pendingDelete"`
+}
+
+type GetHCloudEnvStatusResponse_Status_Errors struct {
+	Code    EnvStatusErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+// GetK8SEnvResponse is a K8S env as returned by K8SEnvs.Get.
+type GetK8SEnvResponse struct {
+	Name         string              `json:"name"`
+	Spec         *K8SEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// GetK8SEnvStatusResponse is the status of a K8S env as returned by K8SEnvs.Status.
+type GetK8SEnvStatusResponse struct {
+	Name         string                         `json:"name"`
+	SpecRevision int64                          `json:"specRevision"`
+	Status       GetK8SEnvStatusResponse_Status `json:"status"`
+}
+
+type GetK8SEnvStatusResponse_Status struct {
+	AppliedSpecRevision int64                                    `json:"appliedSpecRevision"`
+	Errors              []*GetK8SEnvStatusResponse_Status_Errors `json:"errors"`
+	PendingDelete       bool                                     `json:"pendingDelete"`
+}
+
+type GetK8SEnvStatusResponse_Status_Errors struct {
+	Code    EnvStatusErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+// HCloud environment.
+type HCloudEnv struct {
+	// A globally-unique environment identifier.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *HCloudEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+	// Environment status.
+	Status *HCloudEnvStatus `json:"status"`
+}
+
+// HCloud environments query filter.
+type HCloudEnvFilter struct {
+	// Names of the environments to return.
+	// Names that don't match any of the existing environments are ignored.
+	Names []string `json:"names,omitempty"`
+}
+
+// HCloud environment internal load balancer configuration.
+type HCloudEnvLoadBalancerInternalSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type HCloudEnvLoadBalancerInternalSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// HCloud environment public load balancer configuration.
+type HCloudEnvLoadBalancerPublicSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type HCloudEnvLoadBalancerPublicSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// HCloud environment load balancers configuration.
+type HCloudEnvLoadBalancersSpec struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *HCloudEnvLoadBalancerPublicSpec `json:"public"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *HCloudEnvLoadBalancerInternalSpec `json:"internal"`
+}
+
+// HCloud environment load balancers configuration input.
+type HCloudEnvLoadBalancersSpecInput struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *HCloudEnvLoadBalancerPublicSpecInput `json:"public,omitempty"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *HCloudEnvLoadBalancerInternalSpecInput `json:"internal,omitempty"`
+}
+
+// HCloud environment node group configuration.
+type HCloudEnvNodeGroupSpec struct {
+	// Unique (among environment node groups) node group identifier.
+	Name string `json:"name"`
+	// HCloud server type (https://docs.hetzner.com/cloud/servers/overview#server-types).
+	NodeType string `json:"nodeType"`
+	// Locations.
+	Locations []string `json:"locations"`
+	// Maximum number of instances per availability location.
+	CapacityPerLocation int64 `json:"capacityPerLocation"`
+	// Types of workload that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations"`
+}
+
+// HCloud environment node group configuration input.
+type HCloudEnvNodeGroupSpecInput struct {
+	// Unique (among environment node groups) node group identifier.
+	//
+	// Defaults to $nodeType.
+	Name *string `json:"name,omitempty"`
+	// Machine type (https://cloud.google.com/compute/docs/machine-resource).
+	NodeType string `json:"nodeType"`
+	// HCloud locations.
+	Locations []string `json:"locations,omitempty"`
+	// Maximum number of instances per zone.
+	CapacityPerLocation int64 `json:"capacityPerLocation"`
+	// Types of workloads that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations,omitempty"`
+}
+
+// HCloud environment configuration.
+type HCloudEnvSpec struct {
+	// VPC CIDR block from the private IPv4 address ranges as specified in RFC 1918
+	// (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16).
+	//
+	// At least /21 required.
+	//
+	// Examples: "10.136.0.0/21"
+	//
+	// Immutable.
+	Cidr string `json:"cidr"`
+	// HCloud network https://docs.hetzner.com/cloud/general/locations/
+	//
+	// Example: "us-west"
+	//
+	// Immutable.
+	NetworkZone string `json:"networkZone"`
+	// Explicit list of HCloud locations.
+	// (currently supports single location only)
+	//
+	// Example: ["hil"]
+	Locations []string `json:"locations"`
+	// Load balancers configuration.
+	LoadBalancers *HCloudEnvLoadBalancersSpec `json:"loadBalancers"`
+	// Load balancing strategy.
+	LoadBalancingStrategy LoadBalancingStrategy `json:"loadBalancingStrategy"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*HCloudEnvNodeGroupSpec `json:"nodeGroups"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpec `json:"maintenanceWindows"`
+	// Custom domain (deprecated, use customDomains instead).
+	//
+	// Returns customDomains[0] for backwards compatibility.
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains"`
+	// True indicates that cloud resources are to be managed via altinity/cloud-connect.
+	// False means direct management.
+	CloudConnect bool `json:"cloudConnect"`
+	// Labels to apply to HCloud resources.
+	Labels []*KeyValue `json:"labels"`
+	// Wireguard peers configuration.
+	WireguardPeers []*HCloudEnvWireguardPeerSpec `json:"wireguardPeers"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpec `json:"metricsEndpoint"`
+	// Datadog agent configuration.
+	Datadog *DatadogSpec `json:"datadog"`
+	// ClickHouse clusters.
+	ClickHouseClusters []*ClickHouseClusterSpec `json:"clickHouseClusters"`
+	// ClickHouse Keepers.
+	ClickHouseKeepers []*ClickHouseKeeperSpec `json:"clickHouseKeepers"`
+}
+
+type HCloudEnvSpecFragment struct {
+	LoadBalancers         HCloudEnvSpecFragment_LoadBalancers         `json:"loadBalancers"`
+	LoadBalancingStrategy LoadBalancingStrategy                       `json:"loadBalancingStrategy"`
+	CustomDomain          *string                                     `json:"customDomain,omitempty"`
+	CustomDomains         []string                                    `json:"customDomains"`
+	NodeGroups            []*HCloudEnvSpecFragment_NodeGroups         `json:"nodeGroups"`
+	MaintenanceWindows    []*HCloudEnvSpecFragment_MaintenanceWindows `json:"maintenanceWindows"`
+	NetworkZone           string                                      `json:"networkZone"`
+	Locations             []string                                    `json:"locations"`
+	Cidr                  string                                      `json:"cidr"`
+	CloudConnect          bool                                        `json:"cloudConnect"`
+	WireguardPeers        []*HCloudEnvSpecFragment_WireguardPeers     `json:"wireguardPeers"`
+	MetricsEndpoint       HCloudEnvSpecFragment_MetricsEndpoint       `json:"metricsEndpoint"`
+	Datadog               HCloudEnvSpecFragment_Datadog               `json:"datadog"`
+}
+
+type HCloudEnvSpecFragment_Datadog struct {
+	Domain         string `json:"domain"`
+	Enabled        bool   `json:"enabled"`
+	LogsEnabled    bool   `json:"logsEnabled"`
+	MetricsEnabled bool   `json:"metricsEnabled"`
+}
+
+type HCloudEnvSpecFragment_LoadBalancers struct {
+	Internal HCloudEnvSpecFragment_LoadBalancers_Internal `json:"internal"`
+	Public   HCloudEnvSpecFragment_LoadBalancers_Public   `json:"public"`
+}
+
+type HCloudEnvSpecFragment_LoadBalancers_Internal struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type HCloudEnvSpecFragment_LoadBalancers_Public struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type HCloudEnvSpecFragment_MaintenanceWindows struct {
+	Days          []Day  `json:"days"`
+	Enabled       bool   `json:"enabled"`
+	Hour          int64  `json:"hour"`
+	LengthInHours int64  `json:"lengthInHours"`
+	Name          string `json:"name"`
+}
+
+type HCloudEnvSpecFragment_MetricsEndpoint struct {
+	Enabled        bool     `json:"enabled"`
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+type HCloudEnvSpecFragment_NodeGroups struct {
+	CapacityPerLocation int64             `json:"capacityPerLocation"`
+	Locations           []string          `json:"locations"`
+	Name                string            `json:"name"`
+	NodeType            string            `json:"nodeType"`
+	Reservations        []NodeReservation `json:"reservations"`
+}
+
+type HCloudEnvSpecFragment_WireguardPeers struct {
+	AllowedIPs []string `json:"allowedIPs"`
+	Endpoint   string   `json:"endpoint"`
+	PublicKey  string   `json:"publicKey"`
+}
+
+// HCloud environment status.
+type HCloudEnvStatus struct {
+	// Applied spec revision (monotonically-increasing).
+	AppliedSpecRevision int64 `json:"appliedSpecRevision"`
+	// True indicates that environment is pending deletion.
+	PendingDelete bool `json:"pendingDelete"`
+	// Status errors.
+	Errors []*EnvStatusError `json:"errors"`
+}
+
+// HCloud Wireguard peer configuration.
+type HCloudEnvWireguardPeerSpec struct {
+	// Peer public key.
+	PublicKey string `json:"publicKey"`
+	// A list of addresses (in CIDR notation) that should get routed to the peer.
+	AllowedIPs []string `json:"allowedIPs"`
+	// Peer endpoint.
+	Endpoint string `json:"endpoint"`
+}
+
+// HCloud Wireguard peer configuration input.
+type HCloudEnvWireguardPeerSpecInput struct {
+	// Peer public key.
+	PublicKey string `json:"publicKey"`
+	// A list of addresses (in CIDR notation) that should get routed to the peer.
+	AllowedIPs []string `json:"allowedIPs"`
+	// Peer endpoint.
+	Endpoint string `json:"endpoint"`
+}
+
+// Iceberg catalog configuration input.
+type IcebergCatalogInputSpec struct {
+	// Catalog name.
+	// Empty name represents the default catalog.
+	Name *string `json:"name,omitempty"`
+	// Catalog type.
+	Type IcebergCatalogTypeSpec `json:"type"`
+	// Custom S3 bucket name.
+	CustomS3Bucket *string `json:"customS3Bucket,omitempty"`
+	// Path within the custom S3 bucket.
+	CustomS3BucketPath *string `json:"customS3BucketPath,omitempty"`
+	// ARN of the S3 Tables bucket.
+	CustomS3TableBucketArn *string `json:"customS3TableBucketARN,omitempty"`
+	// AWS region for the catalog.
+	AWSRegion *string `json:"awsRegion,omitempty"`
+	// Whether anonymous access is enabled.
+	AnonymousAccessEnabled *bool `json:"anonymousAccessEnabled,omitempty"`
+	// Maintenance configuration.
+	Maintenance *IcebergCatalogMaintenanceInputSpec `json:"maintenance,omitempty"`
+	// Table watch configurations.
+	Watches []*IcebergCatalogWatchInputSpec `json:"watches,omitempty"`
+	// IAM role ARN for BYOK environments.
+	RoleArn *string `json:"roleARN,omitempty"`
+	// IAM role ARN to assume for read-write access.
+	AssumeRoleArnrw *string `json:"assumeRoleARNRW,omitempty"`
+	// IAM role ARN to assume for read-only access.
+	AssumeRoleArnro *string `json:"assumeRoleARNRO,omitempty"`
+}
+
+// Iceberg catalog maintenance configuration input.
+type IcebergCatalogMaintenanceInputSpec struct {
+	// Whether maintenance is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// Iceberg catalog maintenance configuration.
+type IcebergCatalogMaintenanceSpec struct {
+	// Whether maintenance is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// Iceberg catalog configuration.
+type IcebergCatalogSpec struct {
+	// Catalog name.
+	// Empty name represents the default catalog.
+	Name *string `json:"name,omitempty"`
+	// Catalog type.
+	Type IcebergCatalogTypeSpec `json:"type"`
+	// Custom S3 bucket name.
+	CustomS3Bucket *string `json:"customS3Bucket,omitempty"`
+	// Path within the custom S3 bucket.
+	CustomS3BucketPath *string `json:"customS3BucketPath,omitempty"`
+	// ARN of the S3 Tables bucket.
+	CustomS3TableBucketArn *string `json:"customS3TableBucketARN,omitempty"`
+	// AWS region for the catalog.
+	AWSRegion *string `json:"awsRegion,omitempty"`
+	// Whether anonymous access is enabled.
+	AnonymousAccessEnabled *bool `json:"anonymousAccessEnabled,omitempty"`
+	// Maintenance configuration.
+	Maintenance *IcebergCatalogMaintenanceSpec `json:"maintenance"`
+	// Table watch configurations.
+	Watches []*IcebergCatalogWatchSpec `json:"watches"`
+	// IAM role ARN for BYOK environments.
+	RoleArn *string `json:"roleARN,omitempty"`
+	// IAM role ARN to assume for read-write access.
+	AssumeRoleArnrw *string `json:"assumeRoleARNRW,omitempty"`
+	// IAM role ARN to assume for read-only access.
+	AssumeRoleArnro *string `json:"assumeRoleARNRO,omitempty"`
+}
+
+// Iceberg catalog type.
+type IcebergCatalogTypeSpec string
+
+// Iceberg catalog watch configuration input.
+type IcebergCatalogWatchInputSpec struct {
+	// Table name to watch.
+	Table string `json:"table"`
+	// Paths relative to table location to watch.
+	PathsRelativeToTableLocation []string `json:"pathsRelativeToTableLocation,omitempty"`
+}
+
+// Iceberg catalog watch configuration.
+type IcebergCatalogWatchSpec struct {
+	// Table name to watch.
+	Table string `json:"table"`
+	// Paths relative to table location to watch.
+	PathsRelativeToTableLocation []string `json:"pathsRelativeToTableLocation"`
+}
+
+// Iceberg configuration input.
+type IcebergInputSpec struct {
+	// List of Iceberg catalogs.
+	Catalogs []*IcebergCatalogInputSpec `json:"catalogs,omitempty"`
+}
+
+// Iceberg configuration.
+type IcebergSpec struct {
+	// List of Iceberg catalogs.
+	Catalogs []*IcebergCatalogSpec `json:"catalogs"`
+}
+
+// Iceberg configuration update input.
+type IcebergUpdateInputSpec struct {
+	// List of Iceberg catalogs to create or update.
+	Catalogs []*IcebergCatalogInputSpec `json:"catalogs,omitempty"`
+	// List of catalog names to delete.
+	CatalogsToDelete []string `json:"catalogsToDelete,omitempty"`
+}
+
+// Kubernetes distribution.
+type K8SDistribution string
+
+// Kubernetes environment.
+type K8SEnv struct {
+	// A globally-unique environment identifier.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *K8SEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+	// Environment status.
+	Status *K8SEnvStatus `json:"status"`
+}
+
+// Kubernetes custom node type configuration.
+type K8SEnvCustomNodeTypeSpec struct {
+	// Custom node type unique identifier.
+	Name string `json:"name"`
+	// Number of allocatable virtual cores.
+	CPUAllocatable float64 `json:"cpuAllocatable"`
+	// Amount of allocatable memory in bytes.
+	MemAllocatableInBytes int64 `json:"memAllocatableInBytes"`
+}
+
+// Kubernetes custom node type configuration input.
+type K8SEnvCustomNodeTypeSpecInput struct {
+	// Custom node type unique identifier.
+	Name string `json:"name"`
+	// Number of allocatable virtual cores.
+	CPUAllocatable float64 `json:"cpuAllocatable"`
+	// Amount of allocatable memory in bytes.
+	MemAllocatableInBytes float64 `json:"memAllocatableInBytes"`
+}
+
+// Kubernetes environments query filter.
+type K8SEnvFilter struct {
+	// Names of the environments to return.
+	// Names that don't match any of the existing environments are ignored.
+	Names []string `json:"names,omitempty"`
+}
+
+// Kubernetes environment internal load balancer configuration.
+type K8SEnvLoadBalancerInternalSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+	// Annotations to apply to Kubernetes LoadBalancer service.
+	Annotations []*KeyValue `json:"annotations"`
+}
+
+// Kubernetes environment internal load balancer configuration.
+type K8SEnvLoadBalancerInternalSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+	// Annotations to apply to Kubernetes LoadBalancer service.
+	Annotations []*KeyValueInput `json:"annotations,omitempty"`
+}
+
+// Kubernetes environment public load balancer configuration.
+type K8SEnvLoadBalancerPublicSpec struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+	// Annotations to apply to Kubernetes LoadBalancer service.
+	Annotations []*KeyValue `json:"annotations"`
+}
+
+// Kubernetes environment public load balancer configuration.
+type K8SEnvLoadBalancerPublicSpecInput struct {
+	// True if load balancer is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from.
+	//
+	// 0.0.0.0/0 by default.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+	// Annotations to apply to Kubernetes LoadBalancer service.
+	Annotations []*KeyValueInput `json:"annotations,omitempty"`
+}
+
+// Kubernetes environment load balancers configuration.
+type K8SEnvLoadBalancersSpec struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *K8SEnvLoadBalancerPublicSpec `json:"public"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *K8SEnvLoadBalancerInternalSpec `json:"internal"`
+}
+
+// Kubernetes environment load balancers configuration.
+type K8SEnvLoadBalancersSpecInput struct {
+	// Public load balancer configuration.
+	//
+	// Accessible via *.$env_name.altinity.cloud.
+	Public *K8SEnvLoadBalancerPublicSpecInput `json:"public,omitempty"`
+	// Internal load balancer configuration.
+	//
+	// Accessible via *.internal.$env_name.altinity.cloud.
+	Internal *K8SEnvLoadBalancerInternalSpecInput `json:"internal,omitempty"`
+}
+
+// Kubernetes environment logs configuration.
+type K8SEnvLogsSpec struct {
+	// Storage backend configuration.
+	Storage *K8SEnvLogsStorageSpec `json:"storage"`
+}
+
+// Kubernetes environment logs configuration input.
+type K8SEnvLogsSpecInput struct {
+	// Storage backend configuration.
+	Storage *K8SEnvSpecLogsStorageSpecInput `json:"storage,omitempty"`
+}
+
+// Kubernetes environment logs GCS storage configuration.
+type K8SEnvLogsStorageGCSSpec struct {
+	// GCS bucket name.
+	BucketName *string `json:"bucketName,omitempty"`
+}
+
+// Kubernetes environment logs S3 storage configuration.
+type K8SEnvLogsStorageS3Spec struct {
+	// S3 bucket name.
+	BucketName *string `json:"bucketName,omitempty"`
+	// S3 bucket region.
+	//
+	// Defaults to environment region.
+	Region *string `json:"region,omitempty"`
+}
+
+// Kubernetes environment logs storage configuration.
+type K8SEnvLogsStorageSpec struct {
+	// Amazon S3 configuration.
+	S3 *K8SEnvLogsStorageS3Spec `json:"s3,omitempty"`
+	// Google Cloud Storage configuration.
+	Gcs *K8SEnvLogsStorageGCSSpec `json:"gcs,omitempty"`
+}
+
+// Kubernetes environment metrics configuration.
+type K8SEnvMetricsSpec struct {
+	// Metrics retention period in days.
+	//
+	// 1 month by default.
+	RetentionPeriodInDays *int64 `json:"retentionPeriodInDays,omitempty"`
+}
+
+// Kubernetes environment metrics configuration input.
+type K8SEnvMetricsSpecInput struct {
+	// Metrics retention period in days.
+	//
+	// 1 month by default.
+	RetentionPeriodInDays *int64 `json:"retentionPeriodInDays,omitempty"`
+}
+
+// Kubernetes environment node group configuration.
+type K8SEnvNodeGroupSpec struct {
+	// Unique (among environment node groups) node group identifier.
+	Name string `json:"name"`
+	// node.kubernetes.io/instance-type value.
+	NodeType string `json:"nodeType"`
+	// topology.kubernetes.io/zone values.
+	Zones []string `json:"zones"`
+	// Maximum number of nodes per zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workloads that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations"`
+	// List of tolerations to apply to the pods targeting this group.
+	Tolerations []*NodeToleration `json:"tolerations"`
+	// nodeSelector to apply to the pods targeting this group.
+	Selector []*KeyValue `json:"selector"`
+}
+
+type K8SEnvNodeGroupSpecInput struct {
+	// Unique (among environment node groups) node group identifier.
+	//
+	// Defaults to $nodeType.
+	Name *string `json:"name,omitempty"`
+	// node.kubernetes.io/instance-type value.
+	NodeType string `json:"nodeType"`
+	// topology.kubernetes.io/zone values.
+	Zones []string `json:"zones"`
+	// Maximum number of nodes per zone.
+	CapacityPerZone int64 `json:"capacityPerZone"`
+	// Types of workloads that are allowed to be scheduled onto the nodes that belong to this group.
+	Reservations []NodeReservation `json:"reservations,omitempty"`
+	// List of tolerations to apply to the pods targeting this group.
+	Tolerations []*NodeTolerationSpecInput `json:"tolerations,omitempty"`
+	// nodeSelector to apply to the pods targeting this group.
+	Selector []*KeyValueInput `json:"selector,omitempty"`
+}
+
+// Kubernetes environment configuration.
+type K8SEnvSpec struct {
+	// Kubernetes distribution.
+	//
+	// Immutable.
+	Distribution K8SDistribution `json:"distribution"`
+	// Load balancers configuration.
+	LoadBalancers *K8SEnvLoadBalancersSpec `json:"loadBalancers"`
+	// Load balancing strategy.
+	LoadBalancingStrategy LoadBalancingStrategy `json:"loadBalancingStrategy"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*K8SEnvNodeGroupSpec `json:"nodeGroups"`
+	// Custom node types.
+	CustomNodeTypes []*K8SEnvCustomNodeTypeSpec `json:"customNodeTypes"`
+	// Metrics configuration.
+	Metrics *K8SEnvMetricsSpec `json:"metrics"`
+	// Logs configuration.
+	Logs *K8SEnvLogsSpec `json:"logs"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpec `json:"maintenanceWindows"`
+	// Custom domain (deprecated, use customDomains instead).
+	//
+	// Returns customDomains[0] for backwards compatibility.
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpec `json:"metricsEndpoint"`
+	// Datadog agent configuration.
+	Datadog *DatadogSpec `json:"datadog"`
+	// ClickHouse clusters.
+	ClickHouseClusters []*ClickHouseClusterSpec `json:"clickHouseClusters"`
+	// ClickHouse Keepers.
+	ClickHouseKeepers []*ClickHouseKeeperSpec `json:"clickHouseKeepers"`
+}
+
+type K8SEnvSpecFragment struct {
+	LoadBalancers         K8SEnvSpecFragment_LoadBalancers         `json:"loadBalancers"`
+	LoadBalancingStrategy LoadBalancingStrategy                    `json:"loadBalancingStrategy"`
+	Distribution          K8SDistribution                          `json:"distribution"`
+	CustomDomain          *string                                  `json:"customDomain,omitempty"`
+	CustomDomains         []string                                 `json:"customDomains"`
+	NodeGroups            []*K8SEnvSpecFragment_NodeGroups         `json:"nodeGroups"`
+	CustomNodeTypes       []*K8SEnvSpecFragment_CustomNodeTypes    `json:"customNodeTypes"`
+	MaintenanceWindows    []*K8SEnvSpecFragment_MaintenanceWindows `json:"maintenanceWindows"`
+	Metrics               K8SEnvSpecFragment_Metrics               `json:"metrics"`
+	Logs                  K8SEnvSpecFragment_Logs                  `json:"logs"`
+}
+
+type K8SEnvSpecFragment_CustomNodeTypes struct {
+	CPUAllocatable        float64 `json:"cpuAllocatable"`
+	MemAllocatableInBytes int64   `json:"memAllocatableInBytes"`
+	Name                  string  `json:"name"`
+}
+
+type K8SEnvSpecFragment_LoadBalancers struct {
+	Internal K8SEnvSpecFragment_LoadBalancers_Internal `json:"internal"`
+	Public   K8SEnvSpecFragment_LoadBalancers_Public   `json:"public"`
+}
+
+type K8SEnvSpecFragment_LoadBalancers_Internal struct {
+	Annotations    []*K8SEnvSpecFragment_LoadBalancers_Internal_Annotations `json:"annotations"`
+	Enabled        bool                                                     `json:"enabled"`
+	SourceIPRanges []string                                                 `json:"sourceIPRanges"`
+}
+
+type K8SEnvSpecFragment_LoadBalancers_Internal_Annotations struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type K8SEnvSpecFragment_LoadBalancers_Public struct {
+	Annotations    []*K8SEnvSpecFragment_LoadBalancers_Public_Annotations `json:"annotations"`
+	Enabled        bool                                                   `json:"enabled"`
+	SourceIPRanges []string                                               `json:"sourceIPRanges"`
+}
+
+type K8SEnvSpecFragment_LoadBalancers_Public_Annotations struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type K8SEnvSpecFragment_Logs struct {
+	Storage K8SEnvSpecFragment_Logs_Storage `json:"storage"`
+}
+
+type K8SEnvSpecFragment_Logs_Storage struct {
+	Gcs *K8SEnvSpecFragment_Logs_Storage_Gcs `json:"gcs,omitempty"`
+	S3  *K8SEnvSpecFragment_Logs_Storage_S3  `json:"s3,omitempty"`
+}
+
+type K8SEnvSpecFragment_Logs_Storage_Gcs struct {
+	BucketName *string `json:"bucketName,omitempty"`
+}
+
+type K8SEnvSpecFragment_Logs_Storage_S3 struct {
+	BucketName *string `json:"bucketName,omitempty"`
+	Region     *string `json:"region,omitempty"`
+}
+
+type K8SEnvSpecFragment_MaintenanceWindows struct {
+	Days          []Day  `json:"days"`
+	Enabled       bool   `json:"enabled"`
+	Hour          int64  `json:"hour"`
+	LengthInHours int64  `json:"lengthInHours"`
+	Name          string `json:"name"`
+}
+
+type K8SEnvSpecFragment_Metrics struct {
+	RetentionPeriodInDays *int64 `json:"retentionPeriodInDays,omitempty"`
+}
+
+type K8SEnvSpecFragment_NodeGroups struct {
+	CapacityPerZone int64                                        `json:"capacityPerZone"`
+	Name            string                                       `json:"name"`
+	NodeType        string                                       `json:"nodeType"`
+	Reservations    []NodeReservation                            `json:"reservations"`
+	Selector        []*K8SEnvSpecFragment_NodeGroups_Selector    `json:"selector"`
+	Tolerations     []*K8SEnvSpecFragment_NodeGroups_Tolerations `json:"tolerations"`
+	Zones           []string                                     `json:"zones"`
+}
+
+type K8SEnvSpecFragment_NodeGroups_Selector struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type K8SEnvSpecFragment_NodeGroups_Tolerations struct {
+	Effect   NodeTolerationEffect   `json:"effect"`
+	Key      string                 `json:"key"`
+	Operator NodeTolerationOperator `json:"operator"`
+	Value    string                 `json:"value"`
+}
+
+// Kubernetes environment logs GCS storage configuration.
+type K8SEnvSpecLogsStorageGCSSpecInput struct {
+	// GCS bucket name.
+	BucketName *string `json:"bucketName,omitempty"`
+}
+
+// Kubernetes environment logs S3 storage configuration.
+type K8SEnvSpecLogsStorageS3SpecInput struct {
+	// S3 bucket name.
+	BucketName *string `json:"bucketName,omitempty"`
+	// S3 bucket region.
+	//
+	// Defaults to environment region.
+	Region *string `json:"region,omitempty"`
+}
+
+// Kubernetes environment logs storage configuration input.
+type K8SEnvSpecLogsStorageSpecInput struct {
+	// Amazon S3 configuration.
+	S3 *K8SEnvSpecLogsStorageS3SpecInput `json:"s3,omitempty"`
+	// Google Cloud Storage configuration.
+	Gcs *K8SEnvSpecLogsStorageGCSSpecInput `json:"gcs,omitempty"`
+}
+
+// Kubernetes environment status.
+type K8SEnvStatus struct {
+	// Applied spec revision (monotonically-increasing).
+	AppliedSpecRevision int64 `json:"appliedSpecRevision"`
+	// True indicates that environment is pending deletion.
+	PendingDelete bool `json:"pendingDelete"`
+	// Status errors.
+	Errors []*EnvStatusError `json:"errors"`
+}
+
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type KeyValueInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Load balancing strategy.
+type LoadBalancingStrategy string
+
+// Maintenance window configuration.
+type MaintenanceWindowSpec struct {
+	// Maintenance window identifier.
+	Name string `json:"name"`
+	// True if maintenance window is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// Hour of the day in [0, 23] range.
+	Hour int64 `json:"hour"`
+	// Maintenance window length in hours. 4h min, 24h max.
+	LengthInHours int64 `json:"lengthInHours"`
+	// Days on which maintenance can take place.
+	// At least one day is required.
+	Days []Day `json:"days"`
+}
+
+// Maintenance window configuration input.
+type MaintenanceWindowSpecInput struct {
+	// Maintenance window identifier.
+	Name string `json:"name"`
+	// True if maintenance window is enabled,
+	// false otherwise.
+	//
+	// True by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Hour of the day in [0, 23] range.
+	Hour int64 `json:"hour"`
+	// Maintenance window length in hours. 4h min, 24h max.
+	LengthInHours int64 `json:"lengthInHours"`
+	// Days on which maintenance can take place.
+	// At least one day is required.
+	Days []Day `json:"days"`
+}
+
+// Metrics endpoint configuration.
+type MetricsEndpointSpec struct {
+	// True if metrics endpoint is enabled,
+	// false otherwise.
+	Enabled bool `json:"enabled"`
+	// IP addresses/blocks to allow traffic from when metrics endpoint is enabled.
+	SourceIPRanges []string `json:"sourceIPRanges"`
+}
+
+// Metrics endpoint configuration input.
+type MetricsEndpointSpecInput struct {
+	// True if metrics endpoint is enabled,
+	// false otherwise.
+	//
+	// False by default.
+	Enabled *bool `json:"enabled,omitempty"`
+	// IP addresses/blocks to allow traffic from when metrics endpoint is enabled.
+	SourceIPRanges []string `json:"sourceIPRanges,omitempty"`
+}
+
+// Node reservation.
+type NodeReservation string
+
+type NodeToleration struct {
+	// Taint key, e.g. "dedicated".
+	Key      string                 `json:"key"`
+	Operator NodeTolerationOperator `json:"operator"`
+	// Taint value, e.g. "clickhouse"
+	Value string `json:"value"`
+	// Taint effect to match.
+	Effect NodeTolerationEffect `json:"effect"`
+}
+
+// Node taint effect.
+type NodeTolerationEffect string
+
+// Node toleration operator used to match taints.
+type NodeTolerationOperator string
+
+type NodeTolerationSpecInput struct {
+	Key      string                 `json:"key"`
+	Operator NodeTolerationOperator `json:"operator"`
+	Value    string                 `json:"value"`
+	Effect   NodeTolerationEffect   `json:"effect"`
+}
+
+// Specifies Azure Private Link Service settings, including allowed subscription IDs for
+// private access. This enhances network security by ensuring only authorized subscriptions
+// connect to your services.
+//
+// Example: ["34d1b48e-6471-4129-be6b-9e3c2df6955c", "23f44dbf-02a0-4fd7-b821-5e82652bd920"]
+type PrivateLinkServiceSpec struct {
+	// Lists subscription IDs permitted for Private Link access, securing service connections.
+	AllowedSubscriptions []string `json:"allowedSubscriptions"`
+}
+
+// Specifies Azure Private Link Service settings, including allowed subscription IDs for
+// private access. This enhances network security by ensuring only authorized subscriptions
+// connect to your services.
+//
+// Example: ["34d1b48e-6471-4129-be6b-9e3c2df6955c", "23f44dbf-02a0-4fd7-b821-5e82652bd920"]
+type PrivateLinkServiceSpecInput struct {
+	// Lists subscription IDs permitted for Private Link access, securing service connections.
+	AllowedSubscriptions []string `json:"allowedSubscriptions"`
+}
+
+// AWS environment update request input.
+type UpdateAWSEnvHostedInput struct {
+	// Environment name.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *AWSEnvHostedUpdateSpecInput `json:"spec"`
+	// Environment spec update strategy.
+	// MERGE by default.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateAWSEnvHostedResponse is returned by AWSEnvsHosted.Update.
+type UpdateAWSEnvHostedResponse struct {
+	MutationID   string                    `json:"mutationId"`
+	Spec         *AWSEnvHostedSpecFragment `json:"spec"`
+	SpecRevision int64                     `json:"specRevision"`
+}
+
+// AWS environment update request result.
+type UpdateAWSEnvHostedResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// AWS environment active configuration.
+	Spec *AWSEnvHostedSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// AWS environment update request input.
+type UpdateAWSEnvInput struct {
+	// Environment name.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *AWSEnvUpdateSpecInput `json:"spec"`
+	// Environment spec update strategy.
+	// MERGE by default.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateAWSEnvResponse is returned by AWSEnvs.Update.
+type UpdateAWSEnvResponse struct {
+	MutationID   string              `json:"mutationId"`
+	Spec         *AWSEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// AWS environment update request result.
+type UpdateAWSEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// AWS environment active configuration.
+	Spec *AWSEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// Azure environment update request input.
+type UpdateAzureEnvInput struct {
+	// Environment name.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *UpdateAzureEnvSpecInput `json:"spec"`
+	// Environment spec update strategy.
+	// MERGE by default.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateAzureEnvResponse is returned by AzureEnvs.Update.
+type UpdateAzureEnvResponse struct {
+	MutationID   string                `json:"mutationId"`
+	Spec         *AzureEnvSpecFragment `json:"spec"`
+	SpecRevision int64                 `json:"specRevision"`
+}
+
+// Azure environment update request result.
+type UpdateAzureEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// Azure environment active configuration.
+	Spec *AzureEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// Azure environment configuration update request input.
+type UpdateAzureEnvSpecInput struct {
+	// Number of Azure zones.
+	// At least 2 required (if specified).
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of Azure zones.
+	// At least 2 required (if specified).
+	//
+	// Example: ["eastus-1", "eastus-2"]
+	Zones []string `json:"zones,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *AzureEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// AKS Kubernetes support policy. Empty value clears the override.
+	AksSupportPolicy *string `json:"aksSupportPolicy,omitempty"`
+	// AKS SKU tier. Empty value clears the override.
+	AksSKUTier *string `json:"aksSKUTier,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*AzureEnvNodeGroupSpecInput `json:"nodeGroups,omitempty"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                              → _.$env_name.altinity.cloud.
+	// - *.example.com.                            → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com.     → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                     → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.                   → _.internal.$env_name.altinity.cloud.
+	//
+	// Private Link (if used):
+	// - _acme-challenge.privatelink.example.com.  → privatelink.$env_name.altinity.cloud.
+	// - privatelink.example.com.                  → _.privatelink.$env_name.altinity.cloud.
+	// - *.privatelink.example.com.                → _.privatelink.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// Azure Private Link service configuration.
+	PrivateLinkService *PrivateLinkServiceSpecInput `json:"privateLinkService,omitempty"`
+	Tags               []*KeyValueInput             `json:"tags,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create or patch, matched by name.
+	ClickHouseClusters []*ClickHouseClusterUpdateSpecInput `json:"clickHouseClusters,omitempty"`
+	// Names of ClickHouse clusters to delete. Naming a cluster that does not exist is an error.
+	ClickHouseClustersToDelete []string `json:"clickHouseClustersToDelete,omitempty"`
+	// ClickHouse Keepers to create or patch, matched by name.
+	ClickHouseKeepers []*ClickHouseKeeperUpdateSpecInput `json:"clickHouseKeepers,omitempty"`
+	// Names of ClickHouse Keepers to delete. Naming a Keeper that does not exist is an error.
+	ClickHouseKeepersToDelete []string `json:"clickHouseKeepersToDelete,omitempty"`
+}
+
+// GCP environment update request input.
+type UpdateGCPEnvInput struct {
+	// Environment name.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *UpdateGCPEnvSpecInput `json:"spec"`
+	// Environment spec update strategy.
+	// MERGE by default.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateGCPEnvResponse is returned by GCPEnvs.Update.
+type UpdateGCPEnvResponse struct {
+	MutationID   string              `json:"mutationId"`
+	Spec         *GCPEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// GCP environment update request result.
+type UpdateGCPEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// GCP environment active configuration.
+	Spec *GCPEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// GCP environment configuration update request input.
+type UpdateGCPEnvSpecInput struct {
+	// Number of GCP zones.
+	// At least 2 required (if specified).
+	NumberOfZones *int64 `json:"numberOfZones,omitempty"`
+	// Explicit list of GCP zones.
+	// At least 2 required (if specified).
+	//
+	// Example: ["us-west1a", "us-west1b"]
+	Zones []string `json:"zones,omitempty"`
+	// Load balancers configuration.
+	LoadBalancers *GCPEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*GCPEnvNodeGroupSpecInput `json:"nodeGroups,omitempty"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// Network peering configuration.
+	PeeringConnections []*GCPEnvPeeringConnectionSpecInput `json:"peeringConnections,omitempty"`
+	// List of project IDs representing the network's private service consumers.
+	PrivateServiceConsumers []string `json:"privateServiceConsumers,omitempty"`
+	// List of private service connections.
+	PrivateServiceConnections []*GCPEnvPrivateServiceConnectionSpecInput `json:"privateServiceConnections,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Labels to apply to GCP resources.
+	Labels []*KeyValueInput `json:"labels,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create or patch, matched by name.
+	ClickHouseClusters []*ClickHouseClusterUpdateSpecInput `json:"clickHouseClusters,omitempty"`
+	// Names of ClickHouse clusters to delete. Naming a cluster that does not exist is an error.
+	ClickHouseClustersToDelete []string `json:"clickHouseClustersToDelete,omitempty"`
+	// ClickHouse Keepers to create or patch, matched by name.
+	ClickHouseKeepers []*ClickHouseKeeperUpdateSpecInput `json:"clickHouseKeepers,omitempty"`
+	// Names of ClickHouse Keepers to delete. Naming a Keeper that does not exist is an error.
+	ClickHouseKeepersToDelete []string `json:"clickHouseKeepersToDelete,omitempty"`
+}
+
+// HCloud environment update request input.
+type UpdateHCloudEnvInput struct {
+	// Environment name.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *UpdateHCloudEnvSpecInput `json:"spec"`
+	// Environment spec update strategy.
+	// MERGE by default.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateHCloudEnvResponse is returned by HCloudEnvs.Update.
+type UpdateHCloudEnvResponse struct {
+	MutationID   string                 `json:"mutationId"`
+	Spec         *HCloudEnvSpecFragment `json:"spec"`
+	SpecRevision int64                  `json:"specRevision"`
+}
+
+// HCloud environment update request result.
+type UpdateHCloudEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// HCloud environment active configuration.
+	Spec *HCloudEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// HCloud environment configuration update request input.
+type UpdateHCloudEnvSpecInput struct {
+	// Load balancers configuration.
+	LoadBalancers *HCloudEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	// ZONE_BEST_EFFORT by default.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	// At least one required.
+	NodeGroups []*HCloudEnvNodeGroupSpecInput `json:"nodeGroups,omitempty"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// Labels to apply to HCloud resources.
+	Labels []*KeyValueInput `json:"labels,omitempty"`
+	// Encrypted value of HCLOUD_TOKEN
+	HcloudTokenEnc *string `json:"hcloudTokenEnc,omitempty"`
+	// Wireguard peers configuration.
+	WireguardPeers []*HCloudEnvWireguardPeerSpecInput `json:"wireguardPeers,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create or patch, matched by name.
+	ClickHouseClusters []*ClickHouseClusterUpdateSpecInput `json:"clickHouseClusters,omitempty"`
+	// Names of ClickHouse clusters to delete. Naming a cluster that does not exist is an error.
+	ClickHouseClustersToDelete []string `json:"clickHouseClustersToDelete,omitempty"`
+	// ClickHouse Keepers to create or patch, matched by name.
+	ClickHouseKeepers []*ClickHouseKeeperUpdateSpecInput `json:"clickHouseKeepers,omitempty"`
+	// Names of ClickHouse Keepers to delete. Naming a Keeper that does not exist is an error.
+	ClickHouseKeepersToDelete []string `json:"clickHouseKeepersToDelete,omitempty"`
+}
+
+// Kubernetes environment update request input.
+type UpdateK8SEnvInput struct {
+	// Environment name.
+	Name string `json:"name"`
+	// Environment spec.
+	Spec *UpdateK8SEnvSpecInput `json:"spec"`
+	// Environment spec update strategy.
+	// MERGE by default.
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateK8SEnvResponse is returned by K8SEnvs.Update.
+type UpdateK8SEnvResponse struct {
+	MutationID   string              `json:"mutationId"`
+	Spec         *K8SEnvSpecFragment `json:"spec"`
+	SpecRevision int64               `json:"specRevision"`
+}
+
+// Kubernetes environment update request result.
+type UpdateK8SEnvResult struct {
+	// Mutation unique identifier.
+	MutationID string `json:"mutationId"`
+	// Kubernetes environment active configuration.
+	Spec *K8SEnvSpec `json:"spec"`
+	// Spec revision (monotonically-increasing).
+	SpecRevision int64 `json:"specRevision"`
+}
+
+// Kubernetes environment configuration update request input.
+type UpdateK8SEnvSpecInput struct {
+	// Load balancers configuration.
+	LoadBalancers *K8SEnvLoadBalancersSpecInput `json:"loadBalancers,omitempty"`
+	// Load balancing strategy.
+	LoadBalancingStrategy *LoadBalancingStrategy `json:"loadBalancingStrategy,omitempty"`
+	// List of node groups.
+	NodeGroups []*K8SEnvNodeGroupSpecInput `json:"nodeGroups,omitempty"`
+	// Custom node types.
+	CustomNodeTypes []*K8SEnvCustomNodeTypeSpecInput `json:"customNodeTypes,omitempty"`
+	// Metrics configuration.
+	Metrics *K8SEnvMetricsSpecInput `json:"metrics,omitempty"`
+	// Logs configuration.
+	Logs *K8SEnvLogsSpecInput `json:"logs,omitempty"`
+	// List of maintenance windows during which automatic maintenance is permitted.
+	// By default updates are applied as soon as they are available.
+	MaintenanceWindows []*MaintenanceWindowSpecInput `json:"maintenanceWindows,omitempty"`
+	// Custom domain (deprecated, use customDomains instead).
+	CustomDomain *string `json:"customDomain,omitempty"`
+	// Custom domains for TLS certificates.
+	//
+	// Order is preserved.
+	//
+	// Examples:
+	// - ["example.com"]
+	// - ["example.com", "foo.bar.com"]
+	//
+	// Before specifying custom domains, create the following DNS CNAME records
+	// (replace example.com with your domain):
+	//
+	// Required:
+	// - _acme-challenge.example.com. → $env_name.altinity.cloud.
+	//
+	// Public load balancer (if used):
+	// - example.com.                        → _.$env_name.altinity.cloud.
+	// - *.example.com.                      → _.$env_name.altinity.cloud.
+	//
+	// Internal load balancer (if used):
+	// - _acme-challenge.internal.example.com. → internal.$env_name.altinity.cloud.
+	// - internal.example.com.                 → _.internal.$env_name.altinity.cloud.
+	// - *.internal.example.com.               → _.internal.$env_name.altinity.cloud.
+	//
+	// VPC endpoint (if used):
+	// - _acme-challenge.vpce.example.com.   → vpce.$env_name.altinity.cloud.
+	// - vpce.example.com.                   → _.vpce.$env_name.altinity.cloud.
+	// - *.vpce.example.com.                 → _.vpce.$env_name.altinity.cloud.
+	CustomDomains []string `json:"customDomains,omitempty"`
+	// Metrics endpoint configuration.
+	MetricsEndpoint *MetricsEndpointSpecInput `json:"metricsEndpoint,omitempty"`
+	// Datadog monitoring agent configuration.
+	Datadog *DatadogSpecInput `json:"datadog,omitempty"`
+	// ClickHouse clusters to create or patch, matched by name.
+	ClickHouseClusters []*ClickHouseClusterUpdateSpecInput `json:"clickHouseClusters,omitempty"`
+	// Names of ClickHouse clusters to delete. Naming a cluster that does not exist is an error.
+	ClickHouseClustersToDelete []string `json:"clickHouseClustersToDelete,omitempty"`
+	// ClickHouse Keepers to create or patch, matched by name.
+	ClickHouseKeepers []*ClickHouseKeeperUpdateSpecInput `json:"clickHouseKeepers,omitempty"`
+	// Names of ClickHouse Keepers to delete. Naming a Keeper that does not exist is an error.
+	ClickHouseKeepersToDelete []string `json:"clickHouseKeepersToDelete,omitempty"`
+}
+
+// Update strategy.
+type UpdateStrategy string
+
+const (
+	AKSSKUTierFree    AKSSKUTier = "FREE"
+	AKSSKUTierPremium AKSSKUTier = "PREMIUM"
+)
+
+var AllAKSSKUTier = []AKSSKUTier{
+	AKSSKUTierFree,
+	AKSSKUTierPremium,
+}
+
+func (e AKSSKUTier) IsValid() bool {
+	switch e {
+	case AKSSKUTierFree, AKSSKUTierPremium:
+		return true
+	}
+	return false
+}
+
+func (e AKSSKUTier) String() string {
+	return string(e)
+}
+
+const (
+	AKSSupportPolicyStandard AKSSupportPolicy = "STANDARD"
+	AKSSupportPolicyExtended AKSSupportPolicy = "EXTENDED"
+)
+
+var AllAKSSupportPolicy = []AKSSupportPolicy{
+	AKSSupportPolicyStandard,
+	AKSSupportPolicyExtended,
+}
+
+func (e AKSSupportPolicy) IsValid() bool {
+	switch e {
+	case AKSSupportPolicyStandard, AKSSupportPolicyExtended:
+		return true
+	}
+	return false
+}
+
+func (e AKSSupportPolicy) String() string {
+	return string(e)
+}
+
+const (
+	// S3 bucket-based catalog.
+	AWSEnvHostedIcebergCatalogTypeSpecS3 AWSEnvHostedIcebergCatalogTypeSpec = "S3"
+	// S3 Tables-based catalog.
+	AWSEnvHostedIcebergCatalogTypeSpecS3Table AWSEnvHostedIcebergCatalogTypeSpec = "S3_TABLE"
+)
+
+var AllAWSEnvHostedIcebergCatalogTypeSpec = []AWSEnvHostedIcebergCatalogTypeSpec{
+	AWSEnvHostedIcebergCatalogTypeSpecS3,
+	AWSEnvHostedIcebergCatalogTypeSpecS3Table,
+}
+
+func (e AWSEnvHostedIcebergCatalogTypeSpec) IsValid() bool {
+	switch e {
+	case AWSEnvHostedIcebergCatalogTypeSpecS3, AWSEnvHostedIcebergCatalogTypeSpecS3Table:
+		return true
+	}
+	return false
+}
+
+func (e AWSEnvHostedIcebergCatalogTypeSpec) String() string {
+	return string(e)
+}
+
+const (
+	// Regular cluster whose nodes hold data.
+	ClickHouseClusterModeSpecStandard ClickHouseClusterModeSpec = "STANDARD"
+	// Nodes are labelled as swarm workers and shards reconcile fully in parallel.
+	ClickHouseClusterModeSpecSwarm ClickHouseClusterModeSpec = "SWARM"
+)
+
+var AllClickHouseClusterModeSpec = []ClickHouseClusterModeSpec{
+	ClickHouseClusterModeSpecStandard,
+	ClickHouseClusterModeSpecSwarm,
+}
+
+func (e ClickHouseClusterModeSpec) IsValid() bool {
+	switch e {
+	case ClickHouseClusterModeSpecStandard, ClickHouseClusterModeSpecSwarm:
+		return true
+	}
+	return false
+}
+
+func (e ClickHouseClusterModeSpec) String() string {
+	return string(e)
+}
+
+const (
+	// Clear-text password. Set outside this API; it cannot be supplied here.
+	ClickHouseUserPasswordTypeSpecPlainText ClickHouseUserPasswordTypeSpec = "PLAIN_TEXT"
+	// SHA-256 digest, hex encoded.
+	ClickHouseUserPasswordTypeSpecSha256Hex ClickHouseUserPasswordTypeSpec = "SHA256_HEX"
+	// Double SHA-1 digest, hex encoded.
+	ClickHouseUserPasswordTypeSpecDoubleSha1Hex ClickHouseUserPasswordTypeSpec = "DOUBLE_SHA1_HEX"
+)
+
+var AllClickHouseUserPasswordTypeSpec = []ClickHouseUserPasswordTypeSpec{
+	ClickHouseUserPasswordTypeSpecPlainText,
+	ClickHouseUserPasswordTypeSpecSha256Hex,
+	ClickHouseUserPasswordTypeSpecDoubleSha1Hex,
+}
+
+func (e ClickHouseUserPasswordTypeSpec) IsValid() bool {
+	switch e {
+	case ClickHouseUserPasswordTypeSpecPlainText, ClickHouseUserPasswordTypeSpecSha256Hex, ClickHouseUserPasswordTypeSpecDoubleSha1Hex:
+		return true
+	}
+	return false
+}
+
+func (e ClickHouseUserPasswordTypeSpec) String() string {
+	return string(e)
+}
+
+const (
+	// SHA-256 digest, hex encoded.
+	ClickHouseUserPasswordTypeSpecInputSha256Hex ClickHouseUserPasswordTypeSpecInput = "SHA256_HEX"
+	// Double SHA-1 digest, hex encoded.
+	ClickHouseUserPasswordTypeSpecInputDoubleSha1Hex ClickHouseUserPasswordTypeSpecInput = "DOUBLE_SHA1_HEX"
+)
+
+var AllClickHouseUserPasswordTypeSpecInput = []ClickHouseUserPasswordTypeSpecInput{
+	ClickHouseUserPasswordTypeSpecInputSha256Hex,
+	ClickHouseUserPasswordTypeSpecInputDoubleSha1Hex,
+}
+
+func (e ClickHouseUserPasswordTypeSpecInput) IsValid() bool {
+	switch e {
+	case ClickHouseUserPasswordTypeSpecInputSha256Hex, ClickHouseUserPasswordTypeSpecInputDoubleSha1Hex:
+		return true
+	}
+	return false
+}
+
+func (e ClickHouseUserPasswordTypeSpecInput) String() string {
+	return string(e)
+}
+
+const (
+	DaySunday    Day = "SUNDAY"
+	DayMonday    Day = "MONDAY"
+	DayTuesday   Day = "TUESDAY"
+	DayWednesday Day = "WEDNESDAY"
+	DayThursday  Day = "THURSDAY"
+	DayFriday    Day = "FRIDAY"
+	DaySaturday  Day = "SATURDAY"
+)
+
+var AllDay = []Day{
+	DaySunday,
+	DayMonday,
+	DayTuesday,
+	DayWednesday,
+	DayThursday,
+	DayFriday,
+	DaySaturday,
+}
+
+func (e Day) IsValid() bool {
+	switch e {
+	case DaySunday, DayMonday, DayTuesday, DayWednesday, DayThursday, DayFriday, DaySaturday:
+		return true
+	}
+	return false
+}
+
+func (e Day) String() string {
+	return string(e)
+}
+
+const (
+	EnvStatusErrorCodeInternal                      EnvStatusErrorCode = "INTERNAL"
+	EnvStatusErrorCodeDisconnected                  EnvStatusErrorCode = "DISCONNECTED"
+	EnvStatusErrorCodeCloudProviderAccessDenied     EnvStatusErrorCode = "CLOUD_PROVIDER_ACCESS_DENIED"
+	EnvStatusErrorCodeCloudProviderQuotaExceeded    EnvStatusErrorCode = "CLOUD_PROVIDER_QUOTA_EXCEEDED"
+	EnvStatusErrorCodeCloudProviderResourceNotFound EnvStatusErrorCode = "CLOUD_PROVIDER_RESOURCE_NOT_FOUND"
+	EnvStatusErrorCodeCloudProviderBadRequest       EnvStatusErrorCode = "CLOUD_PROVIDER_BAD_REQUEST"
+	EnvStatusErrorCodeGCPProjectNotFound            EnvStatusErrorCode = "GCP_PROJECT_NOT_FOUND"
+	EnvStatusErrorCodeK8sDisconnected               EnvStatusErrorCode = "K8S_DISCONNECTED"
+)
+
+var AllEnvStatusErrorCode = []EnvStatusErrorCode{
+	EnvStatusErrorCodeInternal,
+	EnvStatusErrorCodeDisconnected,
+	EnvStatusErrorCodeCloudProviderAccessDenied,
+	EnvStatusErrorCodeCloudProviderQuotaExceeded,
+	EnvStatusErrorCodeCloudProviderResourceNotFound,
+	EnvStatusErrorCodeCloudProviderBadRequest,
+	EnvStatusErrorCodeGCPProjectNotFound,
+	EnvStatusErrorCodeK8sDisconnected,
+}
+
+func (e EnvStatusErrorCode) IsValid() bool {
+	switch e {
+	case EnvStatusErrorCodeInternal, EnvStatusErrorCodeDisconnected, EnvStatusErrorCodeCloudProviderAccessDenied, EnvStatusErrorCodeCloudProviderQuotaExceeded, EnvStatusErrorCodeCloudProviderResourceNotFound, EnvStatusErrorCodeCloudProviderBadRequest, EnvStatusErrorCodeGCPProjectNotFound, EnvStatusErrorCodeK8sDisconnected:
+		return true
+	}
+	return false
+}
+
+func (e EnvStatusErrorCode) String() string {
+	return string(e)
+}
+
+const (
+	// S3 bucket-based catalog.
+	IcebergCatalogTypeSpecS3 IcebergCatalogTypeSpec = "S3"
+	// S3 Tables-based catalog.
+	IcebergCatalogTypeSpecS3Table IcebergCatalogTypeSpec = "S3_TABLE"
+)
+
+var AllIcebergCatalogTypeSpec = []IcebergCatalogTypeSpec{
+	IcebergCatalogTypeSpecS3,
+	IcebergCatalogTypeSpecS3Table,
+}
+
+func (e IcebergCatalogTypeSpec) IsValid() bool {
+	switch e {
+	case IcebergCatalogTypeSpecS3, IcebergCatalogTypeSpecS3Table:
+		return true
+	}
+	return false
+}
+
+func (e IcebergCatalogTypeSpec) String() string {
+	return string(e)
+}
+
+const (
+	// Amazon Elastic Kubernetes Service.
+	K8SDistributionEks K8SDistribution = "EKS"
+	// Google Kubernetes Engine.
+	K8SDistributionGke K8SDistribution = "GKE"
+	// Azure Kubernetes Service.
+	K8SDistributionAks K8SDistribution = "AKS"
+	// Custom Kubernetes distribution.
+	K8SDistributionCustom K8SDistribution = "CUSTOM"
+)
+
+var AllK8SDistribution = []K8SDistribution{
+	K8SDistributionEks,
+	K8SDistributionGke,
+	K8SDistributionAks,
+	K8SDistributionCustom,
+}
+
+func (e K8SDistribution) IsValid() bool {
+	switch e {
+	case K8SDistributionEks, K8SDistributionGke, K8SDistributionAks, K8SDistributionCustom:
+		return true
+	}
+	return false
+}
+
+func (e K8SDistribution) String() string {
+	return string(e)
+}
+
+const (
+	// Keep the traffic within the same zone.
+	LoadBalancingStrategyZoneBestEffort LoadBalancingStrategy = "ZONE_BEST_EFFORT"
+	// Load balance traffic across all zones in round-robin fashion.
+	LoadBalancingStrategyRoundRobin LoadBalancingStrategy = "ROUND_ROBIN"
+)
+
+var AllLoadBalancingStrategy = []LoadBalancingStrategy{
+	LoadBalancingStrategyZoneBestEffort,
+	LoadBalancingStrategyRoundRobin,
+}
+
+func (e LoadBalancingStrategy) IsValid() bool {
+	switch e {
+	case LoadBalancingStrategyZoneBestEffort, LoadBalancingStrategyRoundRobin:
+		return true
+	}
+	return false
+}
+
+func (e LoadBalancingStrategy) String() string {
+	return string(e)
+}
+
+const (
+	// Reservation for infrastructure/support services.
+	NodeReservationSystem NodeReservation = "SYSTEM"
+	// Reservation for ClickHouse.
+	NodeReservationClickhouse NodeReservation = "CLICKHOUSE"
+	// Reservation for ZooKeeper.
+	NodeReservationZookeeper NodeReservation = "ZOOKEEPER"
+)
+
+var AllNodeReservation = []NodeReservation{
+	NodeReservationSystem,
+	NodeReservationClickhouse,
+	NodeReservationZookeeper,
+}
+
+func (e NodeReservation) IsValid() bool {
+	switch e {
+	case NodeReservationSystem, NodeReservationClickhouse, NodeReservationZookeeper:
+		return true
+	}
+	return false
+}
+
+func (e NodeReservation) String() string {
+	return string(e)
+}
+
+const (
+	NodeTolerationEffectNoSchedule       NodeTolerationEffect = "NO_SCHEDULE"
+	NodeTolerationEffectPreferNoSchedule NodeTolerationEffect = "PREFER_NO_SCHEDULE"
+	NodeTolerationEffectNoExecute        NodeTolerationEffect = "NO_EXECUTE"
+)
+
+var AllNodeTolerationEffect = []NodeTolerationEffect{
+	NodeTolerationEffectNoSchedule,
+	NodeTolerationEffectPreferNoSchedule,
+	NodeTolerationEffectNoExecute,
+}
+
+func (e NodeTolerationEffect) IsValid() bool {
+	switch e {
+	case NodeTolerationEffectNoSchedule, NodeTolerationEffectPreferNoSchedule, NodeTolerationEffectNoExecute:
+		return true
+	}
+	return false
+}
+
+func (e NodeTolerationEffect) String() string {
+	return string(e)
+}
+
+const (
+	NodeTolerationOperatorEqual  NodeTolerationOperator = "EQUAL"
+	NodeTolerationOperatorExists NodeTolerationOperator = "EXISTS"
+)
+
+var AllNodeTolerationOperator = []NodeTolerationOperator{
+	NodeTolerationOperatorEqual,
+	NodeTolerationOperatorExists,
+}
+
+func (e NodeTolerationOperator) IsValid() bool {
+	switch e {
+	case NodeTolerationOperatorEqual, NodeTolerationOperatorExists:
+		return true
+	}
+	return false
+}
+
+func (e NodeTolerationOperator) String() string {
+	return string(e)
+}
+
+const (
+	// Merge objects & replace lists.
+	UpdateStrategyMerge UpdateStrategy = "MERGE"
+	// Replace all.
+	UpdateStrategyReplace UpdateStrategy = "REPLACE"
+)
+
+var AllUpdateStrategy = []UpdateStrategy{
+	UpdateStrategyMerge,
+	UpdateStrategyReplace,
+}
+
+func (e UpdateStrategy) IsValid() bool {
+	switch e {
+	case UpdateStrategyMerge, UpdateStrategyReplace:
+		return true
+	}
+	return false
+}
+
+func (e UpdateStrategy) String() string {
+	return string(e)
+}
@@ -0,0 +1,387 @@
+// Code generated by internal/gen, DO NOT EDIT.
+
+package altinitycloud
+
+import "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+
+// generatedModels pairs the public models with the client types they copy.
+var generatedModels = []struct{ public, internal any }{
+	{AKSSKUTier(""), client.AKSSKUTier("")},
+	{AKSSupportPolicy(""), client.AKSSupportPolicy("")},
+	{AWSEnv{}, client.AWSEnv{}},
+	{AWSEnvBackupsCustomBucketSpec{}, client.AWSEnvBackupsCustomBucketSpec{}},
+	{AWSEnvBackupsCustomBucketSpecInput{}, client.AWSEnvBackupsCustomBucketSpecInput{}},
+	{AWSEnvBackupsSpec{}, client.AWSEnvBackupsSpec{}},
+	{AWSEnvBackupsSpecInput{}, client.AWSEnvBackupsSpecInput{}},
+	{AWSEnvEndpointSpec{}, client.AWSEnvEndpointSpec{}},
+	{AWSEnvEndpointSpecInput{}, client.AWSEnvEndpointSpecInput{}},
+	{AWSEnvExternalBucketSpec{}, client.AWSEnvExternalBucketSpec{}},
+	{AWSEnvExternalBucketSpecInput{}, client.AWSEnvExternalBucketSpecInput{}},
+	{AWSEnvFilter{}, client.AWSEnvFilter{}},
+	{AWSEnvHosted{}, client.AWSEnvHosted{}},
+	{AWSEnvHostedBackupsCustomBucketSpec{}, client.AWSEnvHostedBackupsCustomBucketSpec{}},
+	{AWSEnvHostedBackupsCustomBucketSpecInput{}, client.AWSEnvHostedBackupsCustomBucketSpecInput{}},
+	{AWSEnvHostedBackupsSpec{}, client.AWSEnvHostedBackupsSpec{}},
+	{AWSEnvHostedBackupsSpecInput{}, client.AWSEnvHostedBackupsSpecInput{}},
+	{AWSEnvHostedEndpointSpec{}, client.AWSEnvHostedEndpointSpec{}},
+	{AWSEnvHostedEndpointSpecInput{}, client.AWSEnvHostedEndpointSpecInput{}},
+	{AWSEnvHostedExternalBucketSpec{}, client.AWSEnvHostedExternalBucketSpec{}},
+	{AWSEnvHostedExternalBucketSpecInput{}, client.AWSEnvHostedExternalBucketSpecInput{}},
+	{AWSEnvHostedFilter{}, client.AWSEnvHostedFilter{}},
+	{AWSEnvHostedIcebergCatalogInputSpec{}, client.AWSEnvHostedIcebergCatalogInputSpec{}},
+	{AWSEnvHostedIcebergCatalogMaintenanceInputSpec{}, client.AWSEnvHostedIcebergCatalogMaintenanceInputSpec{}},
+	{AWSEnvHostedIcebergCatalogMaintenanceSpec{}, client.AWSEnvHostedIcebergCatalogMaintenanceSpec{}},
+	{AWSEnvHostedIcebergCatalogSpec{}, client.AWSEnvHostedIcebergCatalogSpec{}},
+	{AWSEnvHostedIcebergCatalogTypeSpec(""), client.AWSEnvHostedIcebergCatalogTypeSpec("")},
+	{AWSEnvHostedIcebergCatalogWatchInputSpec{}, client.AWSEnvHostedIcebergCatalogWatchInputSpec{}},
+	{AWSEnvHostedIcebergCatalogWatchSpec{}, client.AWSEnvHostedIcebergCatalogWatchSpec{}},
+	{AWSEnvHostedIcebergInputSpec{}, client.AWSEnvHostedIcebergInputSpec{}},
+	{AWSEnvHostedIcebergSpec{}, client.AWSEnvHostedIcebergSpec{}},
+	{AWSEnvHostedIcebergUpdateInputSpec{}, client.AWSEnvHostedIcebergUpdateInputSpec{}},
+	{AWSEnvHostedLoadBalancerInternalSpec{}, client.AWSEnvHostedLoadBalancerInternalSpec{}},
+	{AWSEnvHostedLoadBalancerInternalSpecInput{}, client.AWSEnvHostedLoadBalancerInternalSpecInput{}},
+	{AWSEnvHostedLoadBalancerInternalStatus{}, client.AWSEnvHostedLoadBalancerInternalStatus{}},
+	{AWSEnvHostedLoadBalancerPublicSpec{}, client.AWSEnvHostedLoadBalancerPublicSpec{}},
+	{AWSEnvHostedLoadBalancerPublicSpecInput{}, client.AWSEnvHostedLoadBalancerPublicSpecInput{}},
+	{AWSEnvHostedLoadBalancersSpec{}, client.AWSEnvHostedLoadBalancersSpec{}},
+	{AWSEnvHostedLoadBalancersSpecInput{}, client.AWSEnvHostedLoadBalancersSpecInput{}},
+	{AWSEnvHostedLoadBalancersStatus{}, client.AWSEnvHostedLoadBalancersStatus{}},
+	{AWSEnvHostedNodeGroupSpec{}, client.AWSEnvHostedNodeGroupSpec{}},
+	{AWSEnvHostedNodeGroupSpecInput{}, client.AWSEnvHostedNodeGroupSpecInput{}},
+	{AWSEnvHostedSpec{}, client.AWSEnvHostedSpec{}},
+	{AWSEnvHostedSpecFragment{}, client.AWSEnvHostedSpecFragment{}},
+	{AWSEnvHostedSpecFragment_Backups{}, client.AWSEnvHostedSpecFragment_Backups{}},
+	{AWSEnvHostedSpecFragment_Backups_CustomBucket{}, client.AWSEnvHostedSpecFragment_Backups_CustomBucket{}},
+	{AWSEnvHostedSpecFragment_Datadog{}, client.AWSEnvHostedSpecFragment_Datadog{}},
+	{AWSEnvHostedSpecFragment_Endpoints{}, client.AWSEnvHostedSpecFragment_Endpoints{}},
+	{AWSEnvHostedSpecFragment_ExternalBuckets{}, client.AWSEnvHostedSpecFragment_ExternalBuckets{}},
+	{AWSEnvHostedSpecFragment_Iceberg{}, client.AWSEnvHostedSpecFragment_Iceberg{}},
+	{AWSEnvHostedSpecFragment_Iceberg_Catalogs{}, client.AWSEnvHostedSpecFragment_Iceberg_Catalogs{}},
+	{AWSEnvHostedSpecFragment_Iceberg_Catalogs_Maintenance{}, client.AWSEnvHostedSpecFragment_Iceberg_Catalogs_Maintenance{}},
+	{AWSEnvHostedSpecFragment_Iceberg_Catalogs_Watches{}, client.AWSEnvHostedSpecFragment_Iceberg_Catalogs_Watches{}},
+	{AWSEnvHostedSpecFragment_LoadBalancers{}, client.AWSEnvHostedSpecFragment_LoadBalancers{}},
+	{AWSEnvHostedSpecFragment_LoadBalancers_Internal{}, client.AWSEnvHostedSpecFragment_LoadBalancers_Internal{}},
+	{AWSEnvHostedSpecFragment_LoadBalancers_Public{}, client.AWSEnvHostedSpecFragment_LoadBalancers_Public{}},
+	{AWSEnvHostedSpecFragment_MaintenanceWindows{}, client.AWSEnvHostedSpecFragment_MaintenanceWindows{}},
+	{AWSEnvHostedSpecFragment_MetricsEndpoint{}, client.AWSEnvHostedSpecFragment_MetricsEndpoint{}},
+	{AWSEnvHostedSpecFragment_NodeGroups{}, client.AWSEnvHostedSpecFragment_NodeGroups{}},
+	{AWSEnvHostedStatus{}, client.AWSEnvHostedStatus{}},
+	{AWSEnvHostedUpdateSpecInput{}, client.AWSEnvHostedUpdateSpecInput{}},
+	{AWSEnvLoadBalancerInternalSpec{}, client.AWSEnvLoadBalancerInternalSpec{}},
+	{AWSEnvLoadBalancerInternalSpecInput{}, client.AWSEnvLoadBalancerInternalSpecInput{}},
+	{AWSEnvLoadBalancerInternalStatus{}, client.AWSEnvLoadBalancerInternalStatus{}},
+	{AWSEnvLoadBalancerPublicSpec{}, client.AWSEnvLoadBalancerPublicSpec{}},
+	{AWSEnvLoadBalancerPublicSpecInput{}, client.AWSEnvLoadBalancerPublicSpecInput{}},
+	{AWSEnvLoadBalancersSpec{}, client.AWSEnvLoadBalancersSpec{}},
+	{AWSEnvLoadBalancersSpecInput{}, client.AWSEnvLoadBalancersSpecInput{}},
+	{AWSEnvLoadBalancersStatus{}, client.AWSEnvLoadBalancersStatus{}},
+	{AWSEnvNodeGroupSpec{}, client.AWSEnvNodeGroupSpec{}},
+	{AWSEnvNodeGroupSpecInput{}, client.AWSEnvNodeGroupSpecInput{}},
+	{AWSEnvPeeringConnectionSpec{}, client.AWSEnvPeeringConnectionSpec{}},
+	{AWSEnvPeeringConnectionSpecInput{}, client.AWSEnvPeeringConnectionSpecInput{}},
+	{AWSEnvPeeringConnectionStatus{}, client.AWSEnvPeeringConnectionStatus{}},
+	{AWSEnvSpec{}, client.AWSEnvSpec{}},
+	{AWSEnvSpecFragment{}, client.AWSEnvSpecFragment{}},
+	{AWSEnvSpecFragment_Backups{}, client.AWSEnvSpecFragment_Backups{}},
+	{AWSEnvSpecFragment_Backups_CustomBucket{}, client.AWSEnvSpecFragment_Backups_CustomBucket{}},
+	{AWSEnvSpecFragment_Datadog{}, client.AWSEnvSpecFragment_Datadog{}},
+	{AWSEnvSpecFragment_Endpoints{}, client.AWSEnvSpecFragment_Endpoints{}},
+	{AWSEnvSpecFragment_ExternalBuckets{}, client.AWSEnvSpecFragment_ExternalBuckets{}},
+	{AWSEnvSpecFragment_Iceberg{}, client.AWSEnvSpecFragment_Iceberg{}},
+	{AWSEnvSpecFragment_Iceberg_Catalogs{}, client.AWSEnvSpecFragment_Iceberg_Catalogs{}},
+	{AWSEnvSpecFragment_Iceberg_Catalogs_Maintenance{}, client.AWSEnvSpecFragment_Iceberg_Catalogs_Maintenance{}},
+	{AWSEnvSpecFragment_Iceberg_Catalogs_Watches{}, client.AWSEnvSpecFragment_Iceberg_Catalogs_Watches{}},
+	{AWSEnvSpecFragment_LoadBalancers{}, client.AWSEnvSpecFragment_LoadBalancers{}},
+	{AWSEnvSpecFragment_LoadBalancers_Internal{}, client.AWSEnvSpecFragment_LoadBalancers_Internal{}},
+	{AWSEnvSpecFragment_LoadBalancers_Public{}, client.AWSEnvSpecFragment_LoadBalancers_Public{}},
+	{AWSEnvSpecFragment_MaintenanceWindows{}, client.AWSEnvSpecFragment_MaintenanceWindows{}},
+	{AWSEnvSpecFragment_MetricsEndpoint{}, client.AWSEnvSpecFragment_MetricsEndpoint{}},
+	{AWSEnvSpecFragment_NodeGroups{}, client.AWSEnvSpecFragment_NodeGroups{}},
+	{AWSEnvSpecFragment_PeeringConnections{}, client.AWSEnvSpecFragment_PeeringConnections{}},
+	{AWSEnvSpecFragment_Tags{}, client.AWSEnvSpecFragment_Tags{}},
+	{AWSEnvStatus{}, client.AWSEnvStatus{}},
+	{AWSEnvUpdateSpecInput{}, client.AWSEnvUpdateSpecInput{}},
+	{AWSResource{}, client.AWSResource{}},
+	{AzureEnv{}, client.AzureEnv{}},
+	{AzureEnvFilter{}, client.AzureEnvFilter{}},
+	{AzureEnvLoadBalancerInternalSpec{}, client.AzureEnvLoadBalancerInternalSpec{}},
+	{AzureEnvLoadBalancerInternalSpecInput{}, client.AzureEnvLoadBalancerInternalSpecInput{}},
+	{AzureEnvLoadBalancerInternalStatus{}, client.AzureEnvLoadBalancerInternalStatus{}},
+	{AzureEnvLoadBalancerPublicSpec{}, client.AzureEnvLoadBalancerPublicSpec{}},
+	{AzureEnvLoadBalancerPublicSpecInput{}, client.AzureEnvLoadBalancerPublicSpecInput{}},
+	{AzureEnvLoadBalancersSpec{}, client.AzureEnvLoadBalancersSpec{}},
+	{AzureEnvLoadBalancersSpecInput{}, client.AzureEnvLoadBalancersSpecInput{}},
+	{AzureEnvLoadBalancersStatus{}, client.AzureEnvLoadBalancersStatus{}},
+	{AzureEnvNodeGroupSpec{}, client.AzureEnvNodeGroupSpec{}},
+	{AzureEnvNodeGroupSpecInput{}, client.AzureEnvNodeGroupSpecInput{}},
+	{AzureEnvSpec{}, client.AzureEnvSpec{}},
+	{AzureEnvSpecFragment{}, client.AzureEnvSpecFragment{}},
+	{AzureEnvSpecFragment_Datadog{}, client.AzureEnvSpecFragment_Datadog{}},
+	{AzureEnvSpecFragment_LoadBalancers{}, client.AzureEnvSpecFragment_LoadBalancers{}},
+	{AzureEnvSpecFragment_LoadBalancers_Internal{}, client.AzureEnvSpecFragment_LoadBalancers_Internal{}},
+	{AzureEnvSpecFragment_LoadBalancers_Public{}, client.AzureEnvSpecFragment_LoadBalancers_Public{}},
+	{AzureEnvSpecFragment_MaintenanceWindows{}, client.AzureEnvSpecFragment_MaintenanceWindows{}},
+	{AzureEnvSpecFragment_MetricsEndpoint{}, client.AzureEnvSpecFragment_MetricsEndpoint{}},
+	{AzureEnvSpecFragment_NodeGroups{}, client.AzureEnvSpecFragment_NodeGroups{}},
+	{AzureEnvSpecFragment_PrivateLinkService{}, client.AzureEnvSpecFragment_PrivateLinkService{}},
+	{AzureEnvSpecFragment_Tags{}, client.AzureEnvSpecFragment_Tags{}},
+	{AzureEnvStatus{}, client.AzureEnvStatus{}},
+	{ClickHouseClusterCreateSpecInput{}, client.ClickHouseClusterCreateSpecInput{}},
+	{ClickHouseClusterModeSpec(""), client.ClickHouseClusterModeSpec("")},
+	{ClickHouseClusterSpec{}, client.ClickHouseClusterSpec{}},
+	{ClickHouseClusterUpdateSpecInput{}, client.ClickHouseClusterUpdateSpecInput{}},
+	{ClickHouseDiskCreateSpecInput{}, client.ClickHouseDiskCreateSpecInput{}},
+	{ClickHouseDiskSpec{}, client.ClickHouseDiskSpec{}},
+	{ClickHouseDiskUpdateSpecInput{}, client.ClickHouseDiskUpdateSpecInput{}},
+	{ClickHouseKeeperCreateSpecInput{}, client.ClickHouseKeeperCreateSpecInput{}},
+	{ClickHouseKeeperRefSpec{}, client.ClickHouseKeeperRefSpec{}},
+	{ClickHouseKeeperSpec{}, client.ClickHouseKeeperSpec{}},
+	{ClickHouseKeeperSpecInput{}, client.ClickHouseKeeperSpecInput{}},
+	{ClickHouseKeeperUpdateSpecInput{}, client.ClickHouseKeeperUpdateSpecInput{}},
+	{ClickHouseProfileCreateSpecInput{}, client.ClickHouseProfileCreateSpecInput{}},
+	{ClickHouseProfileSpec{}, client.ClickHouseProfileSpec{}},
+	{ClickHouseProfileUpdateSpecInput{}, client.ClickHouseProfileUpdateSpecInput{}},
+	{ClickHouseSecretRefSpec{}, client.ClickHouseSecretRefSpec{}},
+	{ClickHouseSecretRefSpecInput{}, client.ClickHouseSecretRefSpecInput{}},
+	{ClickHouseSettingSpec{}, client.ClickHouseSettingSpec{}},
+	{ClickHouseSettingSpecInput{}, client.ClickHouseSettingSpecInput{}},
+	{ClickHouseUserPasswordTypeSpec(""), client.ClickHouseUserPasswordTypeSpec("")},
+	{ClickHouseUserPasswordTypeSpecInput(""), client.ClickHouseUserPasswordTypeSpecInput("")},
+	{ClickHouseUserSpec{}, client.ClickHouseUserSpec{}},
+	{ClickHouseUserSpecInput{}, client.ClickHouseUserSpecInput{}},
+	{CreateAWSEnvHostedInput{}, client.CreateAWSEnvHostedInput{}},
+	{CreateAWSEnvHostedResponse{}, client.CreateAWSEnvHosted_CreateAWSEnvHosted{}},
+	{CreateAWSEnvHostedResult{}, client.CreateAWSEnvHostedResult{}},
+	{CreateAWSEnvHostedSpecInput{}, client.CreateAWSEnvHostedSpecInput{}},
+	{CreateAWSEnvInput{}, client.CreateAWSEnvInput{}},
+	{CreateAWSEnvResponse{}, client.CreateAWSEnv_CreateAWSEnv{}},
+	{CreateAWSEnvResult{}, client.CreateAWSEnvResult{}},
+	{CreateAWSEnvSpecInput{}, client.CreateAWSEnvSpecInput{}},
+	{CreateAzureEnvInput{}, client.CreateAzureEnvInput{}},
+	{CreateAzureEnvResponse{}, client.CreateAzureEnv_CreateAzureEnv{}},
+	{CreateAzureEnvResult{}, client.CreateAzureEnvResult{}},
+	{CreateAzureEnvSpecInput{}, client.CreateAzureEnvSpecInput{}},
+	{CreateGCPEnvInput{}, client.CreateGCPEnvInput{}},
+	{CreateGCPEnvResponse{}, client.CreateGCPEnv_CreateGCPEnv{}},
+	{CreateGCPEnvResult{}, client.CreateGCPEnvResult{}},
+	{CreateGCPEnvSpecInput{}, client.CreateGCPEnvSpecInput{}},
+	{CreateHCloudEnvInput{}, client.CreateHCloudEnvInput{}},
+	{CreateHCloudEnvResponse{}, client.CreateHCloudEnv_CreateHCloudEnv{}},
+	{CreateHCloudEnvResult{}, client.CreateHCloudEnvResult{}},
+	{CreateHCloudEnvSpecInput{}, client.CreateHCloudEnvSpecInput{}},
+	{CreateK8SEnvInput{}, client.CreateK8SEnvInput{}},
+	{CreateK8SEnvResponse{}, client.CreateK8SEnv_CreateK8SEnv{}},
+	{CreateK8SEnvResult{}, client.CreateK8SEnvResult{}},
+	{CreateK8SEnvSpecInput{}, client.CreateK8SEnvSpecInput{}},
+	{DatadogSpec{}, client.DatadogSpec{}},
+	{DatadogSpecInput{}, client.DatadogSpecInput{}},
+	{Day(""), client.Day("")},
+	{DeleteAWSEnvHostedInput{}, client.DeleteAWSEnvHostedInput{}},
+	{DeleteAWSEnvHostedResponse{}, client.DeleteAWSEnvHosted_DeleteAWSEnvHosted{}},
+	{DeleteAWSEnvHostedResult{}, client.DeleteAWSEnvHostedResult{}},
+	{DeleteAWSEnvInput{}, client.DeleteAWSEnvInput{}},
+	{DeleteAWSEnvResponse{}, client.DeleteAWSEnv_DeleteAWSEnv{}},
+	{DeleteAWSEnvResult{}, client.DeleteAWSEnvResult{}},
+	{DeleteAzureEnvInput{}, client.DeleteAzureEnvInput{}},
+	{DeleteAzureEnvResponse{}, client.DeleteAzureEnv_DeleteAzureEnv{}},
+	{DeleteAzureEnvResult{}, client.DeleteAzureEnvResult{}},
+	{DeleteGCPEnvInput{}, client.DeleteGCPEnvInput{}},
+	{DeleteGCPEnvResponse{}, client.DeleteGCPEnv_DeleteGCPEnv{}},
+	{DeleteGCPEnvResult{}, client.DeleteGCPEnvResult{}},
+	{DeleteHCloudEnvInput{}, client.DeleteHCloudEnvInput{}},
+	{DeleteHCloudEnvResponse{}, client.DeleteHCloudEnv_DeleteHCloudEnv{}},
+	{DeleteHCloudEnvResult{}, client.DeleteHCloudEnvResult{}},
+	{DeleteK8SEnvInput{}, client.DeleteK8SEnvInput{}},
+	{DeleteK8SEnvResponse{}, client.DeleteK8SEnv_DeleteK8SEnv{}},
+	{DeleteK8SEnvResult{}, client.DeleteK8SEnvResult{}},
+	{EnvStatusError{}, client.EnvStatusError{}},
+	{EnvStatusErrorCode(""), client.EnvStatusErrorCode("")},
+	{GCPEnv{}, client.GCPEnv{}},
+	{GCPEnvFilter{}, client.GCPEnvFilter{}},
+	{GCPEnvLoadBalancerInternalSpec{}, client.GCPEnvLoadBalancerInternalSpec{}},
+	{GCPEnvLoadBalancerInternalSpecInput{}, client.GCPEnvLoadBalancerInternalSpecInput{}},
+	{GCPEnvLoadBalancerPublicSpec{}, client.GCPEnvLoadBalancerPublicSpec{}},
+	{GCPEnvLoadBalancerPublicSpecInput{}, client.GCPEnvLoadBalancerPublicSpecInput{}},
+	{GCPEnvLoadBalancersSpec{}, client.GCPEnvLoadBalancersSpec{}},
+	{GCPEnvLoadBalancersSpecInput{}, client.GCPEnvLoadBalancersSpecInput{}},
+	{GCPEnvNodeGroupSpec{}, client.GCPEnvNodeGroupSpec{}},
+	{GCPEnvNodeGroupSpecInput{}, client.GCPEnvNodeGroupSpecInput{}},
+	{GCPEnvPeeringConnectionSpec{}, client.GCPEnvPeeringConnectionSpec{}},
+	{GCPEnvPeeringConnectionSpecInput{}, client.GCPEnvPeeringConnectionSpecInput{}},
+	{GCPEnvPrivateServiceConnectionSpec{}, client.GCPEnvPrivateServiceConnectionSpec{}},
+	{GCPEnvPrivateServiceConnectionSpecInput{}, client.GCPEnvPrivateServiceConnectionSpecInput{}},
+	{GCPEnvSpec{}, client.GCPEnvSpec{}},
+	{GCPEnvSpecFragment{}, client.GCPEnvSpecFragment{}},
+	{GCPEnvSpecFragment_Datadog{}, client.GCPEnvSpecFragment_Datadog{}},
+	{GCPEnvSpecFragment_Labels{}, client.GCPEnvSpecFragment_Labels{}},
+	{GCPEnvSpecFragment_LoadBalancers{}, client.GCPEnvSpecFragment_LoadBalancers{}},
+	{GCPEnvSpecFragment_LoadBalancers_Internal{}, client.GCPEnvSpecFragment_LoadBalancers_Internal{}},
+	{GCPEnvSpecFragment_LoadBalancers_Public{}, client.GCPEnvSpecFragment_LoadBalancers_Public{}},
+	{GCPEnvSpecFragment_MaintenanceWindows{}, client.GCPEnvSpecFragment_MaintenanceWindows{}},
+	{GCPEnvSpecFragment_MetricsEndpoint{}, client.GCPEnvSpecFragment_MetricsEndpoint{}},
+	{GCPEnvSpecFragment_NodeGroups{}, client.GCPEnvSpecFragment_NodeGroups{}},
+	{GCPEnvSpecFragment_PeeringConnections{}, client.GCPEnvSpecFragment_PeeringConnections{}},
+	{GCPEnvStatus{}, client.GCPEnvStatus{}},
+	{GetAWSEnvHostedResponse{}, client.GetAWSEnvHosted_AWSEnvHosted{}},
+	{GetAWSEnvHostedStatusResponse{}, client.GetAWSEnvHostedStatus_AWSEnvHosted{}},
+	{GetAWSEnvHostedStatusResponse_Status{}, client.GetAWSEnvHostedStatus_AWSEnvHosted_Status{}},
+	{GetAWSEnvHostedStatusResponse_Status_Errors{}, client.GetAWSEnvHostedStatus_AWSEnvHosted_Status_Errors{}},
+	{GetAWSEnvHostedStatusResponse_Status_LoadBalancers{}, client.GetAWSEnvHostedStatus_AWSEnvHosted_Status_LoadBalancers{}},
+	{GetAWSEnvHostedStatusResponse_Status_LoadBalancers_Internal{}, client.GetAWSEnvHostedStatus_AWSEnvHosted_Status_LoadBalancers_Internal{}},
+	{GetAWSEnvResponse{}, client.GetAWSEnv_AWSEnv{}},
+	{GetAWSEnvStatusResponse{}, client.GetAWSEnvStatus_AWSEnv{}},
+	{GetAWSEnvStatusResponse_Status{}, client.GetAWSEnvStatus_AWSEnv_Status{}},
+	{GetAWSEnvStatusResponse_Status_AWSResources{}, client.GetAWSEnvStatus_AWSEnv_Status_AWSResources{}},
+	{GetAWSEnvStatusResponse_Status_Errors{}, client.GetAWSEnvStatus_AWSEnv_Status_Errors{}},
+	{GetAWSEnvStatusResponse_Status_LoadBalancers{}, client.GetAWSEnvStatus_AWSEnv_Status_LoadBalancers{}},
+	{GetAWSEnvStatusResponse_Status_LoadBalancers_Internal{}, client.GetAWSEnvStatus_AWSEnv_Status_LoadBalancers_Internal{}},
+	{GetAWSEnvStatusResponse_Status_PeeringConnections{}, client.GetAWSEnvStatus_AWSEnv_Status_PeeringConnections{}},
+	{GetAzureEnvResponse{}, client.GetAzureEnv_AzureEnv{}},
+	{GetAzureEnvStatusResponse{}, client.GetAzureEnvStatus_AzureEnv{}},
+	{GetAzureEnvStatusResponse_Status{}, client.GetAzureEnvStatus_AzureEnv_Status{}},
+	{GetAzureEnvStatusResponse_Status_Errors{}, client.GetAzureEnvStatus_AzureEnv_Status_Errors{}},
+	{GetAzureEnvStatusResponse_Status_LoadBalancers{}, client.GetAzureEnvStatus_AzureEnv_Status_LoadBalancers{}},
+	{GetAzureEnvStatusResponse_Status_LoadBalancers_Internal{}, client.GetAzureEnvStatus_AzureEnv_Status_LoadBalancers_Internal{}},
+	{GetGCPEnvResponse{}, client.GetGCPEnv_GCPEnv{}},
+	{GetGCPEnvStatusResponse{}, client.GetGCPEnvStatus_GCPEnv{}},
+	{GetGCPEnvStatusResponse_Status{}, client.GetGCPEnvStatus_GCPEnv_Status{}},
+	{GetGCPEnvStatusResponse_Status_Errors{}, client.GetGCPEnvStatus_GCPEnv_Status_Errors{}},
+	{GetHCloudEnvResponse{}, client.GetHCloudEnv_HcloudEnv{}},
+	{GetHCloudEnvStatusResponse{}, client.GetHCloudEnvStatus_HcloudEnv{}},
+	{GetHCloudEnvStatusResponse_Status{}, client.GetHCloudEnvStatus_HcloudEnv_Status{}},
+	{GetHCloudEnvStatusResponse_Status_Errors{}, client.GetHCloudEnvStatus_HcloudEnv_Status_Errors{}},
+	{GetK8SEnvResponse{}, client.GetK8SEnv_K8sEnv{}},
+	{GetK8SEnvStatusResponse{}, client.GetK8SEnvStatus_K8sEnv{}},
+	{GetK8SEnvStatusResponse_Status{}, client.GetK8SEnvStatus_K8sEnv_Status{}},
+	{GetK8SEnvStatusResponse_Status_Errors{}, client.GetK8SEnvStatus_K8sEnv_Status_Errors{}},
+	{HCloudEnv{}, client.HCloudEnv{}},
+	{HCloudEnvFilter{}, client.HCloudEnvFilter{}},
+	{HCloudEnvLoadBalancerInternalSpec{}, client.HCloudEnvLoadBalancerInternalSpec{}},
+	{HCloudEnvLoadBalancerInternalSpecInput{}, client.HCloudEnvLoadBalancerInternalSpecInput{}},
+	{HCloudEnvLoadBalancerPublicSpec{}, client.HCloudEnvLoadBalancerPublicSpec{}},
+	{HCloudEnvLoadBalancerPublicSpecInput{}, client.HCloudEnvLoadBalancerPublicSpecInput{}},
+	{HCloudEnvLoadBalancersSpec{}, client.HCloudEnvLoadBalancersSpec{}},
+	{HCloudEnvLoadBalancersSpecInput{}, client.HCloudEnvLoadBalancersSpecInput{}},
+	{HCloudEnvNodeGroupSpec{}, client.HCloudEnvNodeGroupSpec{}},
+	{HCloudEnvNodeGroupSpecInput{}, client.HCloudEnvNodeGroupSpecInput{}},
+	{HCloudEnvSpec{}, client.HCloudEnvSpec{}},
+	{HCloudEnvSpecFragment{}, client.HCloudEnvSpecFragment{}},
+	{HCloudEnvSpecFragment_Datadog{}, client.HCloudEnvSpecFragment_Datadog{}},
+	{HCloudEnvSpecFragment_LoadBalancers{}, client.HCloudEnvSpecFragment_LoadBalancers{}},
+	{HCloudEnvSpecFragment_LoadBalancers_Internal{}, client.HCloudEnvSpecFragment_LoadBalancers_Internal{}},
+	{HCloudEnvSpecFragment_LoadBalancers_Public{}, client.HCloudEnvSpecFragment_LoadBalancers_Public{}},
+	{HCloudEnvSpecFragment_MaintenanceWindows{}, client.HCloudEnvSpecFragment_MaintenanceWindows{}},
+	{HCloudEnvSpecFragment_MetricsEndpoint{}, client.HCloudEnvSpecFragment_MetricsEndpoint{}},
+	{HCloudEnvSpecFragment_NodeGroups{}, client.HCloudEnvSpecFragment_NodeGroups{}},
+	{HCloudEnvSpecFragment_WireguardPeers{}, client.HCloudEnvSpecFragment_WireguardPeers{}},
+	{HCloudEnvStatus{}, client.HCloudEnvStatus{}},
+	{HCloudEnvWireguardPeerSpec{}, client.HCloudEnvWireguardPeerSpec{}},
+	{HCloudEnvWireguardPeerSpecInput{}, client.HCloudEnvWireguardPeerSpecInput{}},
+	{IcebergCatalogInputSpec{}, client.IcebergCatalogInputSpec{}},
+	{IcebergCatalogMaintenanceInputSpec{}, client.IcebergCatalogMaintenanceInputSpec{}},
+	{IcebergCatalogMaintenanceSpec{}, client.IcebergCatalogMaintenanceSpec{}},
+	{IcebergCatalogSpec{}, client.IcebergCatalogSpec{}},
+	{IcebergCatalogTypeSpec(""), client.IcebergCatalogTypeSpec("")},
+	{IcebergCatalogWatchInputSpec{}, client.IcebergCatalogWatchInputSpec{}},
+	{IcebergCatalogWatchSpec{}, client.IcebergCatalogWatchSpec{}},
+	{IcebergInputSpec{}, client.IcebergInputSpec{}},
+	{IcebergSpec{}, client.IcebergSpec{}},
+	{IcebergUpdateInputSpec{}, client.IcebergUpdateInputSpec{}},
+	{K8SDistribution(""), client.K8SDistribution("")},
+	{K8SEnv{}, client.K8SEnv{}},
+	{K8SEnvCustomNodeTypeSpec{}, client.K8SEnvCustomNodeTypeSpec{}},
+	{K8SEnvCustomNodeTypeSpecInput{}, client.K8SEnvCustomNodeTypeSpecInput{}},
+	{K8SEnvFilter{}, client.K8SEnvFilter{}},
+	{K8SEnvLoadBalancerInternalSpec{}, client.K8SEnvLoadBalancerInternalSpec{}},
+	{K8SEnvLoadBalancerInternalSpecInput{}, client.K8SEnvLoadBalancerInternalSpecInput{}},
+	{K8SEnvLoadBalancerPublicSpec{}, client.K8SEnvLoadBalancerPublicSpec{}},
+	{K8SEnvLoadBalancerPublicSpecInput{}, client.K8SEnvLoadBalancerPublicSpecInput{}},
+	{K8SEnvLoadBalancersSpec{}, client.K8SEnvLoadBalancersSpec{}},
+	{K8SEnvLoadBalancersSpecInput{}, client.K8SEnvLoadBalancersSpecInput{}},
+	{K8SEnvLogsSpec{}, client.K8SEnvLogsSpec{}},
+	{K8SEnvLogsSpecInput{}, client.K8SEnvLogsSpecInput{}},
+	{K8SEnvLogsStorageGCSSpec{}, client.K8SEnvLogsStorageGCSSpec{}},
+	{K8SEnvLogsStorageS3Spec{}, client.K8SEnvLogsStorageS3Spec{}},
+	{K8SEnvLogsStorageSpec{}, client.K8SEnvLogsStorageSpec{}},
+	{K8SEnvMetricsSpec{}, client.K8SEnvMetricsSpec{}},
+	{K8SEnvMetricsSpecInput{}, client.K8SEnvMetricsSpecInput{}},
+	{K8SEnvNodeGroupSpec{}, client.K8SEnvNodeGroupSpec{}},
+	{K8SEnvNodeGroupSpecInput{}, client.K8SEnvNodeGroupSpecInput{}},
+	{K8SEnvSpec{}, client.K8SEnvSpec{}},
+	{K8SEnvSpecFragment{}, client.K8SEnvSpecFragment{}},
+	{K8SEnvSpecFragment_CustomNodeTypes{}, client.K8SEnvSpecFragment_CustomNodeTypes{}},
+	{K8SEnvSpecFragment_LoadBalancers{}, client.K8SEnvSpecFragment_LoadBalancers{}},
+	{K8SEnvSpecFragment_LoadBalancers_Internal{}, client.K8SEnvSpecFragment_LoadBalancers_Internal{}},
+	{K8SEnvSpecFragment_LoadBalancers_Internal_Annotations{}, client.K8SEnvSpecFragment_LoadBalancers_Internal_Annotations{}},
+	{K8SEnvSpecFragment_LoadBalancers_Public{}, client.K8SEnvSpecFragment_LoadBalancers_Public{}},
+	{K8SEnvSpecFragment_LoadBalancers_Public_Annotations{}, client.K8SEnvSpecFragment_LoadBalancers_Public_Annotations{}},
+	{K8SEnvSpecFragment_Logs{}, client.K8SEnvSpecFragment_Logs{}},
+	{K8SEnvSpecFragment_Logs_Storage{}, client.K8SEnvSpecFragment_Logs_Storage{}},
+	{K8SEnvSpecFragment_Logs_Storage_Gcs{}, client.K8SEnvSpecFragment_Logs_Storage_Gcs{}},
+	{K8SEnvSpecFragment_Logs_Storage_S3{}, client.K8SEnvSpecFragment_Logs_Storage_S3{}},
+	{K8SEnvSpecFragment_MaintenanceWindows{}, client.K8SEnvSpecFragment_MaintenanceWindows{}},
+	{K8SEnvSpecFragment_Metrics{}, client.K8SEnvSpecFragment_Metrics{}},
+	{K8SEnvSpecFragment_NodeGroups{}, client.K8SEnvSpecFragment_NodeGroups{}},
+	{K8SEnvSpecFragment_NodeGroups_Selector{}, client.K8SEnvSpecFragment_NodeGroups_Selector{}},
+	{K8SEnvSpecFragment_NodeGroups_Tolerations{}, client.K8SEnvSpecFragment_NodeGroups_Tolerations{}},
+	{K8SEnvSpecLogsStorageGCSSpecInput{}, client.K8SEnvSpecLogsStorageGCSSpecInput{}},
+	{K8SEnvSpecLogsStorageS3SpecInput{}, client.K8SEnvSpecLogsStorageS3SpecInput{}},
+	{K8SEnvSpecLogsStorageSpecInput{}, client.K8SEnvSpecLogsStorageSpecInput{}},
+	{K8SEnvStatus{}, client.K8SEnvStatus{}},
+	{KeyValue{}, client.KeyValue{}},
+	{KeyValueInput{}, client.KeyValueInput{}},
+	{LoadBalancingStrategy(""), client.LoadBalancingStrategy("")},
+	{MaintenanceWindowSpec{}, client.MaintenanceWindowSpec{}},
+	{MaintenanceWindowSpecInput{}, client.MaintenanceWindowSpecInput{}},
+	{MetricsEndpointSpec{}, client.MetricsEndpointSpec{}},
+	{MetricsEndpointSpecInput{}, client.MetricsEndpointSpecInput{}},
+	{NodeReservation(""), client.NodeReservation("")},
+	{NodeToleration{}, client.NodeToleration{}},
+	{NodeTolerationEffect(""), client.NodeTolerationEffect("")},
+	{NodeTolerationOperator(""), client.NodeTolerationOperator("")},
+	{NodeTolerationSpecInput{}, client.NodeTolerationSpecInput{}},
+	{PrivateLinkServiceSpec{}, client.PrivateLinkServiceSpec{}},
+	{PrivateLinkServiceSpecInput{}, client.PrivateLinkServiceSpecInput{}},
+	{UpdateAWSEnvHostedInput{}, client.UpdateAWSEnvHostedInput{}},
+	{UpdateAWSEnvHostedResponse{}, client.UpdateAWSEnvHosted_UpdateAWSEnvHosted{}},
+	{UpdateAWSEnvHostedResult{}, client.UpdateAWSEnvHostedResult{}},
+	{UpdateAWSEnvInput{}, client.UpdateAWSEnvInput{}},
+	{UpdateAWSEnvResponse{}, client.UpdateAWSEnv_UpdateAWSEnv{}},
+	{UpdateAWSEnvResult{}, client.UpdateAWSEnvResult{}},
+	{UpdateAzureEnvInput{}, client.UpdateAzureEnvInput{}},
+	{UpdateAzureEnvResponse{}, client.UpdateAzureEnv_UpdateAzureEnv{}},
+	{UpdateAzureEnvResult{}, client.UpdateAzureEnvResult{}},
+	{UpdateAzureEnvSpecInput{}, client.UpdateAzureEnvSpecInput{}},
+	{UpdateGCPEnvInput{}, client.UpdateGCPEnvInput{}},
+	{UpdateGCPEnvResponse{}, client.UpdateGCPEnv_UpdateGCPEnv{}},
+	{UpdateGCPEnvResult{}, client.UpdateGCPEnvResult{}},
+	{UpdateGCPEnvSpecInput{}, client.UpdateGCPEnvSpecInput{}},
+	{UpdateHCloudEnvInput{}, client.UpdateHCloudEnvInput{}},
+	{UpdateHCloudEnvResponse{}, client.UpdateHCloudEnv_UpdateHCloudEnv{}},
+	{UpdateHCloudEnvResult{}, client.UpdateHCloudEnvResult{}},
+	{UpdateHCloudEnvSpecInput{}, client.UpdateHCloudEnvSpecInput{}},
+	{UpdateK8SEnvInput{}, client.UpdateK8SEnvInput{}},
+	{UpdateK8SEnvResponse{}, client.UpdateK8SEnv_UpdateK8SEnv{}},
+	{UpdateK8SEnvResult{}, client.UpdateK8SEnvResult{}},
+	{UpdateK8SEnvSpecInput{}, client.UpdateK8SEnvSpecInput{}},
+	{UpdateStrategy(""), client.UpdateStrategy("")},
+}
+
+// generatedEnums pairs the values of the public enums with those of the client.
+var generatedEnums = []struct{ public, internal any }{
+	{AllAKSSKUTier, client.AllAKSSKUTier},
+	{AllAKSSupportPolicy, client.AllAKSSupportPolicy},
+	{AllAWSEnvHostedIcebergCatalogTypeSpec, client.AllAWSEnvHostedIcebergCatalogTypeSpec},
+	{AllClickHouseClusterModeSpec, client.AllClickHouseClusterModeSpec},
+	{AllClickHouseUserPasswordTypeSpec, client.AllClickHouseUserPasswordTypeSpec},
+	{AllClickHouseUserPasswordTypeSpecInput, client.AllClickHouseUserPasswordTypeSpecInput},
+	{AllDay, client.AllDay},
+	{AllEnvStatusErrorCode, client.AllEnvStatusErrorCode},
+	{AllIcebergCatalogTypeSpec, client.AllIcebergCatalogTypeSpec},
+	{AllK8SDistribution, client.AllK8SDistribution},
+	{AllLoadBalancingStrategy, client.AllLoadBalancingStrategy},
+	{AllNodeReservation, client.AllNodeReservation},
+	{AllNodeTolerationEffect, client.AllNodeTolerationEffect},
+	{AllNodeTolerationOperator, client.AllNodeTolerationOperator},
+	{AllUpdateStrategy, client.AllUpdateStrategy},
+}
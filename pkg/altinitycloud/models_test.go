@@ -0,0 +1,95 @@
+package altinitycloud
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/altinity/terraform-provider-altinitycloud/internal/sdk/client"
+	sdkHttp "github.com/altinity/terraform-provider-altinitycloud/internal/sdk/http"
+)
+
+// TestGeneratedModels checks that the public models still encode like the
+// client types they copy, which convert relies on.
+func TestGeneratedModels(t *testing.T) {
+	t.Parallel()
+
+	const hint = "the client changed: run go generate in pkg/altinitycloud and review the diff of models_gen.go as an API change"
+	for _, pair := range generatedModels {
+		public, internal := reflect.TypeOf(pair.public), reflect.TypeOf(pair.internal)
+		if got, want := jsonFields(public), jsonFields(internal); got != want {
+			t.Errorf("%s encodes as\n%s\nbut client.%s as\n%s\n%s", public.Name(), got, internal.Name(), want, hint)
+		}
+	}
+	for _, pair := range generatedEnums {
+		got, err := json.Marshal(pair.public)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := json.Marshal(pair.internal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != string(want) {
+			t.Errorf("%T values are %s but the client's are %s\n%s", pair.public, got, want, hint)
+		}
+	}
+}
+
+// TestTypesMatchClient checks that the hand-written public types still have
+// the fields of the client types they are converted from.
+func TestTypesMatchClient(t *testing.T) {
+	t.Parallel()
+
+	pairs := []struct{ public, internal interface{} }{
+		{TransportPolicy{}, sdkHttp.TransportPolicy{}},
+		{APIError{}, client.APIError{}},
+	}
+	for _, pair := range pairs {
+		public, internal := reflect.TypeOf(pair.public), reflect.TypeOf(pair.internal)
+		if got, want := exportedFields(public), exportedFields(internal); got != want {
+			t.Errorf("%s has the fields %s but the client's %s, add them to the type and its conversion", public.Name(), got, want)
+		}
+	}
+}
+
+func exportedFields(typ reflect.Type) string {
+	var names []string
+	for i := 0; i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.IsExported() {
+			names = append(names, field.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// jsonFields describes how values of typ encode to JSON, leaving out the names
+// of the types. Struct fields are described by kind only: their types have
+// pairs of their own.
+func jsonFields(typ reflect.Type) string {
+	if typ.Kind() != reflect.Struct {
+		return typ.Kind().String()
+	}
+	var fields []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.IsExported() {
+			fields = append(fields, field.Tag.Get("json")+" "+jsonKind(field.Type))
+		}
+	}
+	return "{" + strings.Join(fields, "; ") + "}"
+}
+
+func jsonKind(typ reflect.Type) string {
+	switch typ.Kind() {
+	case reflect.Pointer:
+		return "*" + jsonKind(typ.Elem())
+	case reflect.Slice:
+		return "[]" + jsonKind(typ.Elem())
+	case reflect.Map:
+		return "map[" + jsonKind(typ.Key()) + "]" + jsonKind(typ.Elem())
+	default:
+		return typ.Kind().String()
+	}
+}
@@ -0,0 +1,34 @@
+package altinitycloud
+
+import "context"
+
+// Certificate is a client certificate of an env, as issued for the
+// altinitycloud_env_certificate resource.
+type Certificate struct {
+	CertificatePEM string
+	PrivateKeyPEM  string
+}
+
+// PEM returns the certificate followed by its key, the form EncryptSecret and
+// the altinitycloud_env_secret resource take.
+func (c *Certificate) PEM() string {
+	return c.CertificatePEM + "\n" + c.PrivateKeyPEM
+}
+
+// GenerateCertificate generates a key and has Altinity.Cloud sign a client
+// certificate for env envName with it.
+func (c *Client) GenerateCertificate(ctx context.Context, envName string) (*Certificate, error) {
+	crt, key, err := c.sdk.Auth.GenerateCertificate(ctx, envName)
+	if err != nil {
+		return nil, publicError(err)
+	}
+	return &Certificate{CertificatePEM: crt, PrivateKeyPEM: key}, nil
+}
+
+// EncryptSecret encrypts value with the public key of the env the client
+// certificate pem (certificate followed by key) was issued for. The result can
+// be used in the env spec wherever a secret is expected.
+func (c *Client) EncryptSecret(ctx context.Context, pem string, value string) (string, error) {
+	encrypted, err := c.sdk.Crypto.Encrypt(ctx, pem, value)
+	return encrypted, publicError(err)
+}
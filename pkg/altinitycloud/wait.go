@@ -0,0 +1,134 @@
+package altinitycloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultPollInterval is how often the wait helpers poll the env status.
+const DefaultPollInterval = 30 * time.Second
+
+// EnvStatus is the status of an env of any cloud.
+type EnvStatus struct {
+	Name string
+	// SpecRevision is the revision of the latest spec of the env.
+	SpecRevision int64
+	// AppliedSpecRevision is the revision the env is provisioned with.
+	AppliedSpecRevision int64
+	PendingDelete       bool
+	Errors              []EnvError
+}
+
+// StatusGetter returns the status of an env. AWSEnvs, AzureEnvs and the other
+// per-cloud operations implement it.
+type StatusGetter interface {
+	EnvStatus(ctx context.Context, name string) (*EnvStatus, error)
+}
+
+type waitConfig struct {
+	pollInterval time.Duration
+	onPoll       func(*EnvStatus)
+}
+
+// WaitOption configures WaitForSpecRevision and WaitForDeletion.
+type WaitOption func(*waitConfig)
+
+// WithPollInterval polls every interval instead of DefaultPollInterval. Polls
+// closer than the query cache TTL (see WithQueryCacheTTL) may return the same
+// status.
+func WithPollInterval(interval time.Duration) WaitOption {
+	return func(c *waitConfig) { c.pollInterval = interval }
+}
+
+// WithProgress calls fn with every status polled, e.g. to report progress.
+func WithProgress(fn func(*EnvStatus)) WaitOption {
+	return func(c *waitConfig) { c.onPoll = fn }
+}
+
+func newWaitConfig(opts []WaitOption) waitConfig {
+	cfg := waitConfig{pollInterval: DefaultPollInterval}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// disconnected reports whether code means the env has not connected to
+// Altinity.Cloud (yet).
+func disconnected(code string) bool {
+	return code == "DISCONNECTED" || code == "K8S_DISCONNECTED"
+}
+
+// WaitForSpecRevision polls the status of env name until revision, e.g. the
+// SpecRevision returned by Create or Update, is applied. Disconnection errors
+// are ignored until the env is first provisioned; other errors reported by the
+// env are returned as a *ProvisioningError. Bound the wait with ctx.
+func WaitForSpecRevision(ctx context.Context, envs StatusGetter, name string, revision int64, opts ...WaitOption) (*EnvStatus, error) {
+	cfg := newWaitConfig(opts)
+	for {
+		status, err := envs.EnvStatus(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("read env status %s: %w", name, err)
+		}
+		if cfg.onPoll != nil {
+			cfg.onPoll(status)
+		}
+
+		var blocking []EnvError
+		for _, envErr := range status.Errors {
+			if disconnected(envErr.Code) && status.AppliedSpecRevision == 0 {
+				continue
+			}
+			blocking = append(blocking, envErr)
+		}
+		if len(blocking) > 0 {
+			return status, &ProvisioningError{Env: name, Errors: blocking}
+		}
+		if len(status.Errors) == 0 && status.AppliedSpecRevision >= revision {
+			return status, nil
+		}
+
+		if err := sleep(ctx, cfg.pollInterval); err != nil {
+			return status, fmt.Errorf("waiting for env %s to apply spec revision %d: %w", name, revision, err)
+		}
+	}
+}
+
+// WaitForDeletion polls the status of env name until it is gone. Errors
+// reading the status, e.g. a transient outage, don't stop the wait; bound it
+// with ctx. An env deleted with MFA enabled is only gone once the deletion is
+// confirmed.
+func WaitForDeletion(ctx context.Context, envs StatusGetter, name string, opts ...WaitOption) error {
+	cfg := newWaitConfig(opts)
+	var lastErr error
+	for {
+		status, err := envs.EnvStatus(ctx, name)
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		lastErr = err
+		if err == nil && cfg.onPoll != nil {
+			cfg.onPoll(status)
+		}
+
+		if err := sleep(ctx, cfg.pollInterval); err != nil {
+			if lastErr != nil {
+				return fmt.Errorf("waiting for env %s to be deleted: %w (last error: %s)", name, err, lastErr)
+			}
+			return fmt.Errorf("waiting for env %s to be deleted: %w", name, err)
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}